@@ -0,0 +1,712 @@
+// Package client is a Go SDK for the calendar api.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = time.RFC3339
+
+type RetryPolicy struct {
+	// MaxAttempts includes the first attempt, values below 2 disable retries.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+type Client struct {
+	Endpoint   string
+	HttpClient *http.Client
+	Retry      RetryPolicy
+	// Token authenticates requests: an api token, a session token or the
+	// admin token.
+	Token string
+}
+
+func New(endpoint string) *Client {
+	return &Client{
+		Endpoint:   strings.TrimRight(endpoint, "/"),
+		HttpClient: http.DefaultClient,
+		Retry:      DefaultRetryPolicy,
+	}
+}
+
+// AddUser creates a user, Token must be the admin token unless signup is
+// open. The Token of the user is only returned here.
+func (c *Client) AddUser(ctx context.Context, login string) (*User, error) {
+	user := &User{}
+	err := c.do(ctx, "POST", "/api/users", nil, User{Login: login}, user, false)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	users := []User{}
+	if err := c.do(ctx, "GET", "/api/users", nil, nil, &users, true); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, login string) (*User, error) {
+	user := &User{}
+	if err := c.do(ctx, "GET", "/api/users/"+url.PathEscape(login), nil, nil, user, true); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// UpdateUser changes the profile of a user, nil fields are kept.
+func (c *Client) UpdateUser(ctx context.Context, login string, update UserUpdate) (*User, error) {
+	user := &User{}
+	if err := c.do(ctx, "PATCH", "/api/users/"+url.PathEscape(login), nil, update, user, true); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// DeleteUser deletes a user, the meetings they own are transferred to
+// transferTo, or cancelled when it is empty.
+func (c *Client) DeleteUser(ctx context.Context, login, transferTo string) error {
+	query := url.Values{}
+	if transferTo != "" {
+		query.Set("transferTo", transferTo)
+	}
+	return c.do(ctx, "DELETE", "/api/users/"+url.PathEscape(login), query, nil, nil, true)
+}
+
+// CreateToken creates another api token of a user, its Token is only
+// returned here.
+func (c *Client) CreateToken(ctx context.Context, login, name string) (*ApiToken, error) {
+	token := &ApiToken{}
+	err := c.do(ctx, "POST", "/api/users/"+url.PathEscape(login)+"/tokens", nil, ApiToken{Name: name}, token, false)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (c *Client) DeleteToken(ctx context.Context, login, tokenId string) error {
+	return c.do(ctx, "DELETE", "/api/users/"+url.PathEscape(login)+"/tokens/"+url.PathEscape(tokenId), nil, nil, nil, true)
+}
+
+// ListTokens returns the api tokens of a user, without the tokens
+// themselves.
+func (c *Client) ListTokens(ctx context.Context, login string) ([]ApiToken, error) {
+	tokens := []ApiToken{}
+	if err := c.do(ctx, "GET", "/api/users/"+url.PathEscape(login)+"/tokens", nil, nil, &tokens, true); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// CreateSession exchanges Token for a session token.
+func (c *Client) CreateSession(ctx context.Context) (*Session, error) {
+	session := &Session{}
+	if err := c.do(ctx, "POST", "/api/sessions", nil, nil, session, false); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (c *Client) AddMeeting(ctx context.Context, meeting Meeting) (*Meeting, error) {
+	created := &Meeting{}
+	err := c.do(ctx, "POST", "/api/meetings", nil, meeting, created, false)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *Client) GetMeeting(ctx context.Context, meetingId string) (*Meeting, error) {
+	meeting := &Meeting{}
+	err := c.do(ctx, "GET", "/api/meetings/"+url.PathEscape(meetingId), nil, nil, meeting, true)
+	if err != nil {
+		return nil, err
+	}
+	return meeting, nil
+}
+
+// UpdateMeeting replaces the meeting, invitees are notified of the change.
+func (c *Client) UpdateMeeting(ctx context.Context, meetingId string, meeting Meeting) (*Meeting, error) {
+	updated := &Meeting{}
+	err := c.do(ctx, "PUT", "/api/meetings/"+url.PathEscape(meetingId), nil, meeting, updated, true)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteMeeting cancels the meeting, invitees are notified.
+func (c *Client) DeleteMeeting(ctx context.Context, meetingId string) error {
+	return c.do(ctx, "DELETE", "/api/meetings/"+url.PathEscape(meetingId), nil, nil, nil, true)
+}
+
+// UploadAttachment stores a file with the meeting, the server tells its type
+// from the content when mimeType is empty.
+func (c *Client) UploadAttachment(ctx context.Context, meetingId, name, mimeType string, file io.Reader) (*Attachment, error) {
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	query := url.Values{"name": {name}}
+	attachment := &Attachment{}
+	err := c.do(ctx, "POST", "/api/meetings/"+url.PathEscape(meetingId)+"/attachments", query, content{mimeType, file}, attachment, false)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// DownloadAttachment writes a file stored with the meeting to w.
+func (c *Client) DownloadAttachment(ctx context.Context, meetingId, attachmentId string, w io.Writer) error {
+	return c.do(ctx, "GET", "/api/meetings/"+url.PathEscape(meetingId)+"/attachments/"+url.PathEscape(attachmentId), nil, nil, w, true)
+}
+
+// DeleteAttachment removes a file from the meeting and deletes it.
+func (c *Client) DeleteAttachment(ctx context.Context, meetingId, attachmentId string) error {
+	return c.do(ctx, "DELETE", "/api/meetings/"+url.PathEscape(meetingId)+"/attachments/"+url.PathEscape(attachmentId), nil, nil, nil, true)
+}
+
+// CalendarFilter selects meetings by the calendars the users keep them in,
+// the zero value selects all of them.
+type CalendarFilter struct {
+	Calendars []string // ids of the calendars to include, all when empty
+	Exclude   []string // ids of the calendars to leave out
+}
+
+func (f CalendarFilter) apply(query url.Values) {
+	if len(f.Calendars) != 0 {
+		query.Set("calendars", strings.Join(f.Calendars, ","))
+	}
+	if len(f.Exclude) != 0 {
+		query.Set("excludeCalendars", strings.Join(f.Exclude, ","))
+	}
+}
+
+func (c *Client) ListMeetings(ctx context.Context, login string, startTime, endTime time.Time) ([]Meeting, error) {
+	return c.ListMeetingsIn(ctx, login, startTime, endTime, CalendarFilter{})
+}
+
+// ListMeetingsIn lists the meetings of a user in the calendars the filter
+// selects.
+func (c *Client) ListMeetingsIn(ctx context.Context, login string, startTime, endTime time.Time, filter CalendarFilter) ([]Meeting, error) {
+	return c.FindMeetings(ctx, login, startTime, endTime, MeetingQuery{CalendarFilter: filter})
+}
+
+// MeetingQuery selects meetings of a listing, the zero value selects all of
+// them.
+type MeetingQuery struct {
+	CalendarFilter
+	Role   string // "owner" or "invitee", any when empty
+	Status string // answer of the user, "notReviewed", "accepted" or "declined"
+	Text   string // contained in the title, description or location
+	Limit  int    // meetings per page, the default of the api when 0
+}
+
+func (q MeetingQuery) apply(query url.Values) {
+	q.CalendarFilter.apply(query)
+	for name, value := range map[string]string{"role": q.Role, "status": q.Status, "q": q.Text} {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	if q.Limit != 0 {
+		query.Set("limit", strconv.Itoa(q.Limit))
+	}
+}
+
+// MeetingPage is a page of a listing, Cursor requests the next one and is
+// empty on the last page.
+type MeetingPage struct {
+	Meetings []Meeting
+	Cursor   string
+}
+
+// ListMeetingsPage returns the page of the meetings of a user following
+// cursor, the first one when it is empty.
+func (c *Client) ListMeetingsPage(ctx context.Context, login string, startTime, endTime time.Time, q MeetingQuery, cursor string) (*MeetingPage, error) {
+	query := url.Values{
+		"startTime": {startTime.Format(dateLayout)},
+		"endTime":   {endTime.Format(dateLayout)},
+	}
+	q.apply(query)
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	page := &MeetingPage{Meetings: []Meeting{}}
+	header, err := c.exchange(ctx, "GET", "/api/users/"+url.PathEscape(login)+"/meetings", query, nil, &page.Meetings, true)
+	if err != nil {
+		return nil, err
+	}
+	page.Cursor = nextCursor(header)
+	return page, nil
+}
+
+// FindMeetings lists the meetings of a user the query selects, reading every
+// page.
+func (c *Client) FindMeetings(ctx context.Context, login string, startTime, endTime time.Time, q MeetingQuery) ([]Meeting, error) {
+	meetings := []Meeting{}
+	cursor := ""
+	for {
+		page, err := c.ListMeetingsPage(ctx, login, startTime, endTime, q, cursor)
+		if err != nil {
+			return nil, err
+		}
+		meetings = append(meetings, page.Meetings...)
+		if cursor = page.Cursor; cursor == "" {
+			return meetings, nil
+		}
+	}
+}
+
+// SearchQuery selects meetings by the words of their titles, descriptions
+// and locations, Text is required.
+type SearchQuery struct {
+	Text         string
+	Participants []string  // who all take part
+	From, To     time.Time // range the meetings overlap, unbounded when zero
+	Limit        int       // results, the default of the api when 0
+}
+
+// SearchMeetings returns the meetings the caller may see matching the
+// query, the most relevant first.
+func (c *Client) SearchMeetings(ctx context.Context, q SearchQuery) ([]Meeting, error) {
+	query := url.Values{"q": {q.Text}}
+	if len(q.Participants) != 0 {
+		query.Set("participants", strings.Join(q.Participants, ","))
+	}
+	if !q.From.IsZero() {
+		query.Set("from", q.From.Format(dateLayout))
+	}
+	if !q.To.IsZero() {
+		query.Set("to", q.To.Format(dateLayout))
+	}
+	if q.Limit != 0 {
+		query.Set("limit", strconv.Itoa(q.Limit))
+	}
+	meetings := []Meeting{}
+	if err := c.do(ctx, "GET", "/api/meetings/search", query, nil, &meetings, true); err != nil {
+		return nil, err
+	}
+	return meetings, nil
+}
+
+// nextCursor returns the cursor of the page the Link header links as next.
+func nextCursor(header http.Header) string {
+	for _, link := range strings.Split(header.Get("Link"), ",") {
+		target, params, ok := strings.Cut(link, ";")
+		if !ok || !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+		if next, err := url.Parse(strings.Trim(strings.TrimSpace(target), "<>")); err == nil {
+			return next.Query().Get("cursor")
+		}
+	}
+	return ""
+}
+
+// SetReminders replaces the default reminders of a user.
+func (c *Client) SetReminders(ctx context.Context, login string, reminders []Reminder) (*User, error) {
+	user := &User{}
+	if err := c.do(ctx, "PUT", "/api/users/"+url.PathEscape(login)+"/reminders", nil, reminders, user, true); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// SetShares replaces the users login shares their calendar with.
+func (c *Client) SetShares(ctx context.Context, login string, shares []Share) (*User, error) {
+	user := &User{}
+	if err := c.do(ctx, "PUT", "/api/users/"+url.PathEscape(login)+"/shares", nil, shares, user, true); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (c *Client) CreateCalendar(ctx context.Context, login string, calendar Calendar) (*Calendar, error) {
+	created := &Calendar{}
+	if err := c.do(ctx, "POST", "/api/users/"+url.PathEscape(login)+"/calendars", nil, calendar, created, false); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListCalendars returns the calendars of a user, the default one first.
+func (c *Client) ListCalendars(ctx context.Context, login string) ([]Calendar, error) {
+	calendars := []Calendar{}
+	if err := c.do(ctx, "GET", "/api/users/"+url.PathEscape(login)+"/calendars", nil, nil, &calendars, true); err != nil {
+		return nil, err
+	}
+	return calendars, nil
+}
+
+func (c *Client) UpdateCalendar(ctx context.Context, login, calendarId string, calendar Calendar) (*Calendar, error) {
+	updated := &Calendar{}
+	if err := c.do(ctx, "PUT", "/api/users/"+url.PathEscape(login)+"/calendars/"+url.PathEscape(calendarId), nil, calendar, updated, true); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteCalendar deletes a calendar, its meetings move to the default one.
+func (c *Client) DeleteCalendar(ctx context.Context, login, calendarId string) error {
+	return c.do(ctx, "DELETE", "/api/users/"+url.PathEscape(login)+"/calendars/"+url.PathEscape(calendarId), nil, nil, nil, true)
+}
+
+func (c *Client) CreateGroup(ctx context.Context, group Group) (*Group, error) {
+	created := &Group{}
+	if err := c.do(ctx, "POST", "/api/groups", nil, group, created, false); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *Client) GetGroup(ctx context.Context, name string) (*Group, error) {
+	group := &Group{}
+	if err := c.do(ctx, "GET", "/api/groups/"+url.PathEscape(name), nil, nil, group, true); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (c *Client) ListGroups(ctx context.Context) ([]Group, error) {
+	groups := []Group{}
+	if err := c.do(ctx, "GET", "/api/groups", nil, nil, &groups, true); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// UpdateGroup replaces the members and nested groups of a group, meetings
+// inviting it invite its new members.
+func (c *Client) UpdateGroup(ctx context.Context, name string, group Group) (*Group, error) {
+	updated := &Group{}
+	if err := c.do(ctx, "PUT", "/api/groups/"+url.PathEscape(name), nil, group, updated, true); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *Client) DeleteGroup(ctx context.Context, name string) error {
+	return c.do(ctx, "DELETE", "/api/groups/"+url.PathEscape(name), nil, nil, nil, true)
+}
+
+// AddWebhook subscribes an url to events, the Secret signing the deliveries
+// is only returned here.
+func (c *Client) AddWebhook(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	created := &Webhook{}
+	if err := c.do(ctx, "POST", "/api/webhooks", nil, webhook, created, false); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListWebhooks returns the webhooks of the caller. The admin gets the ones of
+// login, or all of them when it is empty.
+func (c *Client) ListWebhooks(ctx context.Context, login string) ([]Webhook, error) {
+	query := url.Values{}
+	if login != "" {
+		query.Set("login", login)
+	}
+	webhooks := []Webhook{}
+	if err := c.do(ctx, "GET", "/api/webhooks", query, nil, &webhooks, true); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (c *Client) GetWebhook(ctx context.Context, webhookId string) (*Webhook, error) {
+	webhook := &Webhook{}
+	if err := c.do(ctx, "GET", "/api/webhooks/"+url.PathEscape(webhookId), nil, nil, webhook, true); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// DeleteWebhook unsubscribes a webhook, its pending deliveries are dropped.
+func (c *Client) DeleteWebhook(ctx context.Context, webhookId string) error {
+	return c.do(ctx, "DELETE", "/api/webhooks/"+url.PathEscape(webhookId), nil, nil, nil, true)
+}
+
+// ListDeliveries returns the latest deliveries of a webhook, of any status
+// when status is empty and as many as the api returns by default when limit
+// is 0.
+func (c *Client) ListDeliveries(ctx context.Context, webhookId string, status DeliveryStatus, limit int) ([]Delivery, error) {
+	query := url.Values{}
+	if status != "" {
+		query.Set("status", string(status))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	deliveries := []Delivery{}
+	if err := c.do(ctx, "GET", "/api/webhooks/"+url.PathEscape(webhookId)+"/deliveries", query, nil, &deliveries, true); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// SyncMeetings returns the changes of the meetings of a user since token, or
+// all of them when token is empty. Pass the SyncToken of the result to the
+// next call, right away while More is set.
+func (c *Client) SyncMeetings(ctx context.Context, login, token string) (*SyncResult, error) {
+	query := url.Values{}
+	if token != "" {
+		query.Set("token", token)
+	}
+	var result SyncResult
+	if err := c.do(ctx, "GET", "/api/users/"+url.PathEscape(login)+"/sync", query, nil, &result, true); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ImportCalendar imports the events of an iCalendar file into the calendar
+// of a user, events imported before are updated by their UID.
+func (c *Client) ImportCalendar(ctx context.Context, login string, ics io.Reader) (*ImportReport, error) {
+	report := &ImportReport{}
+	err := c.do(ctx, "POST", "/api/users/"+url.PathEscape(login)+"/import", nil, content{"text/calendar", ics}, report, false)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ExportCalendar writes the meetings of a user to w as an iCalendar file.
+func (c *Client) ExportCalendar(ctx context.Context, login string, w io.Writer) error {
+	return c.do(ctx, "GET", "/api/users/"+url.PathEscape(login)+"/calendar.ics", nil, nil, w, true)
+}
+
+// FindSlot returns the start of the first slot of the given duration, at or
+// after startTime, in which none of the users is busy.
+func (c *Client) FindSlot(ctx context.Context, logins []string, startTime time.Time, duration time.Duration) (time.Time, error) {
+	return c.FindSlotIn(ctx, logins, startTime, duration, CalendarFilter{})
+}
+
+// FindSlotIn is FindSlot counting only the meetings in the calendars the
+// filter selects, e.g. to leave personal calendars out of work slots.
+func (c *Client) FindSlotIn(ctx context.Context, logins []string, startTime time.Time, duration time.Duration, filter CalendarFilter) (time.Time, error) {
+	query := url.Values{
+		"startTime":       {startTime.Format(dateLayout)},
+		"durationMinutes": {strconv.Itoa(int(duration / time.Minute))},
+		"logins":          {strings.Join(logins, ",")},
+	}
+	filter.apply(query)
+	slot := map[string]string{}
+	if err := c.do(ctx, "GET", "/api/findSlot", query, nil, &slot, true); err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(dateLayout, slot["startTime"])
+}
+
+func (c *Client) AcceptMeeting(ctx context.Context, meetingId, login string, decline bool) (*Meeting, error) {
+	return c.AcceptMeetingIn(ctx, meetingId, login, "", decline)
+}
+
+// AcceptMeetingIn answers an invitation and keeps the meeting in a calendar
+// of the invitee, "default" for the default calendar.
+func (c *Client) AcceptMeetingIn(ctx context.Context, meetingId, login, calendarId string, decline bool) (*Meeting, error) {
+	request := acceptMeetingRequest{
+		MeetingId:  meetingId,
+		Login:      login,
+		Decline:    decline,
+		CalendarId: calendarId,
+	}
+	meeting := &Meeting{}
+	if err := c.do(ctx, "POST", "/api/acceptMeeting", nil, request, meeting, true); err != nil {
+		return nil, err
+	}
+	return meeting, nil
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	return c.do(ctx, "GET", "/api/openapi.json", nil, nil, nil, false)
+}
+
+// content is a request body that isn't json, e.g. a file. It is read once,
+// requests sending it aren't retried.
+type content struct {
+	mediaType string
+	body      io.Reader
+}
+
+// do sends the request and decodes a successful response into out.
+// Idempotent requests are retried on transport errors and on responses
+// signalling a temporary failure.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out any, idempotent bool) error {
+	_, err := c.exchange(ctx, method, path, query, in, out, idempotent)
+	return err
+}
+
+// exchange is do, it also returns the headers of the response. in is sent as
+// json unless it is content, the response is copied to out when it is an
+// io.Writer and decoded as json otherwise.
+func (c *Client) exchange(ctx context.Context, method, path string, query url.Values, in, out any, idempotent bool) (http.Header, error) {
+	var body []byte
+	mediaType := ""
+	raw, isContent := in.(content)
+	switch {
+	case isContent:
+		mediaType = raw.mediaType
+		idempotent = false
+	case in != nil:
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return nil, fmt.Errorf("calendar api: encode request: %w", err)
+		}
+		mediaType = "application/json; charset=UTF-8"
+	}
+	uri := c.Endpoint + path
+	if len(query) != 0 {
+		uri += "?" + query.Encode()
+	}
+	attempts := 1
+	if idempotent && c.Retry.MaxAttempts > 1 {
+		attempts = c.Retry.MaxAttempts
+	}
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt != 0 {
+			if err := sleep(ctx, c.backoff(attempt)); err != nil {
+				return nil, err
+			}
+		}
+		var reader io.Reader
+		switch {
+		case isContent:
+			reader = raw.body
+		case body != nil:
+			reader = bytes.NewReader(body)
+		}
+		var header http.Header
+		var retryable bool
+		header, retryable, err = c.attempt(ctx, method, uri, mediaType, reader, out)
+		if err == nil || !retryable {
+			return header, err
+		}
+	}
+	return nil, err
+}
+
+func (c *Client) attempt(ctx context.Context, method, uri, mediaType string, body io.Reader, out any) (http.Header, bool, error) {
+	accept := "application/json"
+	if _, ok := out.(io.Writer); ok {
+		accept = "*/*"
+	}
+	response, retryable, err := c.send(ctx, method, uri, mediaType, body, accept)
+	if err != nil {
+		return nil, retryable, err
+	}
+	defer response.Body.Close()
+	switch out := out.(type) {
+	case nil:
+		io.Copy(io.Discard, response.Body)
+	case io.Writer:
+		if _, err := io.Copy(out, response.Body); err != nil {
+			return nil, false, fmt.Errorf("calendar api: read response: %w", err)
+		}
+	default:
+		if err := json.NewDecoder(response.Body).Decode(out); err != nil {
+			return nil, false, fmt.Errorf("calendar api: decode response: %w", err)
+		}
+	}
+	return response.Header, false, nil
+}
+
+// send sends one request and returns the response when it succeeded, its
+// body is left to the caller to close.
+func (c *Client) send(ctx context.Context, method, uri, mediaType string, body io.Reader, accept string) (*http.Response, bool, error) {
+	request, err := http.NewRequestWithContext(ctx, method, uri, body)
+	if err != nil {
+		return nil, false, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", mediaType)
+	}
+	request.Header.Set("Accept", accept)
+	if c.Token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	httpClient := c.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, ctx.Err() == nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		defer response.Body.Close()
+		return nil, isRetryableStatus(response.StatusCode), decodeError(response)
+	}
+	return response, false, nil
+}
+
+func decodeError(response *http.Response) error {
+	apiErr := &Error{StatusCode: response.StatusCode, RequestId: response.Header.Get("X-Request-Id")}
+	raw, _ := io.ReadAll(response.Body)
+	envelope := apiError{}
+	if json.Unmarshal(raw, &envelope) == nil && envelope.Code != "" {
+		apiErr.Code = envelope.Code
+		apiErr.Message = envelope.Message
+		apiErr.Details = envelope.Details
+		if envelope.RequestId != "" {
+			apiErr.RequestId = envelope.RequestId
+		}
+		return apiErr
+	}
+	apiErr.Message = strings.TrimSpace(string(raw))
+	if apiErr.Message == "" {
+		apiErr.Message = response.Status
+	}
+	return apiErr
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	backoff := c.Retry.InitialBackoff << (attempt - 1)
+	if c.Retry.MaxBackoff > 0 && (backoff > c.Retry.MaxBackoff || backoff <= 0) {
+		backoff = c.Retry.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// jitter keeps concurrent clients from retrying in lockstep
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
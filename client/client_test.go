@@ -0,0 +1,345 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, router *mux.Router) *Client {
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	c := New(server.URL)
+	c.Retry.InitialBackoff = time.Millisecond
+	c.Retry.MaxBackoff = time.Millisecond
+	return c
+}
+
+func writeApiError(w http.ResponseWriter, status int, code ErrorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", "req-1")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(apiError{Code: code, Message: message, RequestId: "req-1"})
+}
+
+func TestListMeetingsEscapesQuery(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/users/{login}/meetings", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "bob smith", mux.Vars(r)["login"])
+		require.Equal(t, "2023-03-07T16:00:00+01:00", r.URL.Query().Get("startTime"))
+		json.NewEncoder(w).Encode([]Meeting{{Id: "1", Owner: "bob smith"}})
+	}).Methods("GET")
+	c := newTestClient(t, router)
+	zone := time.FixedZone("", 3600)
+	meetings, err := c.ListMeetings(context.Background(), "bob smith",
+		time.Date(2023, 3, 7, 16, 0, 0, 0, zone), time.Date(2023, 3, 7, 19, 0, 0, 0, zone))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(meetings))
+	require.Equal(t, "1", meetings[0].Id)
+}
+
+func TestListMeetingsFollowsPages(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/users/{login}/meetings", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "invitee", r.URL.Query().Get("role"))
+		require.Equal(t, "2", r.URL.Query().Get("limit"))
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", `</api/users/bob/meetings?cursor=c1&limit=2&role=invitee>; rel="next"`)
+			json.NewEncoder(w).Encode([]Meeting{{Id: "1"}, {Id: "2"}})
+		case "c1":
+			json.NewEncoder(w).Encode([]Meeting{{Id: "3"}})
+		default:
+			t.Fatalf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	}).Methods("GET")
+	c := newTestClient(t, router)
+	start := time.Date(2023, 3, 7, 0, 0, 0, 0, time.UTC)
+	query := MeetingQuery{Role: "invitee", Limit: 2}
+
+	page, err := c.ListMeetingsPage(context.Background(), "bob", start, start.AddDate(1, 0, 0), query, "")
+	require.NoError(t, err)
+	require.Len(t, page.Meetings, 2)
+	require.Equal(t, "c1", page.Cursor)
+	meetings, err := c.FindMeetings(context.Background(), "bob", start, start.AddDate(1, 0, 0), query)
+	require.NoError(t, err)
+	require.Len(t, meetings, 3)
+	require.Equal(t, "3", meetings[2].Id)
+}
+
+func TestErrorsAreTyped(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/meetings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		writeApiError(w, http.StatusNotFound, CodeNotFound, "meeting not found")
+	}).Methods("GET")
+	router.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
+		writeApiError(w, http.StatusConflict, CodeConflict, "user exists")
+	}).Methods("POST")
+	c := newTestClient(t, router)
+
+	_, err := c.GetMeeting(context.Background(), "640a4862377457548608f50a")
+	require.ErrorIs(t, err, ErrNotFound)
+	apiErr := &Error{}
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "req-1", apiErr.RequestId)
+
+	_, err = c.AddUser(context.Background(), "bob")
+	require.ErrorIs(t, err, ErrConflict)
+	require.False(t, errors.Is(err, ErrNotFound))
+}
+
+func TestIdempotentRequestsAreRetried(t *testing.T) {
+	var calls int32
+	router := mux.NewRouter()
+	router.HandleFunc("/api/findSlot", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"startTime": "2023-03-07T17:30:00Z"})
+	}).Methods("GET")
+	c := newTestClient(t, router)
+	slot, err := c.FindSlot(context.Background(), []string{"alice", "bob"}, time.Date(2023, 3, 7, 15, 0, 0, 0, time.UTC), 30*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 3, 7, 17, 30, 0, 0, time.UTC), slot.UTC())
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestCreatesAreNotRetried(t *testing.T) {
+	var calls int32
+	router := mux.NewRouter()
+	router.HandleFunc("/api/meetings", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}).Methods("POST")
+	c := newTestClient(t, router)
+	_, err := c.AddMeeting(context.Background(), Meeting{Owner: "bob"})
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestContextCancelsRetries(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/meetings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}).Methods("GET")
+	c := newTestClient(t, router)
+	c.Retry = RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := c.GetMeeting(ctx, "1")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestListTokens(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/users/{login}/tokens", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "bob", mux.Vars(r)["login"])
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		json.NewEncoder(w).Encode([]ApiToken{{Id: "t1", Login: "bob", Name: "laptop"}})
+	}).Methods("GET")
+	c := newTestClient(t, router)
+	c.Token = "secret"
+	tokens, err := c.ListTokens(context.Background(), "bob")
+	require.NoError(t, err)
+	require.Equal(t, []ApiToken{{Id: "t1", Login: "bob", Name: "laptop"}}, tokens)
+}
+
+func TestWebhooks(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		webhook := Webhook{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&webhook))
+		require.Equal(t, []EventType{MeetingCreated}, webhook.Events)
+		webhook.Id, webhook.Secret = "w1", "s3cret"
+		json.NewEncoder(w).Encode(webhook)
+	}).Methods("POST")
+	router.HandleFunc("/api/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "bob", r.URL.Query().Get("login"))
+		json.NewEncoder(w).Encode([]Webhook{{Id: "w1", Login: "bob"}})
+	}).Methods("GET")
+	router.HandleFunc("/api/webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Webhook{Id: mux.Vars(r)["id"]})
+	}).Methods("GET")
+	router.HandleFunc("/api/webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("DELETE")
+	router.HandleFunc("/api/webhooks/{id}/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "failed", r.URL.Query().Get("status"))
+		require.Equal(t, "10", r.URL.Query().Get("limit"))
+		json.NewEncoder(w).Encode([]Delivery{{Id: "d1", WebhookId: mux.Vars(r)["id"], Status: DeliveryFailed}})
+	}).Methods("GET")
+	c := newTestClient(t, router)
+	ctx := context.Background()
+
+	created, err := c.AddWebhook(ctx, Webhook{Url: "https://example.com/hook", Events: []EventType{MeetingCreated}})
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", created.Secret)
+	webhooks, err := c.ListWebhooks(ctx, "bob")
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	webhook, err := c.GetWebhook(ctx, "w1")
+	require.NoError(t, err)
+	require.Equal(t, "w1", webhook.Id)
+	deliveries, err := c.ListDeliveries(ctx, "w1", DeliveryFailed, 10)
+	require.NoError(t, err)
+	require.Equal(t, "w1", deliveries[0].WebhookId)
+	require.NoError(t, c.DeleteWebhook(ctx, "w1"))
+}
+
+func TestImportExport(t *testing.T) {
+	const ics = "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+	router := mux.NewRouter()
+	router.HandleFunc("/api/users/{login}/import", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "text/calendar", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, ics, string(body))
+		json.NewEncoder(w).Encode(ImportReport{Created: 1, Events: []ImportedEvent{{Uid: "1@example.com", Status: ImportCreated}}})
+	}).Methods("POST")
+	router.HandleFunc("/api/users/{login}/calendar.ics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		io.WriteString(w, ics)
+	}).Methods("GET")
+	c := newTestClient(t, router)
+
+	report, err := c.ImportCalendar(context.Background(), "bob", strings.NewReader(ics))
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Created)
+	require.Equal(t, ImportCreated, report.Events[0].Status)
+	exported := &bytes.Buffer{}
+	require.NoError(t, c.ExportCalendar(context.Background(), "bob", exported))
+	require.Equal(t, ics, exported.String())
+}
+
+func TestAttachments(t *testing.T) {
+	files := map[string]string{}
+	router := mux.NewRouter()
+	router.HandleFunc("/api/meetings/{id}/attachments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "notes.txt", r.URL.Query().Get("name"))
+		require.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		files["f1"] = string(body)
+		json.NewEncoder(w).Encode(Attachment{Id: "f1", Name: "notes.txt", MimeType: "text/plain", Size: int64(len(body))})
+	}).Methods("POST")
+	router.HandleFunc("/api/meetings/{id}/attachments/{attachmentId}", func(w http.ResponseWriter, r *http.Request) {
+		file, ok := files[mux.Vars(r)["attachmentId"]]
+		if !ok {
+			writeApiError(w, http.StatusNotFound, CodeNotFound, "attachment not found")
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		io.WriteString(w, file)
+	}).Methods("GET")
+	router.HandleFunc("/api/meetings/{id}/attachments/{attachmentId}", func(w http.ResponseWriter, r *http.Request) {
+		delete(files, mux.Vars(r)["attachmentId"])
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("DELETE")
+	c := newTestClient(t, router)
+	ctx := context.Background()
+
+	attachment, err := c.UploadAttachment(ctx, "m1", "notes.txt", "text/plain", strings.NewReader("agenda"))
+	require.NoError(t, err)
+	require.Equal(t, int64(6), attachment.Size)
+	downloaded := &bytes.Buffer{}
+	require.NoError(t, c.DownloadAttachment(ctx, "m1", attachment.Id, downloaded))
+	require.Equal(t, "agenda", downloaded.String())
+	require.NoError(t, c.DeleteAttachment(ctx, "m1", attachment.Id))
+	require.ErrorIs(t, c.DownloadAttachment(ctx, "m1", attachment.Id, io.Discard), ErrNotFound)
+}
+
+func TestStreamMeetings(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/users/{login}/stream", func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["login"] != "bob" {
+			writeApiError(w, http.StatusNotFound, CodeNotFound, "user not found")
+			return
+		}
+		require.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		io.WriteString(w, "retry: 3000\n\n: keep-alive\n\n")
+		for _, event := range []Event{{Id: "e1", Type: MeetingCreated}, {Id: "e2", Type: MeetingDeleted}} {
+			data, _ := json.Marshal(event)
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+		}
+	}).Methods("GET")
+	c := newTestClient(t, router)
+
+	stream, err := c.StreamMeetings(context.Background(), "bob")
+	require.NoError(t, err)
+	defer stream.Close()
+	event, err := stream.Next()
+	require.NoError(t, err)
+	require.Equal(t, "e1", event.Id)
+	require.Equal(t, MeetingCreated, event.Type)
+	event, err = stream.Next()
+	require.NoError(t, err)
+	require.Equal(t, MeetingDeleted, event.Type)
+	_, err = stream.Next()
+	require.Equal(t, io.EOF, err)
+
+	_, err = c.StreamMeetings(context.Background(), "nobody")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+// TestTypesMatchSpec makes sure that the types have the fields of the
+// definitions of the api, and no others.
+func TestTypesMatchSpec(t *testing.T) {
+	raw, err := os.ReadFile("../service/openapi.json")
+	require.NoError(t, err)
+	var document struct {
+		Definitions map[string]struct {
+			Properties map[string]any `json:"properties"`
+		} `json:"definitions"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &document))
+	for name, body := range map[string]any{
+		"AcceptMeetingRequest": acceptMeetingRequest{},
+		"ApiToken":             ApiToken{},
+		"Attachment":           Attachment{},
+		"Calendar":             Calendar{},
+		"Delivery":             Delivery{},
+		"DialIn":               DialIn{},
+		"Error":                apiError{},
+		"ErrorDetail":          ErrorDetail{},
+		"Event":                Event{},
+		"Group":                Group{},
+		"ImportReport":         ImportReport{},
+		"Invitation":           Invitation{},
+		"Meeting":              Meeting{},
+		"Reminder":             Reminder{},
+		"Session":              Session{},
+		"Share":                Share{},
+		"SyncResult":           SyncResult{},
+		"User":                 User{},
+		"UserUpdate":           UserUpdate{},
+		"Webhook":              Webhook{},
+	} {
+		definition, ok := document.Definitions[name]
+		require.True(t, ok, name)
+		fields := reflect.TypeOf(body)
+		tags := []string{}
+		for i := 0; i < fields.NumField(); i++ {
+			tags = append(tags, strings.Split(fields.Field(i).Tag.Get("json"), ",")[0])
+		}
+		properties := []string{}
+		for property := range definition.Properties {
+			properties = append(properties, property)
+		}
+		require.ElementsMatch(t, properties, tags, name)
+	}
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrValidation   = errors.New("validation failed")
+	ErrInternal     = errors.New("internal server error")
+	// ErrSyncTokenExpired asks to sync again from scratch, without a token.
+	ErrSyncTokenExpired = errors.New("sync token expired")
+)
+
+var codeErrors = map[ErrorCode]error{
+	CodeBadRequest:       ErrBadRequest,
+	CodeUnauthorized:     ErrUnauthorized,
+	CodeForbidden:        ErrForbidden,
+	CodeNotFound:         ErrNotFound,
+	CodeConflict:         ErrConflict,
+	CodeValidationFailed: ErrValidation,
+	CodeInternal:         ErrInternal,
+	CodeSyncTokenExpired: ErrSyncTokenExpired,
+}
+
+// Error is returned for every non-2xx response. It matches one of the Err*
+// sentinels with errors.Is according to the api error code.
+type Error struct {
+	StatusCode int
+	Code       ErrorCode
+	Message    string
+	Details    []ErrorDetail
+	RequestId  string
+}
+
+func (e *Error) Error() string {
+	if e.RequestId != "" {
+		return fmt.Sprintf("calendar api: %d %s: %s (request %s)", e.StatusCode, e.Code, e.Message, e.RequestId)
+	}
+	return fmt.Sprintf("calendar api: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+func (e *Error) Is(target error) bool {
+	return codeErrors[e.Code] == target
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// maxEventSize bounds the lines of a stream, events carry whole meetings.
+const maxEventSize = 1 << 20
+
+// EventStream reads the events of a stream opened by StreamMeetings.
+type EventStream struct {
+	body  io.ReadCloser
+	lines *bufio.Scanner
+}
+
+// StreamMeetings subscribes to the events of the meetings of a user as they
+// happen. The stream ends when ctx is done or it is closed.
+func (c *Client) StreamMeetings(ctx context.Context, login string) (*EventStream, error) {
+	uri := c.Endpoint + "/api/users/" + url.PathEscape(login) + "/stream"
+	response, _, err := c.send(ctx, "GET", uri, "", nil, "text/event-stream")
+	if err != nil {
+		return nil, err
+	}
+	lines := bufio.NewScanner(response.Body)
+	lines.Buffer(make([]byte, 0, 64<<10), maxEventSize)
+	return &EventStream{body: response.Body, lines: lines}, nil
+}
+
+// Next blocks until the next event, it returns io.EOF when the server ended
+// the stream.
+func (s *EventStream) Next() (*Event, error) {
+	data := []string{}
+	for s.lines.Scan() {
+		line := s.lines.Text()
+		if line == "" {
+			if len(data) == 0 {
+				continue
+			}
+			event := &Event{}
+			if err := json.Unmarshal([]byte(strings.Join(data, "\n")), event); err != nil {
+				return nil, fmt.Errorf("calendar api: decode event: %w", err)
+			}
+			return event, nil
+		}
+		// the id and type of the event are in the data too, comments keep
+		// the connection alive
+		if field, value, _ := strings.Cut(line, ":"); field == "data" {
+			data = append(data, strings.TrimPrefix(value, " "))
+		}
+	}
+	if err := s.lines.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (s *EventStream) Close() error {
+	return s.body.Close()
+}
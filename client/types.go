@@ -0,0 +1,301 @@
+package client
+
+import "time"
+
+// The types of the requests and responses of the api, as openapi.json
+// describes them.
+
+type AcceptedChoice uint8
+
+var (
+	NotReviewed AcceptedChoice = 0
+	Accepted    AcceptedChoice = 1
+	Declined    AcceptedChoice = 2
+)
+
+type ReoccureanceChoice uint8
+
+var (
+	NoReoccurence ReoccureanceChoice = 0
+	Daily         ReoccureanceChoice = 1
+	WorkingDays   ReoccureanceChoice = 2
+	Weekly        ReoccureanceChoice = 3
+	Monthly       ReoccureanceChoice = 4
+	Yearly        ReoccureanceChoice = 5
+)
+
+type ReminderChannel string
+
+var (
+	ChannelLog     ReminderChannel = "log"
+	ChannelEmail   ReminderChannel = "email"
+	ChannelWebhook ReminderChannel = "webhook"
+)
+
+// Visibility is who sees the details of a meeting, others only see when it
+// is busy.
+type Visibility string
+
+var (
+	VisibilityPublic       Visibility = "public"       // every user
+	VisibilityPrivate      Visibility = "private"      // participants and users they share details with
+	VisibilityConfidential Visibility = "confidential" // participants only
+)
+
+// ShareLevel is what a user shares of their calendar, every level includes
+// the ones before it.
+type ShareLevel string
+
+var (
+	ShareFreeBusy ShareLevel = "freeBusy" // when the user is busy
+	ShareDetails  ShareLevel = "details"  // the details of private meetings
+	ShareEdit     ShareLevel = "edit"     // create, edit and delete meetings the user owns
+	ShareRespond  ShareLevel = "respond"  // answer invitations for the user
+)
+
+// Share grants access to the calendar of a user.
+type Share struct {
+	Grantee string     `json:"grantee"`
+	Level   ShareLevel `json:"level"`
+}
+
+type Reminder struct {
+	OffsetMinutes int             `json:"offsetMinutes"` // before the start of every occurrence
+	Channel       ReminderChannel `json:"channel"`
+}
+
+type Invitation struct {
+	Invitee  string         `json:"invitee"`
+	Accepted AcceptedChoice `json:"accepted"`
+	// RespondedBy is who answered for the invitee, empty when they did.
+	RespondedBy string `json:"respondedBy,omitempty"`
+	CalendarId  string `json:"calendarId,omitempty"` // of the invitee, the default calendar when empty
+	Group       string `json:"group,omitempty"`      // of Groups the invitee is a member of, empty when invited by login
+}
+
+// Group is a team of users, and of the members of nested groups. It is
+// invited as "team:" followed by its name.
+type Group struct {
+	Id      string   `json:"id,omitempty"`
+	Name    string   `json:"name"`
+	Owner   string   `json:"owner"`
+	Members []string `json:"members"`
+	Groups  []string `json:"groups,omitempty"` // names of nested groups
+}
+
+// Calendar groups meetings of a user. Every user also has a default
+// calendar, with the id "default".
+type Calendar struct {
+	Id         string     `json:"id,omitempty"`
+	Owner      string     `json:"owner"`
+	Name       string     `json:"name"`
+	Color      string     `json:"color,omitempty"`      // #rrggbb
+	Visibility Visibility `json:"visibility,omitempty"` // of its meetings without one
+}
+
+// Attachment references a file of a meeting, which is stored elsewhere, or
+// with the meeting, see UploadAttachment.
+type Attachment struct {
+	Id       string `json:"id,omitempty"` // of the file, when it is stored with the meeting
+	Name     string `json:"name"`
+	Url      string `json:"url"`
+	MimeType string `json:"mimeType,omitempty"`
+	Size     int64  `json:"size,omitempty"` // in bytes, of stored files
+}
+
+// DialIn joins the call of a meeting by phone.
+type DialIn struct {
+	Number string `json:"number"`
+	Pin    string `json:"pin,omitempty"`
+}
+
+type Meeting struct {
+	Id            string             `json:"id,omitempty"`
+	Uid           string             `json:"uid,omitempty"` // iCalendar UID of imported meetings
+	Owner         string             `json:"owner,omitempty"`
+	Invited       []Invitation       `json:"invited"`
+	Groups        []string           `json:"groups,omitempty"` // whose members are invited while they are members
+	StartTime     time.Time          `json:"startTime"`
+	EndTime       time.Time          `json:"endTime"`
+	Reoccurance   ReoccureanceChoice `json:"reoccurance"`
+	ReoccurUntil  *time.Time         `json:"reoccurUntil,omitempty"`
+	ExDates       []time.Time        `json:"exDates,omitempty"`  // starts of cancelled occurrences
+	TimeZone      string             `json:"timeZone,omitempty"` // IANA name, occurrences keep their local time in it, UTC when empty
+	Title         string             `json:"title,omitempty"`
+	Description   string             `json:"description"`
+	Location      string             `json:"location,omitempty"`
+	ConferenceUrl string             `json:"conferenceUrl,omitempty"` // joins the video call of the meeting
+	DialIns       []DialIn           `json:"dialIns,omitempty"`       // of calls of the conference provider
+	Attachments   []Attachment       `json:"attachments,omitempty"`
+	Visibility    Visibility         `json:"visibility,omitempty"` // the one of the calendar when empty
+	CalendarId    string             `json:"calendarId,omitempty"` // of the owner, the default calendar when empty
+	Sequence      int                `json:"sequence"`             // iTIP revision, incremented by every change of the organizer
+	// Version orders all changes of meetings, see SyncMeetings.
+	Version   int64     `json:"version"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	UpdatedBy string    `json:"updatedBy,omitempty"` // who made the last change, the owner or an editor
+	Deleted   bool      `json:"deleted,omitempty"`
+	// Reminders apply to every participant, instead of their own defaults.
+	Reminders []Reminder `json:"reminders,omitempty"`
+	// Busy is set when only the time of the meeting is shown, to users who
+	// don't take part in it.
+	Busy bool `json:"busy,omitempty"`
+}
+
+type User struct {
+	Id          string `json:"id,omitempty"`
+	Login       string `json:"login"`
+	DisplayName string `json:"displayName,omitempty"`
+	Email       string `json:"email,omitempty"`    // invitations are mailed here, defaults to the calendar address
+	TimeZone    string `json:"timeZone,omitempty"` // IANA name, mails show times in it, UTC when empty
+	// Reminders are the defaults for meetings without reminders of their own.
+	Reminders []Reminder `json:"reminders,omitempty"`
+	Shares    []Share    `json:"shares,omitempty"`
+	Token     string     `json:"token,omitempty"` // first api token, only returned on creation
+}
+
+// UserUpdate changes the profile of a user, fields which are nil are kept
+// and empty ones cleared.
+type UserUpdate struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	TimeZone    *string `json:"timeZone,omitempty"`
+}
+
+type acceptMeetingRequest struct {
+	MeetingId  string `json:"meetingId"`
+	Login      string `json:"login,omitempty"`
+	Decline    bool   `json:"decline"`
+	CalendarId string `json:"calendarId,omitempty"`
+}
+
+type ApiToken struct {
+	Id         string     `json:"id,omitempty"`
+	Login      string     `json:"login"`
+	Name       string     `json:"name"`
+	Token      string     `json:"token,omitempty"` // only returned on creation
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+type Session struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type SyncResult struct {
+	Created   []Meeting `json:"created"`
+	Updated   []Meeting `json:"updated"`
+	Deleted   []string  `json:"deleted"`
+	SyncToken string    `json:"syncToken"`
+	More      bool      `json:"more"` // another page is ready, sync again with SyncToken right away
+}
+
+type ImportStatus string
+
+var (
+	ImportCreated ImportStatus = "created"
+	ImportUpdated ImportStatus = "updated"
+	ImportSkipped ImportStatus = "skipped"
+)
+
+type ImportedEvent struct {
+	Uid       string       `json:"uid"`
+	Status    ImportStatus `json:"status"`
+	MeetingId string       `json:"meetingId,omitempty"`
+	Reason    string       `json:"reason,omitempty"`
+	Warnings  []string     `json:"warnings,omitempty"`
+}
+
+type ImportReport struct {
+	Created int             `json:"created"`
+	Updated int             `json:"updated"`
+	Skipped int             `json:"skipped"`
+	Events  []ImportedEvent `json:"events"`
+}
+
+type EventType string
+
+var (
+	MeetingCreated   EventType = "meeting.created"
+	MeetingUpdated   EventType = "meeting.updated"
+	MeetingDeleted   EventType = "meeting.deleted"
+	MeetingResponded EventType = "meeting.responded"
+	MeetingReminder  EventType = "meeting.reminder"
+	// MeetingRemoved tells a participant a change dropped them from the
+	// meeting, which they may not see anymore.
+	MeetingRemoved EventType = "meeting.removed"
+)
+
+// Event describes a change of a meeting, it is what webhooks and streams
+// receive.
+type Event struct {
+	Id      string    `json:"id"`
+	Type    EventType `json:"type"`
+	Time    time.Time `json:"time"`
+	Meeting Meeting   `json:"meeting"`
+	Login   string    `json:"login,omitempty"` // the invitee who responded, who is reminded, or who was removed
+}
+
+// Webhook subscribes an url to the events of the meetings of a user, or of
+// all meetings when Login is empty.
+type Webhook struct {
+	Id        string      `json:"id,omitempty"`
+	Login     string      `json:"login,omitempty"`
+	Url       string      `json:"url"`
+	Events    []EventType `json:"events,omitempty"` // all events when empty
+	Secret    string      `json:"secret,omitempty"` // only returned on creation
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+type DeliveryStatus string
+
+var (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Delivery is an event queued for a webhook together with the log of the
+// attempts to deliver it.
+type Delivery struct {
+	Id             string         `json:"id,omitempty"`
+	WebhookId      string         `json:"webhookId"`
+	Event          Event          `json:"event"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	NextAttempt    time.Time      `json:"nextAttempt"`
+	ResponseStatus int            `json:"responseStatus,omitempty"`
+	LastError      string         `json:"lastError,omitempty"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+}
+
+type ErrorCode string
+
+var (
+	CodeBadRequest         ErrorCode = "bad_request"
+	CodeUnauthorized       ErrorCode = "unauthorized"
+	CodeForbidden          ErrorCode = "forbidden"
+	CodeNotFound           ErrorCode = "not_found"
+	CodeMethodNotAllowed   ErrorCode = "method_not_allowed"
+	CodeConflict           ErrorCode = "conflict"
+	CodePreconditionFailed ErrorCode = "precondition_failed"
+	CodeValidationFailed   ErrorCode = "validation_failed"
+	CodeTooLarge           ErrorCode = "too_large"
+	CodeSyncTokenExpired   ErrorCode = "sync_token_expired"
+	CodeInternal           ErrorCode = "internal"
+)
+
+type ErrorDetail struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// apiError is the envelope every failed request is answered with.
+type apiError struct {
+	Code      ErrorCode     `json:"code"`
+	Message   string        `json:"message"`
+	Details   []ErrorDetail `json:"details,omitempty"`
+	RequestId string        `json:"requestId,omitempty"`
+}
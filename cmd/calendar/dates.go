@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// parseWhen understands RFC 3339 timestamps and human friendly dates relative
+// to now: "now", "in 2h", "+30m", "today", "tomorrow 10:00", "friday 9:30",
+// "next monday", "2023-03-07 16:20".
+func parseWhen(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	value = strings.ToLower(value)
+	if value == "now" {
+		return now, nil
+	}
+	if offset := strings.TrimPrefix(strings.TrimPrefix(value, "in "), "+"); offset != value {
+		d, err := time.ParseDuration(strings.ReplaceAll(offset, " ", ""))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid offset %q: %w", value, err)
+		}
+		return now.Add(d), nil
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) == 0 || len(fields) > 3 {
+		return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+	}
+	clock := ""
+	if strings.Contains(fields[len(fields)-1], ":") {
+		clock = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+	day, err := parseDay(strings.Join(fields, " "), now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if clock == "" {
+		return day, nil
+	}
+	hm, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time of day %q", clock)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), hm.Hour(), hm.Minute(), 0, 0, day.Location()), nil
+}
+
+func parseDay(value string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch value {
+	case "", "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+	next := strings.HasPrefix(value, "next ")
+	weekday, ok := weekdays[strings.TrimPrefix(value, "next ")]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+	}
+	// "friday" may be today, "next friday" is always in the future
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if next && days == 0 {
+		days = 7
+	}
+	return today.AddDate(0, 0, days), nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWhen(t *testing.T) {
+	zone := time.FixedZone("CET", 3600)
+	// a wednesday
+	now := time.Date(2023, 3, 8, 14, 25, 0, 0, zone)
+	cases := map[string]time.Time{
+		"now":                       now,
+		"in 2h":                     now.Add(2 * time.Hour),
+		"+30m":                      now.Add(30 * time.Minute),
+		"today":                     time.Date(2023, 3, 8, 0, 0, 0, 0, zone),
+		"tomorrow 10:00":            time.Date(2023, 3, 9, 10, 0, 0, 0, zone),
+		"Yesterday 9:30":            time.Date(2023, 3, 7, 9, 30, 0, 0, zone),
+		"friday 16:20":              time.Date(2023, 3, 10, 16, 20, 0, 0, zone),
+		"wednesday 18:00":           time.Date(2023, 3, 8, 18, 0, 0, 0, zone),
+		"next wednesday":            time.Date(2023, 3, 15, 0, 0, 0, 0, zone),
+		"monday":                    time.Date(2023, 3, 13, 0, 0, 0, 0, zone),
+		"2023-03-07 16:20":          time.Date(2023, 3, 7, 16, 20, 0, 0, zone),
+		"2023-03-07T16:20:00.000Z":  time.Date(2023, 3, 7, 16, 20, 0, 0, time.UTC),
+		"2023-03-07T16:20:00+01:00": time.Date(2023, 3, 7, 16, 20, 0, 0, zone),
+	}
+	for value, expected := range cases {
+		actual, err := parseWhen(value, now)
+		require.NoError(t, err, value)
+		require.True(t, expected.Equal(actual), "%s: expected %v, got %v", value, expected, actual)
+	}
+	for _, value := range []string{"", "someday", "tomorrow 25:00", "in forever"} {
+		_, err := parseWhen(value, now)
+		require.Error(t, err, value)
+	}
+}
@@ -0,0 +1,474 @@
+// Command calendar is a command-line client for the calendar api.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vladem/calendar/client"
+)
+
+const usage = `usage: calendar [--endpoint URL] [--token TOKEN] [--output table|json] <command>
+
+commands:
+  users add <login>
+  groups add <name> [--members LOGIN,...] [--groups NAME,...]
+  meetings list --user LOGIN [--from WHEN] [--to WHEN] [--calendars ID,...] [--exclude-calendars ID,...]
+                [--role owner|invitee] [--status notReviewed|accepted|declined] [--search TEXT]
+  meetings get <id>
+  meetings search <words> [--users LOGIN,...] [--from WHEN] [--to WHEN]
+  meetings add [--owner LOGIN] [--invite LOGIN,...] --start WHEN (--end WHEN | --duration 30m)
+               (--title TEXT | --description TEXT | both) [--location TEXT] [--conference-url URL]
+               [--repeat none|daily|workdays|weekly|monthly|yearly]
+               [--visibility public|private|confidential] [--calendar ID] [--groups NAME,...]
+  slot --users LOGIN,... --duration 30m [--from WHEN] [--calendars ID,...] [--exclude-calendars ID,...]
+  rsvp <meeting id> [--user LOGIN] [--decline] [--calendar ID]
+
+The token defaults to $CALENDAR_TOKEN, requests act as its user. users add prints the
+token of the new user.
+
+Invitees and users may be groups, team:NAME, for their members at the time.
+
+WHEN is RFC 3339 or relative: now, "in 2h", today, "tomorrow 10:00", "friday 9:30", "2023-03-07 16:20"
+`
+
+// repeats names the reoccurances, in the order they are listed.
+var repeats = []struct {
+	name   string
+	choice client.ReoccureanceChoice
+}{
+	{"none", client.NoReoccurence},
+	{"daily", client.Daily},
+	{"workdays", client.WorkingDays},
+	{"weekly", client.Weekly},
+	{"monthly", client.Monthly},
+	{"yearly", client.Yearly},
+}
+
+// repeatChoice returns the reoccurance named name.
+func repeatChoice(name string) (client.ReoccureanceChoice, bool) {
+	for _, repeat := range repeats {
+		if repeat.name == name {
+			return repeat.choice, true
+		}
+	}
+	return client.NoReoccurence, false
+}
+
+// repeatName returns the name of the reoccurance.
+func repeatName(choice client.ReoccureanceChoice) string {
+	for _, repeat := range repeats {
+		if repeat.choice == choice {
+			return repeat.name
+		}
+	}
+	return "none"
+}
+
+type cli struct {
+	client *client.Client
+	out    io.Writer
+	json   bool
+	now    time.Time
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "calendar:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, out io.Writer) error {
+	global := flag.NewFlagSet("calendar", flag.ContinueOnError)
+	global.Usage = func() { fmt.Fprint(global.Output(), usage) }
+	endpoint := global.String("endpoint", envOr("CALENDAR_ENDPOINT", "http://127.0.0.1:8080"), "api endpoint")
+	token := global.String("token", os.Getenv("CALENDAR_TOKEN"), "api token")
+	output := global.String("output", "table", "output format: table or json")
+	if err := global.Parse(args); err != nil {
+		return err
+	}
+	if *output != "table" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+	c := &cli{
+		client: client.New(*endpoint),
+		out:    out,
+		json:   *output == "json",
+		now:    time.Now(),
+	}
+	c.client.Token = *token
+	args = global.Args()
+	if len(args) == 0 {
+		global.Usage()
+		return errors.New("no command given")
+	}
+	command := args[0]
+	if len(args) > 1 {
+		command += " " + args[1]
+	}
+	switch {
+	case command == "users add":
+		return c.addUser(ctx, args[2:])
+	case command == "groups add":
+		return c.addGroup(ctx, args[2:])
+	case command == "meetings list":
+		return c.listMeetings(ctx, args[2:])
+	case command == "meetings get":
+		return c.getMeeting(ctx, args[2:])
+	case command == "meetings search":
+		return c.searchMeetings(ctx, args[2:])
+	case command == "meetings add":
+		return c.addMeeting(ctx, args[2:])
+	case args[0] == "slot":
+		return c.findSlot(ctx, args[1:])
+	case args[0] == "rsvp":
+		return c.rsvp(ctx, args[1:])
+	default:
+		global.Usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+func (c *cli) addUser(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("users add", flag.ContinueOnError)
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("users add: expected exactly one login")
+	}
+	user, err := c.client.AddUser(ctx, positional[0])
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(user)
+	}
+	fmt.Fprintf(c.out, "created user %s (%s), token %s\n", user.Login, user.Id, user.Token)
+	return nil
+}
+
+func (c *cli) addGroup(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("groups add", flag.ContinueOnError)
+	members := fs.String("members", "", "comma separated logins of the members")
+	groups := fs.String("groups", "", "comma separated names of nested groups")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("groups add: expected exactly one name")
+	}
+	group, err := c.client.CreateGroup(ctx, client.Group{Name: positional[0], Members: splitList(*members), Groups: splitList(*groups)})
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(group)
+	}
+	fmt.Fprintf(c.out, "created group %s with %d members\n", group.Name, len(group.Members))
+	return nil
+}
+
+func (c *cli) listMeetings(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("meetings list", flag.ContinueOnError)
+	login := fs.String("user", "", "login whose meetings to list")
+	from := fs.String("from", "today", "start of the range")
+	to := fs.String("to", "", "end of the range, a week after --from by default")
+	filter := calendarFlags(fs)
+	role := fs.String("role", "", "only meetings the user is the owner or an invitee of")
+	status := fs.String("status", "", "only meetings the user answered notReviewed, accepted or declined")
+	search := fs.String("search", "", "only meetings whose title, description or location contains the text")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *login == "" {
+		return errors.New("meetings list: --user is required")
+	}
+	startTime, err := parseWhen(*from, c.now)
+	if err != nil {
+		return err
+	}
+	endTime := startTime.AddDate(0, 0, 7)
+	if *to != "" {
+		if endTime, err = parseWhen(*to, c.now); err != nil {
+			return err
+		}
+	}
+	query := client.MeetingQuery{CalendarFilter: filter(), Role: *role, Status: *status, Text: *search}
+	meetings, err := c.client.FindMeetings(ctx, *login, startTime, endTime, query)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(meetings)
+	}
+	c.printMeetings(meetings)
+	return nil
+}
+
+func (c *cli) getMeeting(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("meetings get", flag.ContinueOnError)
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("meetings get: expected exactly one meeting id")
+	}
+	meeting, err := c.client.GetMeeting(ctx, positional[0])
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(meeting)
+	}
+	c.printMeetings([]client.Meeting{*meeting})
+	return nil
+}
+
+func (c *cli) searchMeetings(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("meetings search", flag.ContinueOnError)
+	users := fs.String("users", "", "comma separated logins who all take part")
+	from := fs.String("from", "", "only meetings ending after this time")
+	to := fs.String("to", "", "only meetings starting before this time")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return errors.New("meetings search: expected the words to search")
+	}
+	query := client.SearchQuery{Text: strings.Join(positional, " "), Participants: splitList(*users)}
+	if *from != "" {
+		if query.From, err = parseWhen(*from, c.now); err != nil {
+			return err
+		}
+	}
+	if *to != "" {
+		if query.To, err = parseWhen(*to, c.now); err != nil {
+			return err
+		}
+	}
+	meetings, err := c.client.SearchMeetings(ctx, query)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(meetings)
+	}
+	c.printMeetings(meetings)
+	return nil
+}
+
+func (c *cli) addMeeting(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("meetings add", flag.ContinueOnError)
+	owner := fs.String("owner", "", "login of the organizer, who shares edit with you")
+	invite := fs.String("invite", "", "comma separated logins to invite")
+	start := fs.String("start", "", "start of the meeting")
+	end := fs.String("end", "", "end of the meeting")
+	duration := fs.Duration("duration", 0, "length of the meeting, alternative to --end")
+	repeat := fs.String("repeat", "none", "none, daily, workdays, weekly, monthly or yearly")
+	title := fs.String("title", "", "title of the meeting")
+	description := fs.String("description", "", "what the meeting is about")
+	location := fs.String("location", "", "where the meeting takes place")
+	conferenceUrl := fs.String("conference-url", "", "http(s) url of the video call")
+	visibility := fs.String("visibility", "", "who sees the details: public, private (default) or confidential")
+	calendar := fs.String("calendar", "", "id of the calendar of the organizer to add the meeting to")
+	groups := fs.String("groups", "", "comma separated groups whose members are invited while they are members")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *start == "" {
+		return errors.New("meetings add: --start is required")
+	}
+	reoccurance, ok := repeatChoice(*repeat)
+	if !ok {
+		return fmt.Errorf("meetings add: unknown --repeat %q", *repeat)
+	}
+	meeting := client.Meeting{
+		Owner:         *owner,
+		Invited:       []client.Invitation{},
+		Reoccurance:   reoccurance,
+		Title:         *title,
+		Description:   *description,
+		Location:      *location,
+		ConferenceUrl: *conferenceUrl,
+		Visibility:    client.Visibility(*visibility),
+		CalendarId:    *calendar,
+		Groups:        splitList(*groups),
+	}
+	for _, login := range splitList(*invite) {
+		meeting.Invited = append(meeting.Invited, client.Invitation{Invitee: login})
+	}
+	var err error
+	if meeting.StartTime, err = parseWhen(*start, c.now); err != nil {
+		return err
+	}
+	switch {
+	case *end != "":
+		if meeting.EndTime, err = parseWhen(*end, c.now); err != nil {
+			return err
+		}
+	case *duration > 0:
+		meeting.EndTime = meeting.StartTime.Add(*duration)
+	default:
+		return errors.New("meetings add: either --end or --duration is required")
+	}
+	created, err := c.client.AddMeeting(ctx, meeting)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(created)
+	}
+	c.printMeetings([]client.Meeting{*created})
+	return nil
+}
+
+func (c *cli) findSlot(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("slot", flag.ContinueOnError)
+	users := fs.String("users", "", "comma separated logins which have to be free")
+	duration := fs.Duration("duration", 30*time.Minute, "length of the slot")
+	from := fs.String("from", "now", "earliest start of the slot")
+	filter := calendarFlags(fs)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	logins := splitList(*users)
+	if len(logins) == 0 {
+		return errors.New("slot: --users is required")
+	}
+	startTime, err := parseWhen(*from, c.now)
+	if err != nil {
+		return err
+	}
+	slot, err := c.client.FindSlotIn(ctx, logins, startTime, *duration, filter())
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(map[string]time.Time{"startTime": slot, "endTime": slot.Add(*duration)})
+	}
+	fmt.Fprintf(c.out, "%s - %s\n", formatTime(slot), slot.Add(*duration).Local().Format("15:04"))
+	return nil
+}
+
+func (c *cli) rsvp(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("rsvp", flag.ContinueOnError)
+	login := fs.String("user", "", "login of the invitee, who shares respond with you")
+	decline := fs.Bool("decline", false, "decline instead of accepting")
+	calendar := fs.String("calendar", "", "id of the calendar of the invitee to keep the meeting in")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("rsvp: expected a meeting id")
+	}
+	meeting, err := c.client.AcceptMeetingIn(ctx, positional[0], *login, *calendar, *decline)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.printJson(meeting)
+	}
+	c.printMeetings([]client.Meeting{*meeting})
+	return nil
+}
+
+// calendarFlags defines the flags selecting calendars, the returned function
+// reads them once parsed.
+func calendarFlags(fs *flag.FlagSet) func() client.CalendarFilter {
+	calendars := fs.String("calendars", "", "comma separated ids of the calendars to include, all by default")
+	exclude := fs.String("exclude-calendars", "", "comma separated ids of the calendars to leave out")
+	return func() client.CalendarFilter {
+		return client.CalendarFilter{Calendars: splitList(*calendars), Exclude: splitList(*exclude)}
+	}
+}
+
+// parseFlags allows flags and positional arguments to be interleaved,
+// e.g. "rsvp 640a48 --decline".
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func (c *cli) printJson(v any) error {
+	encoder := json.NewEncoder(c.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+var acceptedNames = map[client.AcceptedChoice]string{
+	client.NotReviewed: "?",
+	client.Accepted:    "yes",
+	client.Declined:    "no",
+}
+
+func (c *cli) printMeetings(meetings []client.Meeting) {
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTART\tEND\tOWNER\tINVITED\tREPEAT\tTITLE")
+	for _, meeting := range meetings {
+		invited := []string{}
+		for _, invitation := range meeting.Invited {
+			invited = append(invited, fmt.Sprintf("%s(%s)", invitation.Invitee, acceptedNames[invitation.Accepted]))
+		}
+		title := meeting.Title
+		if title == "" {
+			title = meeting.Description
+		}
+		if meeting.Busy {
+			title = "(busy)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			meeting.Id,
+			formatTime(meeting.StartTime),
+			meeting.EndTime.Local().Format("15:04"),
+			meeting.Owner,
+			strings.Join(invited, ","),
+			repeatName(meeting.Reoccurance),
+			title)
+	}
+	w.Flush()
+}
+
+func formatTime(t time.Time) string {
+	return t.Local().Format("Mon 2006-01-02 15:04")
+}
+
+func splitList(value string) []string {
+	res := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+func envOr(name, fallback string) string {
+	if value, ok := os.LookupEnv(name); ok {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vladem/calendar/client"
+)
+
+func TestRepeats(t *testing.T) {
+	for _, repeat := range repeats {
+		choice, ok := repeatChoice(repeat.name)
+		require.True(t, ok)
+		require.Equal(t, repeat.choice, choice)
+		require.Equal(t, repeat.name, repeatName(choice))
+	}
+	require.Len(t, repeats, 6)
+	_, ok := repeatChoice("fortnightly")
+	require.False(t, ok)
+}
+
+func TestParseFlags(t *testing.T) {
+	cases := []struct {
+		args       []string
+		positional []string
+		user       string
+		decline    bool
+	}{
+		{[]string{"m1"}, []string{"m1"}, "", false},
+		{[]string{"m1", "--decline"}, []string{"m1"}, "", true},
+		{[]string{"--user", "alice", "m1", "--decline"}, []string{"m1"}, "alice", true},
+		{[]string{"weekly", "--user=alice", "planning"}, []string{"weekly", "planning"}, "alice", false},
+		// everything after -- is positional
+		{[]string{"m1", "--", "--decline"}, []string{"m1", "--decline"}, "", false},
+		{[]string{}, []string{}, "", false},
+	}
+	for _, c := range cases {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		user := fs.String("user", "", "")
+		decline := fs.Bool("decline", false, "")
+		positional, err := parseFlags(fs, c.args)
+		require.NoError(t, err, c.args)
+		require.Equal(t, c.positional, positional, c.args)
+		require.Equal(t, c.user, *user, c.args)
+		require.Equal(t, c.decline, *decline, c.args)
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&bytes.Buffer{})
+	_, err := parseFlags(fs, []string{"m1", "--unknown"})
+	require.Error(t, err)
+}
+
+// apiRequest is a request the fake api received.
+type apiRequest struct {
+	method string
+	path   string
+	query  url.Values
+	body   map[string]any
+}
+
+var testMeeting = client.Meeting{
+	Id:          "m1",
+	Owner:       "bob",
+	Invited:     []client.Invitation{{Invitee: "alice", Accepted: client.Accepted}, {Invitee: "carl"}},
+	StartTime:   time.Date(2023, 3, 7, 16, 20, 0, 0, time.UTC),
+	EndTime:     time.Date(2023, 3, 7, 16, 40, 0, 0, time.UTC),
+	Reoccurance: client.Weekly,
+	Title:       "planning",
+}
+
+// fakeApi answers every command with canned responses and records the
+// requests it receives.
+func fakeApi(t *testing.T, requests *[]apiRequest) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer cal_test", r.Header.Get("Authorization"))
+		request := apiRequest{method: r.Method, path: r.URL.Path, query: r.URL.Query()}
+		if r.Method == "POST" {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request.body))
+		}
+		*requests = append(*requests, request)
+		w.Header().Set("Content-Type", "application/json")
+		var response any
+		switch r.URL.Path {
+		case "/api/users":
+			response = client.User{Id: "u1", Login: "dave", Token: "cal_dave"}
+		case "/api/groups":
+			response = client.Group{Name: "team", Owner: "bob", Members: []string{"alice", "carl"}}
+		case "/api/findSlot":
+			response = map[string]string{"startTime": "2023-03-08T09:00:00Z"}
+		case "/api/users/bob/meetings", "/api/meetings/search":
+			busy := client.Meeting{Id: "m2", StartTime: testMeeting.StartTime.Add(time.Hour), EndTime: testMeeting.EndTime.Add(time.Hour), Busy: true}
+			response = []client.Meeting{testMeeting, busy}
+		default:
+			response = testMeeting
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCommands(t *testing.T) {
+	// times are printed in the local zone
+	local := time.Local
+	time.Local = time.UTC
+	defer func() { time.Local = local }()
+
+	meetingTable := "ID  START                 END    OWNER  INVITED             REPEAT  TITLE\n" +
+		"m1  Tue 2023-03-07 16:20  16:40  bob    alice(yes),carl(?)  weekly  planning\n"
+	listTable := meetingTable +
+		"m2  Tue 2023-03-07 17:20  17:40                             none    (busy)\n"
+	cases := []struct {
+		name    string
+		args    []string
+		request apiRequest // body holds the fields checked
+		output  string
+	}{{
+		name:    "users add",
+		args:    []string{"users", "add", "dave"},
+		request: apiRequest{method: "POST", path: "/api/users", query: url.Values{}, body: map[string]any{"login": "dave"}},
+		output:  "created user dave (u1), token cal_dave\n",
+	}, {
+		name: "groups add",
+		args: []string{"groups", "add", "--members", "alice, carl", "team"},
+		request: apiRequest{method: "POST", path: "/api/groups", query: url.Values{},
+			body: map[string]any{"name": "team", "members": []any{"alice", "carl"}}},
+		output: "created group team with 2 members\n",
+	}, {
+		name: "meetings list",
+		args: []string{"meetings", "list", "--user", "bob", "--from", "2023-03-06T00:00:00Z", "--status", "accepted", "--exclude-calendars", "c1,c2"},
+		request: apiRequest{method: "GET", path: "/api/users/bob/meetings", query: url.Values{
+			"startTime":        {"2023-03-06T00:00:00Z"},
+			"endTime":          {"2023-03-13T00:00:00Z"},
+			"status":           {"accepted"},
+			"excludeCalendars": {"c1,c2"},
+		}},
+		output: listTable,
+	}, {
+		name:    "meetings get",
+		args:    []string{"meetings", "get", "m1"},
+		request: apiRequest{method: "GET", path: "/api/meetings/m1", query: url.Values{}},
+		output:  meetingTable,
+	}, {
+		name: "meetings search",
+		args: []string{"meetings", "search", "weekly", "--users", "alice", "planning", "--to", "2023-04-01T00:00:00Z"},
+		request: apiRequest{method: "GET", path: "/api/meetings/search", query: url.Values{
+			"q":            {"weekly planning"},
+			"participants": {"alice"},
+			"to":           {"2023-04-01T00:00:00Z"},
+		}},
+		output: listTable,
+	}, {
+		name: "meetings add",
+		args: []string{"meetings", "add", "--invite", "alice,carl", "--start", "2023-03-07T16:20:00Z", "--duration", "20m",
+			"--title", "planning", "--repeat", "weekly", "--visibility", "confidential"},
+		request: apiRequest{method: "POST", path: "/api/meetings", query: url.Values{}, body: map[string]any{
+			"invited":     []any{map[string]any{"invitee": "alice", "accepted": 0.0}, map[string]any{"invitee": "carl", "accepted": 0.0}},
+			"startTime":   "2023-03-07T16:20:00Z",
+			"endTime":     "2023-03-07T16:40:00Z",
+			"title":       "planning",
+			"reoccurance": 3.0,
+			"visibility":  "confidential",
+		}},
+		output: meetingTable,
+	}, {
+		name: "slot",
+		args: []string{"slot", "--users", "alice,carl", "--from", "2023-03-08T08:00:00Z", "--duration", "1h"},
+		request: apiRequest{method: "GET", path: "/api/findSlot", query: url.Values{
+			"startTime":       {"2023-03-08T08:00:00Z"},
+			"durationMinutes": {"60"},
+			"logins":          {"alice,carl"},
+		}},
+		output: "Wed 2023-03-08 09:00 - 10:00\n",
+	}, {
+		name: "rsvp",
+		args: []string{"rsvp", "m1", "--decline", "--user", "alice"},
+		request: apiRequest{method: "POST", path: "/api/acceptMeeting", query: url.Values{},
+			body: map[string]any{"meetingId": "m1", "login": "alice", "decline": true}},
+		output: meetingTable,
+	}}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			requests := []apiRequest{}
+			server := fakeApi(t, &requests)
+			var out bytes.Buffer
+			args := append([]string{"--endpoint", server.URL, "--token", "cal_test"}, c.args...)
+			require.NoError(t, run(context.Background(), args, &out))
+			require.Equal(t, c.output, out.String())
+			require.Len(t, requests, 1)
+			require.Equal(t, c.request.method, requests[0].method)
+			require.Equal(t, c.request.path, requests[0].path)
+			require.Equal(t, c.request.query, requests[0].query)
+			for field, value := range c.request.body {
+				require.Equal(t, value, requests[0].body[field], field)
+			}
+		})
+	}
+}
+
+func TestJsonOutput(t *testing.T) {
+	requests := []apiRequest{}
+	server := fakeApi(t, &requests)
+	var out bytes.Buffer
+	args := []string{"--endpoint", server.URL, "--token", "cal_test", "--output", "json", "meetings", "get", "m1"}
+	require.NoError(t, run(context.Background(), args, &out))
+	var meeting client.Meeting
+	require.NoError(t, json.Unmarshal(out.Bytes(), &meeting))
+	require.Equal(t, testMeeting, meeting)
+	// indented for reading
+	require.True(t, strings.HasPrefix(out.String(), "{\n  \"id\": \"m1\","))
+}
+
+func TestCommandErrors(t *testing.T) {
+	requests := []apiRequest{}
+	server := fakeApi(t, &requests)
+	cases := map[string][]string{
+		"no command given":                             {},
+		`unknown command "meetings move"`:              {"meetings", "move"},
+		`unknown output format "yaml"`:                 {"--output", "yaml", "meetings", "get", "m1"},
+		"users add: expected exactly one login":        {"users", "add", "dave", "erin"},
+		"meetings list: --user is required":            {"meetings", "list"},
+		"meetings search: expected the words":          {"meetings", "search", "--users", "alice"},
+		"meetings add: --start is required":            {"meetings", "add", "--duration", "30m"},
+		`meetings add: unknown --repeat "fortnightly"`: {"meetings", "add", "--start", "now", "--repeat", "fortnightly"},
+		"meetings add: either --end or --duration":     {"meetings", "add", "--start", "now"},
+		"slot: --users is required":                    {"slot"},
+		"rsvp: expected a meeting id":                  {"rsvp", "m1", "m2"},
+	}
+	for message, args := range cases {
+		var out bytes.Buffer
+		err := run(context.Background(), append([]string{"--endpoint", server.URL, "--token", "cal_test"}, args...), &out)
+		require.ErrorContains(t, err, message, args)
+	}
+	// nothing was sent
+	require.Empty(t, requests)
+}
@@ -2,14 +2,52 @@ package main
 
 import (
 	"log"
+	"net/smtp"
 	"os"
 	"os/signal"
+	"strings"
 
 	"github.com/vladem/calendar/service"
 )
 
 func main() {
-	service := service.Service{}
+	var mail service.MailSender
+	if addr := os.Getenv("CALENDAR_SMTP_ADDR"); addr != "" {
+		sender := &service.SmtpSender{Addr: addr, InsecureAuth: os.Getenv("CALENDAR_SMTP_INSECURE_AUTH") == "1"}
+		if user := os.Getenv("CALENDAR_SMTP_USER"); user != "" {
+			host, _, _ := strings.Cut(addr, ":")
+			sender.Auth = smtp.PlainAuth("", user, os.Getenv("CALENDAR_SMTP_PASSWORD"), host)
+		}
+		mail = sender
+	}
+	var conferences service.ConferenceProvider
+	if base := os.Getenv("CALENDAR_JITSI_URL"); base != "" {
+		jitsi := &service.JitsiProvider{BaseUrl: base, Secret: []byte(os.Getenv("CALENDAR_JITSI_SECRET"))}
+		for _, number := range strings.Split(os.Getenv("CALENDAR_JITSI_DIAL_IN"), ",") {
+			if number = strings.TrimSpace(number); number != "" {
+				jitsi.Numbers = append(jitsi.Numbers, number)
+			}
+		}
+		conferences = jitsi
+	}
+	var origins []string
+	for _, origin := range strings.Split(os.Getenv("CALENDAR_STREAM_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	service := service.Service{
+		MailDomain:      os.Getenv("CALENDAR_MAIL_DOMAIN"),
+		Mail:            mail,
+		MailFrom:        os.Getenv("CALENDAR_MAIL_FROM"),
+		Conferences:     conferences,
+		AdminToken:      os.Getenv("CALENDAR_ADMIN_TOKEN"),
+		SessionSecret:   []byte(os.Getenv("CALENDAR_SESSION_SECRET")),
+		PrivateWebhooks: os.Getenv("CALENDAR_PRIVATE_WEBHOOKS") == "1",
+		OpenSignup:      os.Getenv("CALENDAR_OPEN_SIGNUP") == "1",
+		StreamOrigins:   origins,
+		WebhookKey:      []byte(os.Getenv("CALENDAR_WEBHOOK_KEY")),
+	}
 	err := service.ServeHttp()
 	if err != nil {
 		log.Fatalf("failed to connect to db: %v", err)
@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// NewAcceptMeetingParams creates a new AcceptMeetingParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAcceptMeetingParams() *AcceptMeetingParams {
+	return &AcceptMeetingParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAcceptMeetingParamsWithTimeout creates a new AcceptMeetingParams object
+// with the ability to set a timeout on a request.
+func NewAcceptMeetingParamsWithTimeout(timeout time.Duration) *AcceptMeetingParams {
+	return &AcceptMeetingParams{
+		timeout: timeout,
+	}
+}
+
+// NewAcceptMeetingParamsWithContext creates a new AcceptMeetingParams object
+// with the ability to set a context for a request.
+func NewAcceptMeetingParamsWithContext(ctx context.Context) *AcceptMeetingParams {
+	return &AcceptMeetingParams{
+		Context: ctx,
+	}
+}
+
+// NewAcceptMeetingParamsWithHTTPClient creates a new AcceptMeetingParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAcceptMeetingParamsWithHTTPClient(client *http.Client) *AcceptMeetingParams {
+	return &AcceptMeetingParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AcceptMeetingParams contains all the parameters to send to the API endpoint
+
+	for the accept meeting operation.
+
+	Typically these are written to a http.Request.
+*/
+type AcceptMeetingParams struct {
+
+	// Request.
+	Request *models.AcceptMeetingRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the accept meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AcceptMeetingParams) WithDefaults() *AcceptMeetingParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the accept meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AcceptMeetingParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the accept meeting params
+func (o *AcceptMeetingParams) WithTimeout(timeout time.Duration) *AcceptMeetingParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the accept meeting params
+func (o *AcceptMeetingParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the accept meeting params
+func (o *AcceptMeetingParams) WithContext(ctx context.Context) *AcceptMeetingParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the accept meeting params
+func (o *AcceptMeetingParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the accept meeting params
+func (o *AcceptMeetingParams) WithHTTPClient(client *http.Client) *AcceptMeetingParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the accept meeting params
+func (o *AcceptMeetingParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRequest adds the request to the accept meeting params
+func (o *AcceptMeetingParams) WithRequest(request *models.AcceptMeetingRequest) *AcceptMeetingParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the accept meeting params
+func (o *AcceptMeetingParams) SetRequest(request *models.AcceptMeetingRequest) {
+	o.Request = request
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AcceptMeetingParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// AcceptMeetingReader is a Reader for the AcceptMeeting structure.
+type AcceptMeetingReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AcceptMeetingReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAcceptMeetingOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewAcceptMeetingForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAcceptMeetingNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewAcceptMeetingDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAcceptMeetingOK creates a AcceptMeetingOK with default headers values
+func NewAcceptMeetingOK() *AcceptMeetingOK {
+	return &AcceptMeetingOK{}
+}
+
+/*
+AcceptMeetingOK describes a response with status code 200, with default header values.
+
+updated meeting
+*/
+type AcceptMeetingOK struct {
+	Payload *models.Meeting
+}
+
+// IsSuccess returns true when this accept meeting o k response has a 2xx status code
+func (o *AcceptMeetingOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this accept meeting o k response has a 3xx status code
+func (o *AcceptMeetingOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this accept meeting o k response has a 4xx status code
+func (o *AcceptMeetingOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this accept meeting o k response has a 5xx status code
+func (o *AcceptMeetingOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this accept meeting o k response a status code equal to that given
+func (o *AcceptMeetingOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the accept meeting o k response
+func (o *AcceptMeetingOK) Code() int {
+	return 200
+}
+
+func (o *AcceptMeetingOK) Error() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *AcceptMeetingOK) String() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *AcceptMeetingOK) GetPayload() *models.Meeting {
+	return o.Payload
+}
+
+func (o *AcceptMeetingOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Meeting)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAcceptMeetingForbidden creates a AcceptMeetingForbidden with default headers values
+func NewAcceptMeetingForbidden() *AcceptMeetingForbidden {
+	return &AcceptMeetingForbidden{}
+}
+
+/*
+AcceptMeetingForbidden describes a response with status code 403, with default header values.
+
+not invited, or the invitee doesn't share respond with the caller
+*/
+type AcceptMeetingForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this accept meeting forbidden response has a 2xx status code
+func (o *AcceptMeetingForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this accept meeting forbidden response has a 3xx status code
+func (o *AcceptMeetingForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this accept meeting forbidden response has a 4xx status code
+func (o *AcceptMeetingForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this accept meeting forbidden response has a 5xx status code
+func (o *AcceptMeetingForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this accept meeting forbidden response a status code equal to that given
+func (o *AcceptMeetingForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the accept meeting forbidden response
+func (o *AcceptMeetingForbidden) Code() int {
+	return 403
+}
+
+func (o *AcceptMeetingForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeetingForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AcceptMeetingForbidden) String() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeetingForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AcceptMeetingForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AcceptMeetingForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAcceptMeetingNotFound creates a AcceptMeetingNotFound with default headers values
+func NewAcceptMeetingNotFound() *AcceptMeetingNotFound {
+	return &AcceptMeetingNotFound{}
+}
+
+/*
+AcceptMeetingNotFound describes a response with status code 404, with default header values.
+
+no such meeting
+*/
+type AcceptMeetingNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this accept meeting not found response has a 2xx status code
+func (o *AcceptMeetingNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this accept meeting not found response has a 3xx status code
+func (o *AcceptMeetingNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this accept meeting not found response has a 4xx status code
+func (o *AcceptMeetingNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this accept meeting not found response has a 5xx status code
+func (o *AcceptMeetingNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this accept meeting not found response a status code equal to that given
+func (o *AcceptMeetingNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the accept meeting not found response
+func (o *AcceptMeetingNotFound) Code() int {
+	return 404
+}
+
+func (o *AcceptMeetingNotFound) Error() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *AcceptMeetingNotFound) String() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *AcceptMeetingNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AcceptMeetingNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAcceptMeetingDefault creates a AcceptMeetingDefault with default headers values
+func NewAcceptMeetingDefault(code int) *AcceptMeetingDefault {
+	return &AcceptMeetingDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+AcceptMeetingDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type AcceptMeetingDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this accept meeting default response has a 2xx status code
+func (o *AcceptMeetingDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this accept meeting default response has a 3xx status code
+func (o *AcceptMeetingDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this accept meeting default response has a 4xx status code
+func (o *AcceptMeetingDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this accept meeting default response has a 5xx status code
+func (o *AcceptMeetingDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this accept meeting default response a status code equal to that given
+func (o *AcceptMeetingDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the accept meeting default response
+func (o *AcceptMeetingDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AcceptMeetingDefault) Error() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AcceptMeetingDefault) String() string {
+	return fmt.Sprintf("[POST /api/acceptMeeting][%d] acceptMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AcceptMeetingDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AcceptMeetingDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// NewAddMeetingParams creates a new AddMeetingParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAddMeetingParams() *AddMeetingParams {
+	return &AddMeetingParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAddMeetingParamsWithTimeout creates a new AddMeetingParams object
+// with the ability to set a timeout on a request.
+func NewAddMeetingParamsWithTimeout(timeout time.Duration) *AddMeetingParams {
+	return &AddMeetingParams{
+		timeout: timeout,
+	}
+}
+
+// NewAddMeetingParamsWithContext creates a new AddMeetingParams object
+// with the ability to set a context for a request.
+func NewAddMeetingParamsWithContext(ctx context.Context) *AddMeetingParams {
+	return &AddMeetingParams{
+		Context: ctx,
+	}
+}
+
+// NewAddMeetingParamsWithHTTPClient creates a new AddMeetingParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAddMeetingParamsWithHTTPClient(client *http.Client) *AddMeetingParams {
+	return &AddMeetingParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AddMeetingParams contains all the parameters to send to the API endpoint
+
+	for the add meeting operation.
+
+	Typically these are written to a http.Request.
+*/
+type AddMeetingParams struct {
+
+	// Meeting.
+	Meeting *models.Meeting
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the add meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AddMeetingParams) WithDefaults() *AddMeetingParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the add meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AddMeetingParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the add meeting params
+func (o *AddMeetingParams) WithTimeout(timeout time.Duration) *AddMeetingParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the add meeting params
+func (o *AddMeetingParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the add meeting params
+func (o *AddMeetingParams) WithContext(ctx context.Context) *AddMeetingParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the add meeting params
+func (o *AddMeetingParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the add meeting params
+func (o *AddMeetingParams) WithHTTPClient(client *http.Client) *AddMeetingParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the add meeting params
+func (o *AddMeetingParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithMeeting adds the meeting to the add meeting params
+func (o *AddMeetingParams) WithMeeting(meeting *models.Meeting) *AddMeetingParams {
+	o.SetMeeting(meeting)
+	return o
+}
+
+// SetMeeting adds the meeting to the add meeting params
+func (o *AddMeetingParams) SetMeeting(meeting *models.Meeting) {
+	o.Meeting = meeting
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AddMeetingParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Meeting != nil {
+		if err := r.SetBodyParam(o.Meeting); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
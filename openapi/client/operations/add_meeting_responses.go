@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// AddMeetingReader is a Reader for the AddMeeting structure.
+type AddMeetingReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AddMeetingReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAddMeetingOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 422:
+		result := NewAddMeetingUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewAddMeetingDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAddMeetingOK creates a AddMeetingOK with default headers values
+func NewAddMeetingOK() *AddMeetingOK {
+	return &AddMeetingOK{}
+}
+
+/*
+AddMeetingOK describes a response with status code 200, with default header values.
+
+created meeting
+*/
+type AddMeetingOK struct {
+	Payload *models.Meeting
+}
+
+// IsSuccess returns true when this add meeting o k response has a 2xx status code
+func (o *AddMeetingOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this add meeting o k response has a 3xx status code
+func (o *AddMeetingOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add meeting o k response has a 4xx status code
+func (o *AddMeetingOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this add meeting o k response has a 5xx status code
+func (o *AddMeetingOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add meeting o k response a status code equal to that given
+func (o *AddMeetingOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the add meeting o k response
+func (o *AddMeetingOK) Code() int {
+	return 200
+}
+
+func (o *AddMeetingOK) Error() string {
+	return fmt.Sprintf("[POST /api/meetings][%d] addMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *AddMeetingOK) String() string {
+	return fmt.Sprintf("[POST /api/meetings][%d] addMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *AddMeetingOK) GetPayload() *models.Meeting {
+	return o.Payload
+}
+
+func (o *AddMeetingOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Meeting)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddMeetingUnprocessableEntity creates a AddMeetingUnprocessableEntity with default headers values
+func NewAddMeetingUnprocessableEntity() *AddMeetingUnprocessableEntity {
+	return &AddMeetingUnprocessableEntity{}
+}
+
+/*
+AddMeetingUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid meeting
+*/
+type AddMeetingUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add meeting unprocessable entity response has a 2xx status code
+func (o *AddMeetingUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this add meeting unprocessable entity response has a 3xx status code
+func (o *AddMeetingUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add meeting unprocessable entity response has a 4xx status code
+func (o *AddMeetingUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this add meeting unprocessable entity response has a 5xx status code
+func (o *AddMeetingUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add meeting unprocessable entity response a status code equal to that given
+func (o *AddMeetingUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the add meeting unprocessable entity response
+func (o *AddMeetingUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AddMeetingUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /api/meetings][%d] addMeetingUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AddMeetingUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /api/meetings][%d] addMeetingUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AddMeetingUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddMeetingUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddMeetingDefault creates a AddMeetingDefault with default headers values
+func NewAddMeetingDefault(code int) *AddMeetingDefault {
+	return &AddMeetingDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+AddMeetingDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type AddMeetingDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add meeting default response has a 2xx status code
+func (o *AddMeetingDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this add meeting default response has a 3xx status code
+func (o *AddMeetingDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this add meeting default response has a 4xx status code
+func (o *AddMeetingDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this add meeting default response has a 5xx status code
+func (o *AddMeetingDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this add meeting default response a status code equal to that given
+func (o *AddMeetingDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the add meeting default response
+func (o *AddMeetingDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AddMeetingDefault) Error() string {
+	return fmt.Sprintf("[POST /api/meetings][%d] addMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AddMeetingDefault) String() string {
+	return fmt.Sprintf("[POST /api/meetings][%d] addMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AddMeetingDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddMeetingDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
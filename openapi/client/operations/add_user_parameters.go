@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// NewAddUserParams creates a new AddUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAddUserParams() *AddUserParams {
+	return &AddUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAddUserParamsWithTimeout creates a new AddUserParams object
+// with the ability to set a timeout on a request.
+func NewAddUserParamsWithTimeout(timeout time.Duration) *AddUserParams {
+	return &AddUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewAddUserParamsWithContext creates a new AddUserParams object
+// with the ability to set a context for a request.
+func NewAddUserParamsWithContext(ctx context.Context) *AddUserParams {
+	return &AddUserParams{
+		Context: ctx,
+	}
+}
+
+// NewAddUserParamsWithHTTPClient creates a new AddUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAddUserParamsWithHTTPClient(client *http.Client) *AddUserParams {
+	return &AddUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AddUserParams contains all the parameters to send to the API endpoint
+
+	for the add user operation.
+
+	Typically these are written to a http.Request.
+*/
+type AddUserParams struct {
+
+	// User.
+	User *models.User
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the add user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AddUserParams) WithDefaults() *AddUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the add user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AddUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the add user params
+func (o *AddUserParams) WithTimeout(timeout time.Duration) *AddUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the add user params
+func (o *AddUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the add user params
+func (o *AddUserParams) WithContext(ctx context.Context) *AddUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the add user params
+func (o *AddUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the add user params
+func (o *AddUserParams) WithHTTPClient(client *http.Client) *AddUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the add user params
+func (o *AddUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUser adds the user to the add user params
+func (o *AddUserParams) WithUser(user *models.User) *AddUserParams {
+	o.SetUser(user)
+	return o
+}
+
+// SetUser adds the user to the add user params
+func (o *AddUserParams) SetUser(user *models.User) {
+	o.User = user
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AddUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.User != nil {
+		if err := r.SetBodyParam(o.User); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
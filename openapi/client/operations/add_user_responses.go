@@ -0,0 +1,478 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// AddUserReader is a Reader for the AddUser structure.
+type AddUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AddUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAddUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAddUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAddUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewAddUserConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAddUserUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewAddUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAddUserOK creates a AddUserOK with default headers values
+func NewAddUserOK() *AddUserOK {
+	return &AddUserOK{}
+}
+
+/*
+AddUserOK describes a response with status code 200, with default header values.
+
+created user
+*/
+type AddUserOK struct {
+	Payload *models.User
+}
+
+// IsSuccess returns true when this add user o k response has a 2xx status code
+func (o *AddUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this add user o k response has a 3xx status code
+func (o *AddUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add user o k response has a 4xx status code
+func (o *AddUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this add user o k response has a 5xx status code
+func (o *AddUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add user o k response a status code equal to that given
+func (o *AddUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the add user o k response
+func (o *AddUserOK) Code() int {
+	return 200
+}
+
+func (o *AddUserOK) Error() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserOK  %+v", 200, o.Payload)
+}
+
+func (o *AddUserOK) String() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserOK  %+v", 200, o.Payload)
+}
+
+func (o *AddUserOK) GetPayload() *models.User {
+	return o.Payload
+}
+
+func (o *AddUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.User)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddUserUnauthorized creates a AddUserUnauthorized with default headers values
+func NewAddUserUnauthorized() *AddUserUnauthorized {
+	return &AddUserUnauthorized{}
+}
+
+/*
+AddUserUnauthorized describes a response with status code 401, with default header values.
+
+signup is closed
+*/
+type AddUserUnauthorized struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add user unauthorized response has a 2xx status code
+func (o *AddUserUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this add user unauthorized response has a 3xx status code
+func (o *AddUserUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add user unauthorized response has a 4xx status code
+func (o *AddUserUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this add user unauthorized response has a 5xx status code
+func (o *AddUserUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add user unauthorized response a status code equal to that given
+func (o *AddUserUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the add user unauthorized response
+func (o *AddUserUnauthorized) Code() int {
+	return 401
+}
+
+func (o *AddUserUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *AddUserUnauthorized) String() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *AddUserUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddUserForbidden creates a AddUserForbidden with default headers values
+func NewAddUserForbidden() *AddUserForbidden {
+	return &AddUserForbidden{}
+}
+
+/*
+AddUserForbidden describes a response with status code 403, with default header values.
+
+not the admin
+*/
+type AddUserForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add user forbidden response has a 2xx status code
+func (o *AddUserForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this add user forbidden response has a 3xx status code
+func (o *AddUserForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add user forbidden response has a 4xx status code
+func (o *AddUserForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this add user forbidden response has a 5xx status code
+func (o *AddUserForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add user forbidden response a status code equal to that given
+func (o *AddUserForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the add user forbidden response
+func (o *AddUserForbidden) Code() int {
+	return 403
+}
+
+func (o *AddUserForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AddUserForbidden) String() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AddUserForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddUserConflict creates a AddUserConflict with default headers values
+func NewAddUserConflict() *AddUserConflict {
+	return &AddUserConflict{}
+}
+
+/*
+AddUserConflict describes a response with status code 409, with default header values.
+
+login is taken
+*/
+type AddUserConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add user conflict response has a 2xx status code
+func (o *AddUserConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this add user conflict response has a 3xx status code
+func (o *AddUserConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add user conflict response has a 4xx status code
+func (o *AddUserConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this add user conflict response has a 5xx status code
+func (o *AddUserConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add user conflict response a status code equal to that given
+func (o *AddUserConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the add user conflict response
+func (o *AddUserConflict) Code() int {
+	return 409
+}
+
+func (o *AddUserConflict) Error() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserConflict  %+v", 409, o.Payload)
+}
+
+func (o *AddUserConflict) String() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserConflict  %+v", 409, o.Payload)
+}
+
+func (o *AddUserConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddUserConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddUserUnprocessableEntity creates a AddUserUnprocessableEntity with default headers values
+func NewAddUserUnprocessableEntity() *AddUserUnprocessableEntity {
+	return &AddUserUnprocessableEntity{}
+}
+
+/*
+AddUserUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid login or profile
+*/
+type AddUserUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add user unprocessable entity response has a 2xx status code
+func (o *AddUserUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this add user unprocessable entity response has a 3xx status code
+func (o *AddUserUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add user unprocessable entity response has a 4xx status code
+func (o *AddUserUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this add user unprocessable entity response has a 5xx status code
+func (o *AddUserUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add user unprocessable entity response a status code equal to that given
+func (o *AddUserUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the add user unprocessable entity response
+func (o *AddUserUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AddUserUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AddUserUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AddUserUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddUserUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddUserDefault creates a AddUserDefault with default headers values
+func NewAddUserDefault(code int) *AddUserDefault {
+	return &AddUserDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+AddUserDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type AddUserDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add user default response has a 2xx status code
+func (o *AddUserDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this add user default response has a 3xx status code
+func (o *AddUserDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this add user default response has a 4xx status code
+func (o *AddUserDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this add user default response has a 5xx status code
+func (o *AddUserDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this add user default response a status code equal to that given
+func (o *AddUserDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the add user default response
+func (o *AddUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AddUserDefault) Error() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUser default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AddUserDefault) String() string {
+	return fmt.Sprintf("[POST /api/users][%d] addUser default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AddUserDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// AddWebhookReader is a Reader for the AddWebhook structure.
+type AddWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AddWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAddWebhookOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewAddWebhookNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewAddWebhookUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewAddWebhookDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAddWebhookOK creates a AddWebhookOK with default headers values
+func NewAddWebhookOK() *AddWebhookOK {
+	return &AddWebhookOK{}
+}
+
+/*
+AddWebhookOK describes a response with status code 200, with default header values.
+
+created webhook, the only response carrying the secret
+*/
+type AddWebhookOK struct {
+	Payload *models.Webhook
+}
+
+// IsSuccess returns true when this add webhook o k response has a 2xx status code
+func (o *AddWebhookOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this add webhook o k response has a 3xx status code
+func (o *AddWebhookOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add webhook o k response has a 4xx status code
+func (o *AddWebhookOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this add webhook o k response has a 5xx status code
+func (o *AddWebhookOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add webhook o k response a status code equal to that given
+func (o *AddWebhookOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the add webhook o k response
+func (o *AddWebhookOK) Code() int {
+	return 200
+}
+
+func (o *AddWebhookOK) Error() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhookOK  %+v", 200, o.Payload)
+}
+
+func (o *AddWebhookOK) String() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhookOK  %+v", 200, o.Payload)
+}
+
+func (o *AddWebhookOK) GetPayload() *models.Webhook {
+	return o.Payload
+}
+
+func (o *AddWebhookOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Webhook)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddWebhookNotFound creates a AddWebhookNotFound with default headers values
+func NewAddWebhookNotFound() *AddWebhookNotFound {
+	return &AddWebhookNotFound{}
+}
+
+/*
+AddWebhookNotFound describes a response with status code 404, with default header values.
+
+webhooks are not enabled
+*/
+type AddWebhookNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add webhook not found response has a 2xx status code
+func (o *AddWebhookNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this add webhook not found response has a 3xx status code
+func (o *AddWebhookNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add webhook not found response has a 4xx status code
+func (o *AddWebhookNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this add webhook not found response has a 5xx status code
+func (o *AddWebhookNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add webhook not found response a status code equal to that given
+func (o *AddWebhookNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the add webhook not found response
+func (o *AddWebhookNotFound) Code() int {
+	return 404
+}
+
+func (o *AddWebhookNotFound) Error() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *AddWebhookNotFound) String() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *AddWebhookNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddWebhookNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddWebhookUnprocessableEntity creates a AddWebhookUnprocessableEntity with default headers values
+func NewAddWebhookUnprocessableEntity() *AddWebhookUnprocessableEntity {
+	return &AddWebhookUnprocessableEntity{}
+}
+
+/*
+AddWebhookUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid webhook
+*/
+type AddWebhookUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add webhook unprocessable entity response has a 2xx status code
+func (o *AddWebhookUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this add webhook unprocessable entity response has a 3xx status code
+func (o *AddWebhookUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this add webhook unprocessable entity response has a 4xx status code
+func (o *AddWebhookUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this add webhook unprocessable entity response has a 5xx status code
+func (o *AddWebhookUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this add webhook unprocessable entity response a status code equal to that given
+func (o *AddWebhookUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the add webhook unprocessable entity response
+func (o *AddWebhookUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *AddWebhookUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhookUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AddWebhookUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhookUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *AddWebhookUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddWebhookUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddWebhookDefault creates a AddWebhookDefault with default headers values
+func NewAddWebhookDefault(code int) *AddWebhookDefault {
+	return &AddWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+AddWebhookDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type AddWebhookDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this add webhook default response has a 2xx status code
+func (o *AddWebhookDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this add webhook default response has a 3xx status code
+func (o *AddWebhookDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this add webhook default response has a 4xx status code
+func (o *AddWebhookDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this add webhook default response has a 5xx status code
+func (o *AddWebhookDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this add webhook default response a status code equal to that given
+func (o *AddWebhookDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the add webhook default response
+func (o *AddWebhookDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AddWebhookDefault) Error() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhook default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AddWebhookDefault) String() string {
+	return fmt.Sprintf("[POST /api/webhooks][%d] addWebhook default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AddWebhookDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AddWebhookDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
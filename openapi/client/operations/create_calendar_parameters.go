@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// NewCreateCalendarParams creates a new CreateCalendarParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateCalendarParams() *CreateCalendarParams {
+	return &CreateCalendarParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateCalendarParamsWithTimeout creates a new CreateCalendarParams object
+// with the ability to set a timeout on a request.
+func NewCreateCalendarParamsWithTimeout(timeout time.Duration) *CreateCalendarParams {
+	return &CreateCalendarParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateCalendarParamsWithContext creates a new CreateCalendarParams object
+// with the ability to set a context for a request.
+func NewCreateCalendarParamsWithContext(ctx context.Context) *CreateCalendarParams {
+	return &CreateCalendarParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateCalendarParamsWithHTTPClient creates a new CreateCalendarParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateCalendarParamsWithHTTPClient(client *http.Client) *CreateCalendarParams {
+	return &CreateCalendarParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateCalendarParams contains all the parameters to send to the API endpoint
+
+	for the create calendar operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateCalendarParams struct {
+
+	// Calendar.
+	Calendar *models.Calendar
+
+	// Login.
+	Login string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateCalendarParams) WithDefaults() *CreateCalendarParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateCalendarParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create calendar params
+func (o *CreateCalendarParams) WithTimeout(timeout time.Duration) *CreateCalendarParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create calendar params
+func (o *CreateCalendarParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create calendar params
+func (o *CreateCalendarParams) WithContext(ctx context.Context) *CreateCalendarParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create calendar params
+func (o *CreateCalendarParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create calendar params
+func (o *CreateCalendarParams) WithHTTPClient(client *http.Client) *CreateCalendarParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create calendar params
+func (o *CreateCalendarParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCalendar adds the calendar to the create calendar params
+func (o *CreateCalendarParams) WithCalendar(calendar *models.Calendar) *CreateCalendarParams {
+	o.SetCalendar(calendar)
+	return o
+}
+
+// SetCalendar adds the calendar to the create calendar params
+func (o *CreateCalendarParams) SetCalendar(calendar *models.Calendar) {
+	o.Calendar = calendar
+}
+
+// WithLogin adds the login to the create calendar params
+func (o *CreateCalendarParams) WithLogin(login string) *CreateCalendarParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the create calendar params
+func (o *CreateCalendarParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateCalendarParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Calendar != nil {
+		if err := r.SetBodyParam(o.Calendar); err != nil {
+			return err
+		}
+	}
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// CreateCalendarReader is a Reader for the CreateCalendar structure.
+type CreateCalendarReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateCalendarReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateCalendarOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewCreateCalendarNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewCreateCalendarUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewCreateCalendarDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateCalendarOK creates a CreateCalendarOK with default headers values
+func NewCreateCalendarOK() *CreateCalendarOK {
+	return &CreateCalendarOK{}
+}
+
+/*
+CreateCalendarOK describes a response with status code 200, with default header values.
+
+created calendar
+*/
+type CreateCalendarOK struct {
+	Payload *models.Calendar
+}
+
+// IsSuccess returns true when this create calendar o k response has a 2xx status code
+func (o *CreateCalendarOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create calendar o k response has a 3xx status code
+func (o *CreateCalendarOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create calendar o k response has a 4xx status code
+func (o *CreateCalendarOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create calendar o k response has a 5xx status code
+func (o *CreateCalendarOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create calendar o k response a status code equal to that given
+func (o *CreateCalendarOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create calendar o k response
+func (o *CreateCalendarOK) Code() int {
+	return 200
+}
+
+func (o *CreateCalendarOK) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateCalendarOK) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateCalendarOK) GetPayload() *models.Calendar {
+	return o.Payload
+}
+
+func (o *CreateCalendarOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Calendar)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateCalendarNotFound creates a CreateCalendarNotFound with default headers values
+func NewCreateCalendarNotFound() *CreateCalendarNotFound {
+	return &CreateCalendarNotFound{}
+}
+
+/*
+CreateCalendarNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type CreateCalendarNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create calendar not found response has a 2xx status code
+func (o *CreateCalendarNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create calendar not found response has a 3xx status code
+func (o *CreateCalendarNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create calendar not found response has a 4xx status code
+func (o *CreateCalendarNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create calendar not found response has a 5xx status code
+func (o *CreateCalendarNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create calendar not found response a status code equal to that given
+func (o *CreateCalendarNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the create calendar not found response
+func (o *CreateCalendarNotFound) Code() int {
+	return 404
+}
+
+func (o *CreateCalendarNotFound) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateCalendarNotFound) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateCalendarNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateCalendarNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateCalendarUnprocessableEntity creates a CreateCalendarUnprocessableEntity with default headers values
+func NewCreateCalendarUnprocessableEntity() *CreateCalendarUnprocessableEntity {
+	return &CreateCalendarUnprocessableEntity{}
+}
+
+/*
+CreateCalendarUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid calendar
+*/
+type CreateCalendarUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create calendar unprocessable entity response has a 2xx status code
+func (o *CreateCalendarUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create calendar unprocessable entity response has a 3xx status code
+func (o *CreateCalendarUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create calendar unprocessable entity response has a 4xx status code
+func (o *CreateCalendarUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create calendar unprocessable entity response has a 5xx status code
+func (o *CreateCalendarUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create calendar unprocessable entity response a status code equal to that given
+func (o *CreateCalendarUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the create calendar unprocessable entity response
+func (o *CreateCalendarUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *CreateCalendarUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendarUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *CreateCalendarUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendarUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *CreateCalendarUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateCalendarUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateCalendarDefault creates a CreateCalendarDefault with default headers values
+func NewCreateCalendarDefault(code int) *CreateCalendarDefault {
+	return &CreateCalendarDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CreateCalendarDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type CreateCalendarDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create calendar default response has a 2xx status code
+func (o *CreateCalendarDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this create calendar default response has a 3xx status code
+func (o *CreateCalendarDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this create calendar default response has a 4xx status code
+func (o *CreateCalendarDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this create calendar default response has a 5xx status code
+func (o *CreateCalendarDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this create calendar default response a status code equal to that given
+func (o *CreateCalendarDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the create calendar default response
+func (o *CreateCalendarDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateCalendarDefault) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateCalendarDefault) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/calendars][%d] createCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateCalendarDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateCalendarDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
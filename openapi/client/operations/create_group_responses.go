@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// CreateGroupReader is a Reader for the CreateGroup structure.
+type CreateGroupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateGroupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateGroupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 409:
+		result := NewCreateGroupConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewCreateGroupUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewCreateGroupDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateGroupOK creates a CreateGroupOK with default headers values
+func NewCreateGroupOK() *CreateGroupOK {
+	return &CreateGroupOK{}
+}
+
+/*
+CreateGroupOK describes a response with status code 200, with default header values.
+
+created group
+*/
+type CreateGroupOK struct {
+	Payload *models.Group
+}
+
+// IsSuccess returns true when this create group o k response has a 2xx status code
+func (o *CreateGroupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create group o k response has a 3xx status code
+func (o *CreateGroupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create group o k response has a 4xx status code
+func (o *CreateGroupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create group o k response has a 5xx status code
+func (o *CreateGroupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create group o k response a status code equal to that given
+func (o *CreateGroupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create group o k response
+func (o *CreateGroupOK) Code() int {
+	return 200
+}
+
+func (o *CreateGroupOK) Error() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateGroupOK) String() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateGroupOK) GetPayload() *models.Group {
+	return o.Payload
+}
+
+func (o *CreateGroupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Group)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateGroupConflict creates a CreateGroupConflict with default headers values
+func NewCreateGroupConflict() *CreateGroupConflict {
+	return &CreateGroupConflict{}
+}
+
+/*
+CreateGroupConflict describes a response with status code 409, with default header values.
+
+a group with the name exists
+*/
+type CreateGroupConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create group conflict response has a 2xx status code
+func (o *CreateGroupConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create group conflict response has a 3xx status code
+func (o *CreateGroupConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create group conflict response has a 4xx status code
+func (o *CreateGroupConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create group conflict response has a 5xx status code
+func (o *CreateGroupConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create group conflict response a status code equal to that given
+func (o *CreateGroupConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the create group conflict response
+func (o *CreateGroupConflict) Code() int {
+	return 409
+}
+
+func (o *CreateGroupConflict) Error() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroupConflict  %+v", 409, o.Payload)
+}
+
+func (o *CreateGroupConflict) String() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroupConflict  %+v", 409, o.Payload)
+}
+
+func (o *CreateGroupConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateGroupConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateGroupUnprocessableEntity creates a CreateGroupUnprocessableEntity with default headers values
+func NewCreateGroupUnprocessableEntity() *CreateGroupUnprocessableEntity {
+	return &CreateGroupUnprocessableEntity{}
+}
+
+/*
+CreateGroupUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid name, unknown members or nested groups
+*/
+type CreateGroupUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create group unprocessable entity response has a 2xx status code
+func (o *CreateGroupUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create group unprocessable entity response has a 3xx status code
+func (o *CreateGroupUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create group unprocessable entity response has a 4xx status code
+func (o *CreateGroupUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create group unprocessable entity response has a 5xx status code
+func (o *CreateGroupUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create group unprocessable entity response a status code equal to that given
+func (o *CreateGroupUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the create group unprocessable entity response
+func (o *CreateGroupUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *CreateGroupUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *CreateGroupUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *CreateGroupUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateGroupUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateGroupDefault creates a CreateGroupDefault with default headers values
+func NewCreateGroupDefault(code int) *CreateGroupDefault {
+	return &CreateGroupDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CreateGroupDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type CreateGroupDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create group default response has a 2xx status code
+func (o *CreateGroupDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this create group default response has a 3xx status code
+func (o *CreateGroupDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this create group default response has a 4xx status code
+func (o *CreateGroupDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this create group default response has a 5xx status code
+func (o *CreateGroupDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this create group default response a status code equal to that given
+func (o *CreateGroupDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the create group default response
+func (o *CreateGroupDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateGroupDefault) Error() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateGroupDefault) String() string {
+	return fmt.Sprintf("[POST /api/groups][%d] createGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateGroupDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateGroupDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// CreateSessionReader is a Reader for the CreateSession structure.
+type CreateSessionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateSessionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateSessionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewCreateSessionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewCreateSessionDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateSessionOK creates a CreateSessionOK with default headers values
+func NewCreateSessionOK() *CreateSessionOK {
+	return &CreateSessionOK{}
+}
+
+/*
+CreateSessionOK describes a response with status code 200, with default header values.
+
+session
+*/
+type CreateSessionOK struct {
+	Payload *models.Session
+}
+
+// IsSuccess returns true when this create session o k response has a 2xx status code
+func (o *CreateSessionOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create session o k response has a 3xx status code
+func (o *CreateSessionOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create session o k response has a 4xx status code
+func (o *CreateSessionOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create session o k response has a 5xx status code
+func (o *CreateSessionOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create session o k response a status code equal to that given
+func (o *CreateSessionOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create session o k response
+func (o *CreateSessionOK) Code() int {
+	return 200
+}
+
+func (o *CreateSessionOK) Error() string {
+	return fmt.Sprintf("[POST /api/sessions][%d] createSessionOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateSessionOK) String() string {
+	return fmt.Sprintf("[POST /api/sessions][%d] createSessionOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateSessionOK) GetPayload() *models.Session {
+	return o.Payload
+}
+
+func (o *CreateSessionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Session)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateSessionNotFound creates a CreateSessionNotFound with default headers values
+func NewCreateSessionNotFound() *CreateSessionNotFound {
+	return &CreateSessionNotFound{}
+}
+
+/*
+CreateSessionNotFound describes a response with status code 404, with default header values.
+
+sessions are not enabled
+*/
+type CreateSessionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create session not found response has a 2xx status code
+func (o *CreateSessionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create session not found response has a 3xx status code
+func (o *CreateSessionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create session not found response has a 4xx status code
+func (o *CreateSessionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create session not found response has a 5xx status code
+func (o *CreateSessionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create session not found response a status code equal to that given
+func (o *CreateSessionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the create session not found response
+func (o *CreateSessionNotFound) Code() int {
+	return 404
+}
+
+func (o *CreateSessionNotFound) Error() string {
+	return fmt.Sprintf("[POST /api/sessions][%d] createSessionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateSessionNotFound) String() string {
+	return fmt.Sprintf("[POST /api/sessions][%d] createSessionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateSessionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateSessionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateSessionDefault creates a CreateSessionDefault with default headers values
+func NewCreateSessionDefault(code int) *CreateSessionDefault {
+	return &CreateSessionDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CreateSessionDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type CreateSessionDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this create session default response has a 2xx status code
+func (o *CreateSessionDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this create session default response has a 3xx status code
+func (o *CreateSessionDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this create session default response has a 4xx status code
+func (o *CreateSessionDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this create session default response has a 5xx status code
+func (o *CreateSessionDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this create session default response a status code equal to that given
+func (o *CreateSessionDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the create session default response
+func (o *CreateSessionDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateSessionDefault) Error() string {
+	return fmt.Sprintf("[POST /api/sessions][%d] createSession default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateSessionDefault) String() string {
+	return fmt.Sprintf("[POST /api/sessions][%d] createSession default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateSessionDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateSessionDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
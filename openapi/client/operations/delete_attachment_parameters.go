@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteAttachmentParams creates a new DeleteAttachmentParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteAttachmentParams() *DeleteAttachmentParams {
+	return &DeleteAttachmentParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteAttachmentParamsWithTimeout creates a new DeleteAttachmentParams object
+// with the ability to set a timeout on a request.
+func NewDeleteAttachmentParamsWithTimeout(timeout time.Duration) *DeleteAttachmentParams {
+	return &DeleteAttachmentParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteAttachmentParamsWithContext creates a new DeleteAttachmentParams object
+// with the ability to set a context for a request.
+func NewDeleteAttachmentParamsWithContext(ctx context.Context) *DeleteAttachmentParams {
+	return &DeleteAttachmentParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteAttachmentParamsWithHTTPClient creates a new DeleteAttachmentParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteAttachmentParamsWithHTTPClient(client *http.Client) *DeleteAttachmentParams {
+	return &DeleteAttachmentParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeleteAttachmentParams contains all the parameters to send to the API endpoint
+
+	for the delete attachment operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeleteAttachmentParams struct {
+
+	// AttachmentID.
+	AttachmentID string
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete attachment params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteAttachmentParams) WithDefaults() *DeleteAttachmentParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete attachment params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteAttachmentParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete attachment params
+func (o *DeleteAttachmentParams) WithTimeout(timeout time.Duration) *DeleteAttachmentParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete attachment params
+func (o *DeleteAttachmentParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete attachment params
+func (o *DeleteAttachmentParams) WithContext(ctx context.Context) *DeleteAttachmentParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete attachment params
+func (o *DeleteAttachmentParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete attachment params
+func (o *DeleteAttachmentParams) WithHTTPClient(client *http.Client) *DeleteAttachmentParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete attachment params
+func (o *DeleteAttachmentParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAttachmentID adds the attachmentID to the delete attachment params
+func (o *DeleteAttachmentParams) WithAttachmentID(attachmentID string) *DeleteAttachmentParams {
+	o.SetAttachmentID(attachmentID)
+	return o
+}
+
+// SetAttachmentID adds the attachmentId to the delete attachment params
+func (o *DeleteAttachmentParams) SetAttachmentID(attachmentID string) {
+	o.AttachmentID = attachmentID
+}
+
+// WithID adds the id to the delete attachment params
+func (o *DeleteAttachmentParams) WithID(id string) *DeleteAttachmentParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the delete attachment params
+func (o *DeleteAttachmentParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteAttachmentParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param attachmentId
+	if err := r.SetPathParam("attachmentId", o.AttachmentID); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
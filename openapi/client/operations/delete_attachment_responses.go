@@ -0,0 +1,318 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// DeleteAttachmentReader is a Reader for the DeleteAttachment structure.
+type DeleteAttachmentReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteAttachmentReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteAttachmentNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewDeleteAttachmentForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeleteAttachmentNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteAttachmentDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteAttachmentNoContent creates a DeleteAttachmentNoContent with default headers values
+func NewDeleteAttachmentNoContent() *DeleteAttachmentNoContent {
+	return &DeleteAttachmentNoContent{}
+}
+
+/*
+DeleteAttachmentNoContent describes a response with status code 204, with default header values.
+
+deleted
+*/
+type DeleteAttachmentNoContent struct {
+}
+
+// IsSuccess returns true when this delete attachment no content response has a 2xx status code
+func (o *DeleteAttachmentNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete attachment no content response has a 3xx status code
+func (o *DeleteAttachmentNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete attachment no content response has a 4xx status code
+func (o *DeleteAttachmentNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete attachment no content response has a 5xx status code
+func (o *DeleteAttachmentNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete attachment no content response a status code equal to that given
+func (o *DeleteAttachmentNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete attachment no content response
+func (o *DeleteAttachmentNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteAttachmentNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachmentNoContent ", 204)
+}
+
+func (o *DeleteAttachmentNoContent) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachmentNoContent ", 204)
+}
+
+func (o *DeleteAttachmentNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteAttachmentForbidden creates a DeleteAttachmentForbidden with default headers values
+func NewDeleteAttachmentForbidden() *DeleteAttachmentForbidden {
+	return &DeleteAttachmentForbidden{}
+}
+
+/*
+DeleteAttachmentForbidden describes a response with status code 403, with default header values.
+
+the owner doesn't share edit with the caller
+*/
+type DeleteAttachmentForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete attachment forbidden response has a 2xx status code
+func (o *DeleteAttachmentForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete attachment forbidden response has a 3xx status code
+func (o *DeleteAttachmentForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete attachment forbidden response has a 4xx status code
+func (o *DeleteAttachmentForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete attachment forbidden response has a 5xx status code
+func (o *DeleteAttachmentForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete attachment forbidden response a status code equal to that given
+func (o *DeleteAttachmentForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the delete attachment forbidden response
+func (o *DeleteAttachmentForbidden) Code() int {
+	return 403
+}
+
+func (o *DeleteAttachmentForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachmentForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteAttachmentForbidden) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachmentForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteAttachmentForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteAttachmentForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAttachmentNotFound creates a DeleteAttachmentNotFound with default headers values
+func NewDeleteAttachmentNotFound() *DeleteAttachmentNotFound {
+	return &DeleteAttachmentNotFound{}
+}
+
+/*
+DeleteAttachmentNotFound describes a response with status code 404, with default header values.
+
+no such meeting or file
+*/
+type DeleteAttachmentNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete attachment not found response has a 2xx status code
+func (o *DeleteAttachmentNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete attachment not found response has a 3xx status code
+func (o *DeleteAttachmentNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete attachment not found response has a 4xx status code
+func (o *DeleteAttachmentNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete attachment not found response has a 5xx status code
+func (o *DeleteAttachmentNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete attachment not found response a status code equal to that given
+func (o *DeleteAttachmentNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete attachment not found response
+func (o *DeleteAttachmentNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteAttachmentNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachmentNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteAttachmentNotFound) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachmentNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteAttachmentNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteAttachmentNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAttachmentDefault creates a DeleteAttachmentDefault with default headers values
+func NewDeleteAttachmentDefault(code int) *DeleteAttachmentDefault {
+	return &DeleteAttachmentDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeleteAttachmentDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteAttachmentDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete attachment default response has a 2xx status code
+func (o *DeleteAttachmentDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this delete attachment default response has a 3xx status code
+func (o *DeleteAttachmentDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this delete attachment default response has a 4xx status code
+func (o *DeleteAttachmentDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this delete attachment default response has a 5xx status code
+func (o *DeleteAttachmentDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this delete attachment default response a status code equal to that given
+func (o *DeleteAttachmentDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the delete attachment default response
+func (o *DeleteAttachmentDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteAttachmentDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachment default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteAttachmentDefault) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}/attachments/{attachmentId}][%d] deleteAttachment default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteAttachmentDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteAttachmentDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
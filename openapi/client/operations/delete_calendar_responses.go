@@ -0,0 +1,318 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// DeleteCalendarReader is a Reader for the DeleteCalendar structure.
+type DeleteCalendarReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteCalendarReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteCalendarNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteCalendarNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewDeleteCalendarUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteCalendarDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteCalendarNoContent creates a DeleteCalendarNoContent with default headers values
+func NewDeleteCalendarNoContent() *DeleteCalendarNoContent {
+	return &DeleteCalendarNoContent{}
+}
+
+/*
+DeleteCalendarNoContent describes a response with status code 204, with default header values.
+
+deleted
+*/
+type DeleteCalendarNoContent struct {
+}
+
+// IsSuccess returns true when this delete calendar no content response has a 2xx status code
+func (o *DeleteCalendarNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete calendar no content response has a 3xx status code
+func (o *DeleteCalendarNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete calendar no content response has a 4xx status code
+func (o *DeleteCalendarNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete calendar no content response has a 5xx status code
+func (o *DeleteCalendarNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete calendar no content response a status code equal to that given
+func (o *DeleteCalendarNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete calendar no content response
+func (o *DeleteCalendarNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteCalendarNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendarNoContent ", 204)
+}
+
+func (o *DeleteCalendarNoContent) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendarNoContent ", 204)
+}
+
+func (o *DeleteCalendarNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteCalendarNotFound creates a DeleteCalendarNotFound with default headers values
+func NewDeleteCalendarNotFound() *DeleteCalendarNotFound {
+	return &DeleteCalendarNotFound{}
+}
+
+/*
+DeleteCalendarNotFound describes a response with status code 404, with default header values.
+
+no such calendar
+*/
+type DeleteCalendarNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete calendar not found response has a 2xx status code
+func (o *DeleteCalendarNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete calendar not found response has a 3xx status code
+func (o *DeleteCalendarNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete calendar not found response has a 4xx status code
+func (o *DeleteCalendarNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete calendar not found response has a 5xx status code
+func (o *DeleteCalendarNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete calendar not found response a status code equal to that given
+func (o *DeleteCalendarNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete calendar not found response
+func (o *DeleteCalendarNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteCalendarNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteCalendarNotFound) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteCalendarNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteCalendarNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteCalendarUnprocessableEntity creates a DeleteCalendarUnprocessableEntity with default headers values
+func NewDeleteCalendarUnprocessableEntity() *DeleteCalendarUnprocessableEntity {
+	return &DeleteCalendarUnprocessableEntity{}
+}
+
+/*
+DeleteCalendarUnprocessableEntity describes a response with status code 422, with default header values.
+
+the default calendar
+*/
+type DeleteCalendarUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete calendar unprocessable entity response has a 2xx status code
+func (o *DeleteCalendarUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete calendar unprocessable entity response has a 3xx status code
+func (o *DeleteCalendarUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete calendar unprocessable entity response has a 4xx status code
+func (o *DeleteCalendarUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete calendar unprocessable entity response has a 5xx status code
+func (o *DeleteCalendarUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete calendar unprocessable entity response a status code equal to that given
+func (o *DeleteCalendarUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the delete calendar unprocessable entity response
+func (o *DeleteCalendarUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *DeleteCalendarUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendarUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *DeleteCalendarUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendarUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *DeleteCalendarUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteCalendarUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteCalendarDefault creates a DeleteCalendarDefault with default headers values
+func NewDeleteCalendarDefault(code int) *DeleteCalendarDefault {
+	return &DeleteCalendarDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeleteCalendarDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteCalendarDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete calendar default response has a 2xx status code
+func (o *DeleteCalendarDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this delete calendar default response has a 3xx status code
+func (o *DeleteCalendarDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this delete calendar default response has a 4xx status code
+func (o *DeleteCalendarDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this delete calendar default response has a 5xx status code
+func (o *DeleteCalendarDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this delete calendar default response a status code equal to that given
+func (o *DeleteCalendarDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the delete calendar default response
+func (o *DeleteCalendarDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteCalendarDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteCalendarDefault) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}/calendars/{id}][%d] deleteCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteCalendarDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteCalendarDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
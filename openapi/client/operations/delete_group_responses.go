@@ -0,0 +1,392 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// DeleteGroupReader is a Reader for the DeleteGroup structure.
+type DeleteGroupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteGroupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteGroupNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewDeleteGroupForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeleteGroupNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewDeleteGroupConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteGroupDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteGroupNoContent creates a DeleteGroupNoContent with default headers values
+func NewDeleteGroupNoContent() *DeleteGroupNoContent {
+	return &DeleteGroupNoContent{}
+}
+
+/*
+DeleteGroupNoContent describes a response with status code 204, with default header values.
+
+deleted
+*/
+type DeleteGroupNoContent struct {
+}
+
+// IsSuccess returns true when this delete group no content response has a 2xx status code
+func (o *DeleteGroupNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete group no content response has a 3xx status code
+func (o *DeleteGroupNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete group no content response has a 4xx status code
+func (o *DeleteGroupNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete group no content response has a 5xx status code
+func (o *DeleteGroupNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete group no content response a status code equal to that given
+func (o *DeleteGroupNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete group no content response
+func (o *DeleteGroupNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteGroupNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupNoContent ", 204)
+}
+
+func (o *DeleteGroupNoContent) String() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupNoContent ", 204)
+}
+
+func (o *DeleteGroupNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteGroupForbidden creates a DeleteGroupForbidden with default headers values
+func NewDeleteGroupForbidden() *DeleteGroupForbidden {
+	return &DeleteGroupForbidden{}
+}
+
+/*
+DeleteGroupForbidden describes a response with status code 403, with default header values.
+
+not the owner of the group
+*/
+type DeleteGroupForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete group forbidden response has a 2xx status code
+func (o *DeleteGroupForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete group forbidden response has a 3xx status code
+func (o *DeleteGroupForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete group forbidden response has a 4xx status code
+func (o *DeleteGroupForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete group forbidden response has a 5xx status code
+func (o *DeleteGroupForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete group forbidden response a status code equal to that given
+func (o *DeleteGroupForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the delete group forbidden response
+func (o *DeleteGroupForbidden) Code() int {
+	return 403
+}
+
+func (o *DeleteGroupForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteGroupForbidden) String() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteGroupForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteGroupForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteGroupNotFound creates a DeleteGroupNotFound with default headers values
+func NewDeleteGroupNotFound() *DeleteGroupNotFound {
+	return &DeleteGroupNotFound{}
+}
+
+/*
+DeleteGroupNotFound describes a response with status code 404, with default header values.
+
+no such group
+*/
+type DeleteGroupNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete group not found response has a 2xx status code
+func (o *DeleteGroupNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete group not found response has a 3xx status code
+func (o *DeleteGroupNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete group not found response has a 4xx status code
+func (o *DeleteGroupNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete group not found response has a 5xx status code
+func (o *DeleteGroupNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete group not found response a status code equal to that given
+func (o *DeleteGroupNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete group not found response
+func (o *DeleteGroupNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteGroupNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteGroupNotFound) String() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteGroupNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteGroupNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteGroupConflict creates a DeleteGroupConflict with default headers values
+func NewDeleteGroupConflict() *DeleteGroupConflict {
+	return &DeleteGroupConflict{}
+}
+
+/*
+DeleteGroupConflict describes a response with status code 409, with default header values.
+
+the group is nested in another one
+*/
+type DeleteGroupConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete group conflict response has a 2xx status code
+func (o *DeleteGroupConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete group conflict response has a 3xx status code
+func (o *DeleteGroupConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete group conflict response has a 4xx status code
+func (o *DeleteGroupConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete group conflict response has a 5xx status code
+func (o *DeleteGroupConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete group conflict response a status code equal to that given
+func (o *DeleteGroupConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the delete group conflict response
+func (o *DeleteGroupConflict) Code() int {
+	return 409
+}
+
+func (o *DeleteGroupConflict) Error() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupConflict  %+v", 409, o.Payload)
+}
+
+func (o *DeleteGroupConflict) String() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroupConflict  %+v", 409, o.Payload)
+}
+
+func (o *DeleteGroupConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteGroupConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteGroupDefault creates a DeleteGroupDefault with default headers values
+func NewDeleteGroupDefault(code int) *DeleteGroupDefault {
+	return &DeleteGroupDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeleteGroupDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteGroupDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete group default response has a 2xx status code
+func (o *DeleteGroupDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this delete group default response has a 3xx status code
+func (o *DeleteGroupDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this delete group default response has a 4xx status code
+func (o *DeleteGroupDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this delete group default response has a 5xx status code
+func (o *DeleteGroupDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this delete group default response a status code equal to that given
+func (o *DeleteGroupDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the delete group default response
+func (o *DeleteGroupDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteGroupDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteGroupDefault) String() string {
+	return fmt.Sprintf("[DELETE /api/groups/{name}][%d] deleteGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteGroupDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteGroupDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteMeetingParams creates a new DeleteMeetingParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteMeetingParams() *DeleteMeetingParams {
+	return &DeleteMeetingParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteMeetingParamsWithTimeout creates a new DeleteMeetingParams object
+// with the ability to set a timeout on a request.
+func NewDeleteMeetingParamsWithTimeout(timeout time.Duration) *DeleteMeetingParams {
+	return &DeleteMeetingParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteMeetingParamsWithContext creates a new DeleteMeetingParams object
+// with the ability to set a context for a request.
+func NewDeleteMeetingParamsWithContext(ctx context.Context) *DeleteMeetingParams {
+	return &DeleteMeetingParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteMeetingParamsWithHTTPClient creates a new DeleteMeetingParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteMeetingParamsWithHTTPClient(client *http.Client) *DeleteMeetingParams {
+	return &DeleteMeetingParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeleteMeetingParams contains all the parameters to send to the API endpoint
+
+	for the delete meeting operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeleteMeetingParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteMeetingParams) WithDefaults() *DeleteMeetingParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteMeetingParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete meeting params
+func (o *DeleteMeetingParams) WithTimeout(timeout time.Duration) *DeleteMeetingParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete meeting params
+func (o *DeleteMeetingParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete meeting params
+func (o *DeleteMeetingParams) WithContext(ctx context.Context) *DeleteMeetingParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete meeting params
+func (o *DeleteMeetingParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete meeting params
+func (o *DeleteMeetingParams) WithHTTPClient(client *http.Client) *DeleteMeetingParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete meeting params
+func (o *DeleteMeetingParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the delete meeting params
+func (o *DeleteMeetingParams) WithID(id string) *DeleteMeetingParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the delete meeting params
+func (o *DeleteMeetingParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteMeetingParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// DeleteMeetingReader is a Reader for the DeleteMeeting structure.
+type DeleteMeetingReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteMeetingReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteMeetingNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewDeleteMeetingForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeleteMeetingNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteMeetingDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteMeetingNoContent creates a DeleteMeetingNoContent with default headers values
+func NewDeleteMeetingNoContent() *DeleteMeetingNoContent {
+	return &DeleteMeetingNoContent{}
+}
+
+/*
+DeleteMeetingNoContent describes a response with status code 204, with default header values.
+
+deleted
+*/
+type DeleteMeetingNoContent struct {
+}
+
+// IsSuccess returns true when this delete meeting no content response has a 2xx status code
+func (o *DeleteMeetingNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete meeting no content response has a 3xx status code
+func (o *DeleteMeetingNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete meeting no content response has a 4xx status code
+func (o *DeleteMeetingNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete meeting no content response has a 5xx status code
+func (o *DeleteMeetingNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete meeting no content response a status code equal to that given
+func (o *DeleteMeetingNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete meeting no content response
+func (o *DeleteMeetingNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteMeetingNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeetingNoContent ", 204)
+}
+
+func (o *DeleteMeetingNoContent) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeetingNoContent ", 204)
+}
+
+func (o *DeleteMeetingNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteMeetingForbidden creates a DeleteMeetingForbidden with default headers values
+func NewDeleteMeetingForbidden() *DeleteMeetingForbidden {
+	return &DeleteMeetingForbidden{}
+}
+
+/*
+DeleteMeetingForbidden describes a response with status code 403, with default header values.
+
+the owner doesn't share edit with the caller
+*/
+type DeleteMeetingForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete meeting forbidden response has a 2xx status code
+func (o *DeleteMeetingForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete meeting forbidden response has a 3xx status code
+func (o *DeleteMeetingForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete meeting forbidden response has a 4xx status code
+func (o *DeleteMeetingForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete meeting forbidden response has a 5xx status code
+func (o *DeleteMeetingForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete meeting forbidden response a status code equal to that given
+func (o *DeleteMeetingForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the delete meeting forbidden response
+func (o *DeleteMeetingForbidden) Code() int {
+	return 403
+}
+
+func (o *DeleteMeetingForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeetingForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteMeetingForbidden) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeetingForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteMeetingForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteMeetingForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteMeetingNotFound creates a DeleteMeetingNotFound with default headers values
+func NewDeleteMeetingNotFound() *DeleteMeetingNotFound {
+	return &DeleteMeetingNotFound{}
+}
+
+/*
+DeleteMeetingNotFound describes a response with status code 404, with default header values.
+
+no such meeting
+*/
+type DeleteMeetingNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete meeting not found response has a 2xx status code
+func (o *DeleteMeetingNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete meeting not found response has a 3xx status code
+func (o *DeleteMeetingNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete meeting not found response has a 4xx status code
+func (o *DeleteMeetingNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete meeting not found response has a 5xx status code
+func (o *DeleteMeetingNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete meeting not found response a status code equal to that given
+func (o *DeleteMeetingNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete meeting not found response
+func (o *DeleteMeetingNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteMeetingNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteMeetingNotFound) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteMeetingNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteMeetingNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteMeetingDefault creates a DeleteMeetingDefault with default headers values
+func NewDeleteMeetingDefault(code int) *DeleteMeetingDefault {
+	return &DeleteMeetingDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeleteMeetingDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteMeetingDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete meeting default response has a 2xx status code
+func (o *DeleteMeetingDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this delete meeting default response has a 3xx status code
+func (o *DeleteMeetingDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this delete meeting default response has a 4xx status code
+func (o *DeleteMeetingDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this delete meeting default response has a 5xx status code
+func (o *DeleteMeetingDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this delete meeting default response a status code equal to that given
+func (o *DeleteMeetingDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the delete meeting default response
+func (o *DeleteMeetingDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteMeetingDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteMeetingDefault) String() string {
+	return fmt.Sprintf("[DELETE /api/meetings/{id}][%d] deleteMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteMeetingDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteMeetingDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// DeleteUserReader is a Reader for the DeleteUser structure.
+type DeleteUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteUserNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewDeleteUserUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteUserNoContent creates a DeleteUserNoContent with default headers values
+func NewDeleteUserNoContent() *DeleteUserNoContent {
+	return &DeleteUserNoContent{}
+}
+
+/*
+DeleteUserNoContent describes a response with status code 204, with default header values.
+
+deleted
+*/
+type DeleteUserNoContent struct {
+}
+
+// IsSuccess returns true when this delete user no content response has a 2xx status code
+func (o *DeleteUserNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete user no content response has a 3xx status code
+func (o *DeleteUserNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user no content response has a 4xx status code
+func (o *DeleteUserNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete user no content response has a 5xx status code
+func (o *DeleteUserNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user no content response a status code equal to that given
+func (o *DeleteUserNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete user no content response
+func (o *DeleteUserNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteUserNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUserNoContent ", 204)
+}
+
+func (o *DeleteUserNoContent) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUserNoContent ", 204)
+}
+
+func (o *DeleteUserNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteUserNotFound creates a DeleteUserNotFound with default headers values
+func NewDeleteUserNotFound() *DeleteUserNotFound {
+	return &DeleteUserNotFound{}
+}
+
+/*
+DeleteUserNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type DeleteUserNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete user not found response has a 2xx status code
+func (o *DeleteUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user not found response has a 3xx status code
+func (o *DeleteUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user not found response has a 4xx status code
+func (o *DeleteUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user not found response has a 5xx status code
+func (o *DeleteUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user not found response a status code equal to that given
+func (o *DeleteUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete user not found response
+func (o *DeleteUserNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteUserNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUserNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteUserNotFound) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUserNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteUserNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteUserUnprocessableEntity creates a DeleteUserUnprocessableEntity with default headers values
+func NewDeleteUserUnprocessableEntity() *DeleteUserUnprocessableEntity {
+	return &DeleteUserUnprocessableEntity{}
+}
+
+/*
+DeleteUserUnprocessableEntity describes a response with status code 422, with default header values.
+
+unknown user to transfer to
+*/
+type DeleteUserUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete user unprocessable entity response has a 2xx status code
+func (o *DeleteUserUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user unprocessable entity response has a 3xx status code
+func (o *DeleteUserUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user unprocessable entity response has a 4xx status code
+func (o *DeleteUserUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user unprocessable entity response has a 5xx status code
+func (o *DeleteUserUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user unprocessable entity response a status code equal to that given
+func (o *DeleteUserUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the delete user unprocessable entity response
+func (o *DeleteUserUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *DeleteUserUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *DeleteUserUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *DeleteUserUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteUserUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteUserDefault creates a DeleteUserDefault with default headers values
+func NewDeleteUserDefault(code int) *DeleteUserDefault {
+	return &DeleteUserDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeleteUserDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteUserDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete user default response has a 2xx status code
+func (o *DeleteUserDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this delete user default response has a 3xx status code
+func (o *DeleteUserDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this delete user default response has a 4xx status code
+func (o *DeleteUserDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this delete user default response has a 5xx status code
+func (o *DeleteUserDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this delete user default response a status code equal to that given
+func (o *DeleteUserDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the delete user default response
+func (o *DeleteUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteUserDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUser default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteUserDefault) String() string {
+	return fmt.Sprintf("[DELETE /api/users/{login}][%d] deleteUser default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteUserDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// DeleteWebhookReader is a Reader for the DeleteWebhook structure.
+type DeleteWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteWebhookNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteWebhookNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteWebhookDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteWebhookNoContent creates a DeleteWebhookNoContent with default headers values
+func NewDeleteWebhookNoContent() *DeleteWebhookNoContent {
+	return &DeleteWebhookNoContent{}
+}
+
+/*
+DeleteWebhookNoContent describes a response with status code 204, with default header values.
+
+deleted, pending deliveries are dropped
+*/
+type DeleteWebhookNoContent struct {
+}
+
+// IsSuccess returns true when this delete webhook no content response has a 2xx status code
+func (o *DeleteWebhookNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete webhook no content response has a 3xx status code
+func (o *DeleteWebhookNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete webhook no content response has a 4xx status code
+func (o *DeleteWebhookNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete webhook no content response has a 5xx status code
+func (o *DeleteWebhookNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete webhook no content response a status code equal to that given
+func (o *DeleteWebhookNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete webhook no content response
+func (o *DeleteWebhookNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteWebhookNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /api/webhooks/{id}][%d] deleteWebhookNoContent ", 204)
+}
+
+func (o *DeleteWebhookNoContent) String() string {
+	return fmt.Sprintf("[DELETE /api/webhooks/{id}][%d] deleteWebhookNoContent ", 204)
+}
+
+func (o *DeleteWebhookNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteWebhookNotFound creates a DeleteWebhookNotFound with default headers values
+func NewDeleteWebhookNotFound() *DeleteWebhookNotFound {
+	return &DeleteWebhookNotFound{}
+}
+
+/*
+DeleteWebhookNotFound describes a response with status code 404, with default header values.
+
+no such webhook
+*/
+type DeleteWebhookNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete webhook not found response has a 2xx status code
+func (o *DeleteWebhookNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete webhook not found response has a 3xx status code
+func (o *DeleteWebhookNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete webhook not found response has a 4xx status code
+func (o *DeleteWebhookNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete webhook not found response has a 5xx status code
+func (o *DeleteWebhookNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete webhook not found response a status code equal to that given
+func (o *DeleteWebhookNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete webhook not found response
+func (o *DeleteWebhookNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteWebhookNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /api/webhooks/{id}][%d] deleteWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteWebhookNotFound) String() string {
+	return fmt.Sprintf("[DELETE /api/webhooks/{id}][%d] deleteWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteWebhookNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteWebhookNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteWebhookDefault creates a DeleteWebhookDefault with default headers values
+func NewDeleteWebhookDefault(code int) *DeleteWebhookDefault {
+	return &DeleteWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeleteWebhookDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteWebhookDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this delete webhook default response has a 2xx status code
+func (o *DeleteWebhookDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this delete webhook default response has a 3xx status code
+func (o *DeleteWebhookDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this delete webhook default response has a 4xx status code
+func (o *DeleteWebhookDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this delete webhook default response has a 5xx status code
+func (o *DeleteWebhookDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this delete webhook default response a status code equal to that given
+func (o *DeleteWebhookDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the delete webhook default response
+func (o *DeleteWebhookDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteWebhookDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/webhooks/{id}][%d] deleteWebhook default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteWebhookDefault) String() string {
+	return fmt.Sprintf("[DELETE /api/webhooks/{id}][%d] deleteWebhook default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteWebhookDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteWebhookDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
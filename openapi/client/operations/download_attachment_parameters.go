@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDownloadAttachmentParams creates a new DownloadAttachmentParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDownloadAttachmentParams() *DownloadAttachmentParams {
+	return &DownloadAttachmentParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDownloadAttachmentParamsWithTimeout creates a new DownloadAttachmentParams object
+// with the ability to set a timeout on a request.
+func NewDownloadAttachmentParamsWithTimeout(timeout time.Duration) *DownloadAttachmentParams {
+	return &DownloadAttachmentParams{
+		timeout: timeout,
+	}
+}
+
+// NewDownloadAttachmentParamsWithContext creates a new DownloadAttachmentParams object
+// with the ability to set a context for a request.
+func NewDownloadAttachmentParamsWithContext(ctx context.Context) *DownloadAttachmentParams {
+	return &DownloadAttachmentParams{
+		Context: ctx,
+	}
+}
+
+// NewDownloadAttachmentParamsWithHTTPClient creates a new DownloadAttachmentParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDownloadAttachmentParamsWithHTTPClient(client *http.Client) *DownloadAttachmentParams {
+	return &DownloadAttachmentParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DownloadAttachmentParams contains all the parameters to send to the API endpoint
+
+	for the download attachment operation.
+
+	Typically these are written to a http.Request.
+*/
+type DownloadAttachmentParams struct {
+
+	// AttachmentID.
+	AttachmentID string
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the download attachment params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DownloadAttachmentParams) WithDefaults() *DownloadAttachmentParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the download attachment params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DownloadAttachmentParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the download attachment params
+func (o *DownloadAttachmentParams) WithTimeout(timeout time.Duration) *DownloadAttachmentParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the download attachment params
+func (o *DownloadAttachmentParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the download attachment params
+func (o *DownloadAttachmentParams) WithContext(ctx context.Context) *DownloadAttachmentParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the download attachment params
+func (o *DownloadAttachmentParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the download attachment params
+func (o *DownloadAttachmentParams) WithHTTPClient(client *http.Client) *DownloadAttachmentParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the download attachment params
+func (o *DownloadAttachmentParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAttachmentID adds the attachmentID to the download attachment params
+func (o *DownloadAttachmentParams) WithAttachmentID(attachmentID string) *DownloadAttachmentParams {
+	o.SetAttachmentID(attachmentID)
+	return o
+}
+
+// SetAttachmentID adds the attachmentId to the download attachment params
+func (o *DownloadAttachmentParams) SetAttachmentID(attachmentID string) {
+	o.AttachmentID = attachmentID
+}
+
+// WithID adds the id to the download attachment params
+func (o *DownloadAttachmentParams) WithID(id string) *DownloadAttachmentParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the download attachment params
+func (o *DownloadAttachmentParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DownloadAttachmentParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param attachmentId
+	if err := r.SetPathParam("attachmentId", o.AttachmentID); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
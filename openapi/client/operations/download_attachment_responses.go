@@ -0,0 +1,318 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// DownloadAttachmentReader is a Reader for the DownloadAttachment structure.
+type DownloadAttachmentReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DownloadAttachmentReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDownloadAttachmentOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewDownloadAttachmentForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDownloadAttachmentNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDownloadAttachmentDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDownloadAttachmentOK creates a DownloadAttachmentOK with default headers values
+func NewDownloadAttachmentOK() *DownloadAttachmentOK {
+	return &DownloadAttachmentOK{}
+}
+
+/*
+DownloadAttachmentOK describes a response with status code 200, with default header values.
+
+the file, with its type
+*/
+type DownloadAttachmentOK struct {
+}
+
+// IsSuccess returns true when this download attachment o k response has a 2xx status code
+func (o *DownloadAttachmentOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this download attachment o k response has a 3xx status code
+func (o *DownloadAttachmentOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this download attachment o k response has a 4xx status code
+func (o *DownloadAttachmentOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this download attachment o k response has a 5xx status code
+func (o *DownloadAttachmentOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this download attachment o k response a status code equal to that given
+func (o *DownloadAttachmentOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the download attachment o k response
+func (o *DownloadAttachmentOK) Code() int {
+	return 200
+}
+
+func (o *DownloadAttachmentOK) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachmentOK ", 200)
+}
+
+func (o *DownloadAttachmentOK) String() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachmentOK ", 200)
+}
+
+func (o *DownloadAttachmentOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDownloadAttachmentForbidden creates a DownloadAttachmentForbidden with default headers values
+func NewDownloadAttachmentForbidden() *DownloadAttachmentForbidden {
+	return &DownloadAttachmentForbidden{}
+}
+
+/*
+DownloadAttachmentForbidden describes a response with status code 403, with default header values.
+
+the caller only sees the time of the meeting
+*/
+type DownloadAttachmentForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this download attachment forbidden response has a 2xx status code
+func (o *DownloadAttachmentForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this download attachment forbidden response has a 3xx status code
+func (o *DownloadAttachmentForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this download attachment forbidden response has a 4xx status code
+func (o *DownloadAttachmentForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this download attachment forbidden response has a 5xx status code
+func (o *DownloadAttachmentForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this download attachment forbidden response a status code equal to that given
+func (o *DownloadAttachmentForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the download attachment forbidden response
+func (o *DownloadAttachmentForbidden) Code() int {
+	return 403
+}
+
+func (o *DownloadAttachmentForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachmentForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DownloadAttachmentForbidden) String() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachmentForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DownloadAttachmentForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadAttachmentForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDownloadAttachmentNotFound creates a DownloadAttachmentNotFound with default headers values
+func NewDownloadAttachmentNotFound() *DownloadAttachmentNotFound {
+	return &DownloadAttachmentNotFound{}
+}
+
+/*
+DownloadAttachmentNotFound describes a response with status code 404, with default header values.
+
+no such meeting or file
+*/
+type DownloadAttachmentNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this download attachment not found response has a 2xx status code
+func (o *DownloadAttachmentNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this download attachment not found response has a 3xx status code
+func (o *DownloadAttachmentNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this download attachment not found response has a 4xx status code
+func (o *DownloadAttachmentNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this download attachment not found response has a 5xx status code
+func (o *DownloadAttachmentNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this download attachment not found response a status code equal to that given
+func (o *DownloadAttachmentNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the download attachment not found response
+func (o *DownloadAttachmentNotFound) Code() int {
+	return 404
+}
+
+func (o *DownloadAttachmentNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachmentNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DownloadAttachmentNotFound) String() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachmentNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DownloadAttachmentNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadAttachmentNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDownloadAttachmentDefault creates a DownloadAttachmentDefault with default headers values
+func NewDownloadAttachmentDefault(code int) *DownloadAttachmentDefault {
+	return &DownloadAttachmentDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DownloadAttachmentDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DownloadAttachmentDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this download attachment default response has a 2xx status code
+func (o *DownloadAttachmentDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this download attachment default response has a 3xx status code
+func (o *DownloadAttachmentDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this download attachment default response has a 4xx status code
+func (o *DownloadAttachmentDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this download attachment default response has a 5xx status code
+func (o *DownloadAttachmentDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this download attachment default response a status code equal to that given
+func (o *DownloadAttachmentDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the download attachment default response
+func (o *DownloadAttachmentDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DownloadAttachmentDefault) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachment default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DownloadAttachmentDefault) String() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}/attachments/{attachmentId}][%d] downloadAttachment default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DownloadAttachmentDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadAttachmentDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewExportCalendarParams creates a new ExportCalendarParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewExportCalendarParams() *ExportCalendarParams {
+	return &ExportCalendarParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewExportCalendarParamsWithTimeout creates a new ExportCalendarParams object
+// with the ability to set a timeout on a request.
+func NewExportCalendarParamsWithTimeout(timeout time.Duration) *ExportCalendarParams {
+	return &ExportCalendarParams{
+		timeout: timeout,
+	}
+}
+
+// NewExportCalendarParamsWithContext creates a new ExportCalendarParams object
+// with the ability to set a context for a request.
+func NewExportCalendarParamsWithContext(ctx context.Context) *ExportCalendarParams {
+	return &ExportCalendarParams{
+		Context: ctx,
+	}
+}
+
+// NewExportCalendarParamsWithHTTPClient creates a new ExportCalendarParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewExportCalendarParamsWithHTTPClient(client *http.Client) *ExportCalendarParams {
+	return &ExportCalendarParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ExportCalendarParams contains all the parameters to send to the API endpoint
+
+	for the export calendar operation.
+
+	Typically these are written to a http.Request.
+*/
+type ExportCalendarParams struct {
+
+	// Login.
+	Login string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the export calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportCalendarParams) WithDefaults() *ExportCalendarParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the export calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportCalendarParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the export calendar params
+func (o *ExportCalendarParams) WithTimeout(timeout time.Duration) *ExportCalendarParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the export calendar params
+func (o *ExportCalendarParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the export calendar params
+func (o *ExportCalendarParams) WithContext(ctx context.Context) *ExportCalendarParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the export calendar params
+func (o *ExportCalendarParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the export calendar params
+func (o *ExportCalendarParams) WithHTTPClient(client *http.Client) *ExportCalendarParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the export calendar params
+func (o *ExportCalendarParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLogin adds the login to the export calendar params
+func (o *ExportCalendarParams) WithLogin(login string) *ExportCalendarParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the export calendar params
+func (o *ExportCalendarParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ExportCalendarParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// ExportCalendarReader is a Reader for the ExportCalendar structure.
+type ExportCalendarReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportCalendarReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportCalendarOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewExportCalendarForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewExportCalendarNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewExportCalendarDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewExportCalendarOK creates a ExportCalendarOK with default headers values
+func NewExportCalendarOK() *ExportCalendarOK {
+	return &ExportCalendarOK{}
+}
+
+/*
+ExportCalendarOK describes a response with status code 200, with default header values.
+
+iCalendar document
+*/
+type ExportCalendarOK struct {
+	Payload string
+}
+
+// IsSuccess returns true when this export calendar o k response has a 2xx status code
+func (o *ExportCalendarOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this export calendar o k response has a 3xx status code
+func (o *ExportCalendarOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export calendar o k response has a 4xx status code
+func (o *ExportCalendarOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export calendar o k response has a 5xx status code
+func (o *ExportCalendarOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export calendar o k response a status code equal to that given
+func (o *ExportCalendarOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the export calendar o k response
+func (o *ExportCalendarOK) Code() int {
+	return 200
+}
+
+func (o *ExportCalendarOK) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportCalendarOK) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *ExportCalendarOK) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ExportCalendarOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportCalendarForbidden creates a ExportCalendarForbidden with default headers values
+func NewExportCalendarForbidden() *ExportCalendarForbidden {
+	return &ExportCalendarForbidden{}
+}
+
+/*
+ExportCalendarForbidden describes a response with status code 403, with default header values.
+
+the user doesn't share their calendar with the caller
+*/
+type ExportCalendarForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this export calendar forbidden response has a 2xx status code
+func (o *ExportCalendarForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export calendar forbidden response has a 3xx status code
+func (o *ExportCalendarForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export calendar forbidden response has a 4xx status code
+func (o *ExportCalendarForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export calendar forbidden response has a 5xx status code
+func (o *ExportCalendarForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export calendar forbidden response a status code equal to that given
+func (o *ExportCalendarForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the export calendar forbidden response
+func (o *ExportCalendarForbidden) Code() int {
+	return 403
+}
+
+func (o *ExportCalendarForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendarForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ExportCalendarForbidden) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendarForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ExportCalendarForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ExportCalendarForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportCalendarNotFound creates a ExportCalendarNotFound with default headers values
+func NewExportCalendarNotFound() *ExportCalendarNotFound {
+	return &ExportCalendarNotFound{}
+}
+
+/*
+ExportCalendarNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type ExportCalendarNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this export calendar not found response has a 2xx status code
+func (o *ExportCalendarNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export calendar not found response has a 3xx status code
+func (o *ExportCalendarNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export calendar not found response has a 4xx status code
+func (o *ExportCalendarNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export calendar not found response has a 5xx status code
+func (o *ExportCalendarNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export calendar not found response a status code equal to that given
+func (o *ExportCalendarNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the export calendar not found response
+func (o *ExportCalendarNotFound) Code() int {
+	return 404
+}
+
+func (o *ExportCalendarNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportCalendarNotFound) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ExportCalendarNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ExportCalendarNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewExportCalendarDefault creates a ExportCalendarDefault with default headers values
+func NewExportCalendarDefault(code int) *ExportCalendarDefault {
+	return &ExportCalendarDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ExportCalendarDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ExportCalendarDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this export calendar default response has a 2xx status code
+func (o *ExportCalendarDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this export calendar default response has a 3xx status code
+func (o *ExportCalendarDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this export calendar default response has a 4xx status code
+func (o *ExportCalendarDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this export calendar default response has a 5xx status code
+func (o *ExportCalendarDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this export calendar default response a status code equal to that given
+func (o *ExportCalendarDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the export calendar default response
+func (o *ExportCalendarDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ExportCalendarDefault) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ExportCalendarDefault) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendar.ics][%d] exportCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ExportCalendarDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ExportCalendarDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewFindSlotParams creates a new FindSlotParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewFindSlotParams() *FindSlotParams {
+	return &FindSlotParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewFindSlotParamsWithTimeout creates a new FindSlotParams object
+// with the ability to set a timeout on a request.
+func NewFindSlotParamsWithTimeout(timeout time.Duration) *FindSlotParams {
+	return &FindSlotParams{
+		timeout: timeout,
+	}
+}
+
+// NewFindSlotParamsWithContext creates a new FindSlotParams object
+// with the ability to set a context for a request.
+func NewFindSlotParamsWithContext(ctx context.Context) *FindSlotParams {
+	return &FindSlotParams{
+		Context: ctx,
+	}
+}
+
+// NewFindSlotParamsWithHTTPClient creates a new FindSlotParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewFindSlotParamsWithHTTPClient(client *http.Client) *FindSlotParams {
+	return &FindSlotParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+FindSlotParams contains all the parameters to send to the API endpoint
+
+	for the find slot operation.
+
+	Typically these are written to a http.Request.
+*/
+type FindSlotParams struct {
+
+	/* Calendars.
+
+	   comma separated ids of calendars, meetings in other calendars of the users don't block slots
+	*/
+	Calendars *string
+
+	// DurationMinutes.
+	DurationMinutes int64
+
+	/* ExcludeCalendars.
+
+	   comma separated ids of calendars whose meetings don't block slots
+	*/
+	ExcludeCalendars *string
+
+	/* Logins.
+
+	   comma separated logins, team:NAME for the members of a group
+	*/
+	Logins string
+
+	// StartTime.
+	//
+	// Format: date-time
+	StartTime strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the find slot params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *FindSlotParams) WithDefaults() *FindSlotParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the find slot params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *FindSlotParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the find slot params
+func (o *FindSlotParams) WithTimeout(timeout time.Duration) *FindSlotParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the find slot params
+func (o *FindSlotParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the find slot params
+func (o *FindSlotParams) WithContext(ctx context.Context) *FindSlotParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the find slot params
+func (o *FindSlotParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the find slot params
+func (o *FindSlotParams) WithHTTPClient(client *http.Client) *FindSlotParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the find slot params
+func (o *FindSlotParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCalendars adds the calendars to the find slot params
+func (o *FindSlotParams) WithCalendars(calendars *string) *FindSlotParams {
+	o.SetCalendars(calendars)
+	return o
+}
+
+// SetCalendars adds the calendars to the find slot params
+func (o *FindSlotParams) SetCalendars(calendars *string) {
+	o.Calendars = calendars
+}
+
+// WithDurationMinutes adds the durationMinutes to the find slot params
+func (o *FindSlotParams) WithDurationMinutes(durationMinutes int64) *FindSlotParams {
+	o.SetDurationMinutes(durationMinutes)
+	return o
+}
+
+// SetDurationMinutes adds the durationMinutes to the find slot params
+func (o *FindSlotParams) SetDurationMinutes(durationMinutes int64) {
+	o.DurationMinutes = durationMinutes
+}
+
+// WithExcludeCalendars adds the excludeCalendars to the find slot params
+func (o *FindSlotParams) WithExcludeCalendars(excludeCalendars *string) *FindSlotParams {
+	o.SetExcludeCalendars(excludeCalendars)
+	return o
+}
+
+// SetExcludeCalendars adds the excludeCalendars to the find slot params
+func (o *FindSlotParams) SetExcludeCalendars(excludeCalendars *string) {
+	o.ExcludeCalendars = excludeCalendars
+}
+
+// WithLogins adds the logins to the find slot params
+func (o *FindSlotParams) WithLogins(logins string) *FindSlotParams {
+	o.SetLogins(logins)
+	return o
+}
+
+// SetLogins adds the logins to the find slot params
+func (o *FindSlotParams) SetLogins(logins string) {
+	o.Logins = logins
+}
+
+// WithStartTime adds the startTime to the find slot params
+func (o *FindSlotParams) WithStartTime(startTime strfmt.DateTime) *FindSlotParams {
+	o.SetStartTime(startTime)
+	return o
+}
+
+// SetStartTime adds the startTime to the find slot params
+func (o *FindSlotParams) SetStartTime(startTime strfmt.DateTime) {
+	o.StartTime = startTime
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *FindSlotParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Calendars != nil {
+
+		// query param calendars
+		var qrCalendars string
+
+		if o.Calendars != nil {
+			qrCalendars = *o.Calendars
+		}
+		qCalendars := qrCalendars
+		if qCalendars != "" {
+
+			if err := r.SetQueryParam("calendars", qCalendars); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param durationMinutes
+	qrDurationMinutes := o.DurationMinutes
+	qDurationMinutes := swag.FormatInt64(qrDurationMinutes)
+	if qDurationMinutes != "" {
+
+		if err := r.SetQueryParam("durationMinutes", qDurationMinutes); err != nil {
+			return err
+		}
+	}
+
+	if o.ExcludeCalendars != nil {
+
+		// query param excludeCalendars
+		var qrExcludeCalendars string
+
+		if o.ExcludeCalendars != nil {
+			qrExcludeCalendars = *o.ExcludeCalendars
+		}
+		qExcludeCalendars := qrExcludeCalendars
+		if qExcludeCalendars != "" {
+
+			if err := r.SetQueryParam("excludeCalendars", qExcludeCalendars); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param logins
+	qrLogins := o.Logins
+	qLogins := qrLogins
+	if qLogins != "" {
+
+		if err := r.SetQueryParam("logins", qLogins); err != nil {
+			return err
+		}
+	}
+
+	// query param startTime
+	qrStartTime := o.StartTime
+	qStartTime := qrStartTime.String()
+	if qStartTime != "" {
+
+		if err := r.SetQueryParam("startTime", qStartTime); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
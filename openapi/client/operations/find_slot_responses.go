@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// FindSlotReader is a Reader for the FindSlot structure.
+type FindSlotReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *FindSlotReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewFindSlotOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewFindSlotForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewFindSlotDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewFindSlotOK creates a FindSlotOK with default headers values
+func NewFindSlotOK() *FindSlotOK {
+	return &FindSlotOK{}
+}
+
+/*
+FindSlotOK describes a response with status code 200, with default header values.
+
+first free slot
+*/
+type FindSlotOK struct {
+	Payload *models.Slot
+}
+
+// IsSuccess returns true when this find slot o k response has a 2xx status code
+func (o *FindSlotOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this find slot o k response has a 3xx status code
+func (o *FindSlotOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this find slot o k response has a 4xx status code
+func (o *FindSlotOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this find slot o k response has a 5xx status code
+func (o *FindSlotOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this find slot o k response a status code equal to that given
+func (o *FindSlotOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the find slot o k response
+func (o *FindSlotOK) Code() int {
+	return 200
+}
+
+func (o *FindSlotOK) Error() string {
+	return fmt.Sprintf("[GET /api/findSlot][%d] findSlotOK  %+v", 200, o.Payload)
+}
+
+func (o *FindSlotOK) String() string {
+	return fmt.Sprintf("[GET /api/findSlot][%d] findSlotOK  %+v", 200, o.Payload)
+}
+
+func (o *FindSlotOK) GetPayload() *models.Slot {
+	return o.Payload
+}
+
+func (o *FindSlotOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Slot)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewFindSlotForbidden creates a FindSlotForbidden with default headers values
+func NewFindSlotForbidden() *FindSlotForbidden {
+	return &FindSlotForbidden{}
+}
+
+/*
+FindSlotForbidden describes a response with status code 403, with default header values.
+
+a user doesn't share free/busy with the caller
+*/
+type FindSlotForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this find slot forbidden response has a 2xx status code
+func (o *FindSlotForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this find slot forbidden response has a 3xx status code
+func (o *FindSlotForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this find slot forbidden response has a 4xx status code
+func (o *FindSlotForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this find slot forbidden response has a 5xx status code
+func (o *FindSlotForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this find slot forbidden response a status code equal to that given
+func (o *FindSlotForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the find slot forbidden response
+func (o *FindSlotForbidden) Code() int {
+	return 403
+}
+
+func (o *FindSlotForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/findSlot][%d] findSlotForbidden  %+v", 403, o.Payload)
+}
+
+func (o *FindSlotForbidden) String() string {
+	return fmt.Sprintf("[GET /api/findSlot][%d] findSlotForbidden  %+v", 403, o.Payload)
+}
+
+func (o *FindSlotForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *FindSlotForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewFindSlotDefault creates a FindSlotDefault with default headers values
+func NewFindSlotDefault(code int) *FindSlotDefault {
+	return &FindSlotDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+FindSlotDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type FindSlotDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this find slot default response has a 2xx status code
+func (o *FindSlotDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this find slot default response has a 3xx status code
+func (o *FindSlotDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this find slot default response has a 4xx status code
+func (o *FindSlotDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this find slot default response has a 5xx status code
+func (o *FindSlotDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this find slot default response a status code equal to that given
+func (o *FindSlotDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the find slot default response
+func (o *FindSlotDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *FindSlotDefault) Error() string {
+	return fmt.Sprintf("[GET /api/findSlot][%d] findSlot default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *FindSlotDefault) String() string {
+	return fmt.Sprintf("[GET /api/findSlot][%d] findSlot default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *FindSlotDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *FindSlotDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// GetGroupReader is a Reader for the GetGroup structure.
+type GetGroupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetGroupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetGroupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetGroupNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetGroupDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetGroupOK creates a GetGroupOK with default headers values
+func NewGetGroupOK() *GetGroupOK {
+	return &GetGroupOK{}
+}
+
+/*
+GetGroupOK describes a response with status code 200, with default header values.
+
+group
+*/
+type GetGroupOK struct {
+	Payload *models.Group
+}
+
+// IsSuccess returns true when this get group o k response has a 2xx status code
+func (o *GetGroupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get group o k response has a 3xx status code
+func (o *GetGroupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get group o k response has a 4xx status code
+func (o *GetGroupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get group o k response has a 5xx status code
+func (o *GetGroupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get group o k response a status code equal to that given
+func (o *GetGroupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get group o k response
+func (o *GetGroupOK) Code() int {
+	return 200
+}
+
+func (o *GetGroupOK) Error() string {
+	return fmt.Sprintf("[GET /api/groups/{name}][%d] getGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *GetGroupOK) String() string {
+	return fmt.Sprintf("[GET /api/groups/{name}][%d] getGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *GetGroupOK) GetPayload() *models.Group {
+	return o.Payload
+}
+
+func (o *GetGroupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Group)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetGroupNotFound creates a GetGroupNotFound with default headers values
+func NewGetGroupNotFound() *GetGroupNotFound {
+	return &GetGroupNotFound{}
+}
+
+/*
+GetGroupNotFound describes a response with status code 404, with default header values.
+
+no such group
+*/
+type GetGroupNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get group not found response has a 2xx status code
+func (o *GetGroupNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get group not found response has a 3xx status code
+func (o *GetGroupNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get group not found response has a 4xx status code
+func (o *GetGroupNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get group not found response has a 5xx status code
+func (o *GetGroupNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get group not found response a status code equal to that given
+func (o *GetGroupNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get group not found response
+func (o *GetGroupNotFound) Code() int {
+	return 404
+}
+
+func (o *GetGroupNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/groups/{name}][%d] getGroupNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetGroupNotFound) String() string {
+	return fmt.Sprintf("[GET /api/groups/{name}][%d] getGroupNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetGroupNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetGroupNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetGroupDefault creates a GetGroupDefault with default headers values
+func NewGetGroupDefault(code int) *GetGroupDefault {
+	return &GetGroupDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetGroupDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetGroupDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get group default response has a 2xx status code
+func (o *GetGroupDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get group default response has a 3xx status code
+func (o *GetGroupDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get group default response has a 4xx status code
+func (o *GetGroupDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get group default response has a 5xx status code
+func (o *GetGroupDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get group default response a status code equal to that given
+func (o *GetGroupDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get group default response
+func (o *GetGroupDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetGroupDefault) Error() string {
+	return fmt.Sprintf("[GET /api/groups/{name}][%d] getGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetGroupDefault) String() string {
+	return fmt.Sprintf("[GET /api/groups/{name}][%d] getGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetGroupDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetGroupDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetMeetingParams creates a new GetMeetingParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetMeetingParams() *GetMeetingParams {
+	return &GetMeetingParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetMeetingParamsWithTimeout creates a new GetMeetingParams object
+// with the ability to set a timeout on a request.
+func NewGetMeetingParamsWithTimeout(timeout time.Duration) *GetMeetingParams {
+	return &GetMeetingParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetMeetingParamsWithContext creates a new GetMeetingParams object
+// with the ability to set a context for a request.
+func NewGetMeetingParamsWithContext(ctx context.Context) *GetMeetingParams {
+	return &GetMeetingParams{
+		Context: ctx,
+	}
+}
+
+// NewGetMeetingParamsWithHTTPClient creates a new GetMeetingParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetMeetingParamsWithHTTPClient(client *http.Client) *GetMeetingParams {
+	return &GetMeetingParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetMeetingParams contains all the parameters to send to the API endpoint
+
+	for the get meeting operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetMeetingParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMeetingParams) WithDefaults() *GetMeetingParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMeetingParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get meeting params
+func (o *GetMeetingParams) WithTimeout(timeout time.Duration) *GetMeetingParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get meeting params
+func (o *GetMeetingParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get meeting params
+func (o *GetMeetingParams) WithContext(ctx context.Context) *GetMeetingParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get meeting params
+func (o *GetMeetingParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get meeting params
+func (o *GetMeetingParams) WithHTTPClient(client *http.Client) *GetMeetingParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get meeting params
+func (o *GetMeetingParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get meeting params
+func (o *GetMeetingParams) WithID(id string) *GetMeetingParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get meeting params
+func (o *GetMeetingParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetMeetingParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// GetMeetingReader is a Reader for the GetMeeting structure.
+type GetMeetingReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetMeetingReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetMeetingOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetMeetingNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetMeetingDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetMeetingOK creates a GetMeetingOK with default headers values
+func NewGetMeetingOK() *GetMeetingOK {
+	return &GetMeetingOK{}
+}
+
+/*
+GetMeetingOK describes a response with status code 200, with default header values.
+
+meeting, only its time when the caller doesn't take part in it
+*/
+type GetMeetingOK struct {
+	Payload *models.Meeting
+}
+
+// IsSuccess returns true when this get meeting o k response has a 2xx status code
+func (o *GetMeetingOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get meeting o k response has a 3xx status code
+func (o *GetMeetingOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get meeting o k response has a 4xx status code
+func (o *GetMeetingOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get meeting o k response has a 5xx status code
+func (o *GetMeetingOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get meeting o k response a status code equal to that given
+func (o *GetMeetingOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get meeting o k response
+func (o *GetMeetingOK) Code() int {
+	return 200
+}
+
+func (o *GetMeetingOK) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}][%d] getMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *GetMeetingOK) String() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}][%d] getMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *GetMeetingOK) GetPayload() *models.Meeting {
+	return o.Payload
+}
+
+func (o *GetMeetingOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Meeting)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMeetingNotFound creates a GetMeetingNotFound with default headers values
+func NewGetMeetingNotFound() *GetMeetingNotFound {
+	return &GetMeetingNotFound{}
+}
+
+/*
+GetMeetingNotFound describes a response with status code 404, with default header values.
+
+no such meeting
+*/
+type GetMeetingNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get meeting not found response has a 2xx status code
+func (o *GetMeetingNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get meeting not found response has a 3xx status code
+func (o *GetMeetingNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get meeting not found response has a 4xx status code
+func (o *GetMeetingNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get meeting not found response has a 5xx status code
+func (o *GetMeetingNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get meeting not found response a status code equal to that given
+func (o *GetMeetingNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get meeting not found response
+func (o *GetMeetingNotFound) Code() int {
+	return 404
+}
+
+func (o *GetMeetingNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}][%d] getMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetMeetingNotFound) String() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}][%d] getMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetMeetingNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMeetingNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMeetingDefault creates a GetMeetingDefault with default headers values
+func NewGetMeetingDefault(code int) *GetMeetingDefault {
+	return &GetMeetingDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetMeetingDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetMeetingDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get meeting default response has a 2xx status code
+func (o *GetMeetingDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get meeting default response has a 3xx status code
+func (o *GetMeetingDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get meeting default response has a 4xx status code
+func (o *GetMeetingDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get meeting default response has a 5xx status code
+func (o *GetMeetingDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get meeting default response a status code equal to that given
+func (o *GetMeetingDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get meeting default response
+func (o *GetMeetingDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetMeetingDefault) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}][%d] getMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetMeetingDefault) String() string {
+	return fmt.Sprintf("[GET /api/meetings/{id}][%d] getMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetMeetingDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMeetingDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetSpecParams creates a new GetSpecParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetSpecParams() *GetSpecParams {
+	return &GetSpecParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSpecParamsWithTimeout creates a new GetSpecParams object
+// with the ability to set a timeout on a request.
+func NewGetSpecParamsWithTimeout(timeout time.Duration) *GetSpecParams {
+	return &GetSpecParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetSpecParamsWithContext creates a new GetSpecParams object
+// with the ability to set a context for a request.
+func NewGetSpecParamsWithContext(ctx context.Context) *GetSpecParams {
+	return &GetSpecParams{
+		Context: ctx,
+	}
+}
+
+// NewGetSpecParamsWithHTTPClient creates a new GetSpecParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetSpecParamsWithHTTPClient(client *http.Client) *GetSpecParams {
+	return &GetSpecParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetSpecParams contains all the parameters to send to the API endpoint
+
+	for the get spec operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetSpecParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get spec params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSpecParams) WithDefaults() *GetSpecParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get spec params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSpecParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get spec params
+func (o *GetSpecParams) WithTimeout(timeout time.Duration) *GetSpecParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get spec params
+func (o *GetSpecParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get spec params
+func (o *GetSpecParams) WithContext(ctx context.Context) *GetSpecParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get spec params
+func (o *GetSpecParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get spec params
+func (o *GetSpecParams) WithHTTPClient(client *http.Client) *GetSpecParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get spec params
+func (o *GetSpecParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSpecParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
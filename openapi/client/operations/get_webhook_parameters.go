@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetWebhookParams creates a new GetWebhookParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetWebhookParams() *GetWebhookParams {
+	return &GetWebhookParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetWebhookParamsWithTimeout creates a new GetWebhookParams object
+// with the ability to set a timeout on a request.
+func NewGetWebhookParamsWithTimeout(timeout time.Duration) *GetWebhookParams {
+	return &GetWebhookParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetWebhookParamsWithContext creates a new GetWebhookParams object
+// with the ability to set a context for a request.
+func NewGetWebhookParamsWithContext(ctx context.Context) *GetWebhookParams {
+	return &GetWebhookParams{
+		Context: ctx,
+	}
+}
+
+// NewGetWebhookParamsWithHTTPClient creates a new GetWebhookParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetWebhookParamsWithHTTPClient(client *http.Client) *GetWebhookParams {
+	return &GetWebhookParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetWebhookParams contains all the parameters to send to the API endpoint
+
+	for the get webhook operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetWebhookParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get webhook params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetWebhookParams) WithDefaults() *GetWebhookParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get webhook params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetWebhookParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get webhook params
+func (o *GetWebhookParams) WithTimeout(timeout time.Duration) *GetWebhookParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get webhook params
+func (o *GetWebhookParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get webhook params
+func (o *GetWebhookParams) WithContext(ctx context.Context) *GetWebhookParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get webhook params
+func (o *GetWebhookParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get webhook params
+func (o *GetWebhookParams) WithHTTPClient(client *http.Client) *GetWebhookParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get webhook params
+func (o *GetWebhookParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get webhook params
+func (o *GetWebhookParams) WithID(id string) *GetWebhookParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get webhook params
+func (o *GetWebhookParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetWebhookParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
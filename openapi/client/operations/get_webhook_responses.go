@@ -0,0 +1,256 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// GetWebhookReader is a Reader for the GetWebhook structure.
+type GetWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetWebhookOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetWebhookNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetWebhookDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetWebhookOK creates a GetWebhookOK with default headers values
+func NewGetWebhookOK() *GetWebhookOK {
+	return &GetWebhookOK{}
+}
+
+/*
+GetWebhookOK describes a response with status code 200, with default header values.
+
+webhook
+*/
+type GetWebhookOK struct {
+	Payload *models.Webhook
+}
+
+// IsSuccess returns true when this get webhook o k response has a 2xx status code
+func (o *GetWebhookOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get webhook o k response has a 3xx status code
+func (o *GetWebhookOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get webhook o k response has a 4xx status code
+func (o *GetWebhookOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get webhook o k response has a 5xx status code
+func (o *GetWebhookOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get webhook o k response a status code equal to that given
+func (o *GetWebhookOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get webhook o k response
+func (o *GetWebhookOK) Code() int {
+	return 200
+}
+
+func (o *GetWebhookOK) Error() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}][%d] getWebhookOK  %+v", 200, o.Payload)
+}
+
+func (o *GetWebhookOK) String() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}][%d] getWebhookOK  %+v", 200, o.Payload)
+}
+
+func (o *GetWebhookOK) GetPayload() *models.Webhook {
+	return o.Payload
+}
+
+func (o *GetWebhookOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Webhook)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetWebhookNotFound creates a GetWebhookNotFound with default headers values
+func NewGetWebhookNotFound() *GetWebhookNotFound {
+	return &GetWebhookNotFound{}
+}
+
+/*
+GetWebhookNotFound describes a response with status code 404, with default header values.
+
+no such webhook
+*/
+type GetWebhookNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get webhook not found response has a 2xx status code
+func (o *GetWebhookNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get webhook not found response has a 3xx status code
+func (o *GetWebhookNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get webhook not found response has a 4xx status code
+func (o *GetWebhookNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get webhook not found response has a 5xx status code
+func (o *GetWebhookNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get webhook not found response a status code equal to that given
+func (o *GetWebhookNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get webhook not found response
+func (o *GetWebhookNotFound) Code() int {
+	return 404
+}
+
+func (o *GetWebhookNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}][%d] getWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetWebhookNotFound) String() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}][%d] getWebhookNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetWebhookNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetWebhookNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetWebhookDefault creates a GetWebhookDefault with default headers values
+func NewGetWebhookDefault(code int) *GetWebhookDefault {
+	return &GetWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetWebhookDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetWebhookDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get webhook default response has a 2xx status code
+func (o *GetWebhookDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get webhook default response has a 3xx status code
+func (o *GetWebhookDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get webhook default response has a 4xx status code
+func (o *GetWebhookDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get webhook default response has a 5xx status code
+func (o *GetWebhookDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get webhook default response a status code equal to that given
+func (o *GetWebhookDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get webhook default response
+func (o *GetWebhookDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetWebhookDefault) Error() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}][%d] getWebhook default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetWebhookDefault) String() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}][%d] getWebhook default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetWebhookDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetWebhookDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
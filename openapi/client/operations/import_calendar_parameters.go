@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewImportCalendarParams creates a new ImportCalendarParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewImportCalendarParams() *ImportCalendarParams {
+	return &ImportCalendarParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImportCalendarParamsWithTimeout creates a new ImportCalendarParams object
+// with the ability to set a timeout on a request.
+func NewImportCalendarParamsWithTimeout(timeout time.Duration) *ImportCalendarParams {
+	return &ImportCalendarParams{
+		timeout: timeout,
+	}
+}
+
+// NewImportCalendarParamsWithContext creates a new ImportCalendarParams object
+// with the ability to set a context for a request.
+func NewImportCalendarParamsWithContext(ctx context.Context) *ImportCalendarParams {
+	return &ImportCalendarParams{
+		Context: ctx,
+	}
+}
+
+// NewImportCalendarParamsWithHTTPClient creates a new ImportCalendarParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewImportCalendarParamsWithHTTPClient(client *http.Client) *ImportCalendarParams {
+	return &ImportCalendarParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ImportCalendarParams contains all the parameters to send to the API endpoint
+
+	for the import calendar operation.
+
+	Typically these are written to a http.Request.
+*/
+type ImportCalendarParams struct {
+
+	// Calendar.
+	Calendar string
+
+	// Login.
+	Login string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the import calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportCalendarParams) WithDefaults() *ImportCalendarParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the import calendar params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportCalendarParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the import calendar params
+func (o *ImportCalendarParams) WithTimeout(timeout time.Duration) *ImportCalendarParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the import calendar params
+func (o *ImportCalendarParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the import calendar params
+func (o *ImportCalendarParams) WithContext(ctx context.Context) *ImportCalendarParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the import calendar params
+func (o *ImportCalendarParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the import calendar params
+func (o *ImportCalendarParams) WithHTTPClient(client *http.Client) *ImportCalendarParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the import calendar params
+func (o *ImportCalendarParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCalendar adds the calendar to the import calendar params
+func (o *ImportCalendarParams) WithCalendar(calendar string) *ImportCalendarParams {
+	o.SetCalendar(calendar)
+	return o
+}
+
+// SetCalendar adds the calendar to the import calendar params
+func (o *ImportCalendarParams) SetCalendar(calendar string) {
+	o.Calendar = calendar
+}
+
+// WithLogin adds the login to the import calendar params
+func (o *ImportCalendarParams) WithLogin(login string) *ImportCalendarParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the import calendar params
+func (o *ImportCalendarParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImportCalendarParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Calendar); err != nil {
+		return err
+	}
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
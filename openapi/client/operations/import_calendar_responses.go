@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// ImportCalendarReader is a Reader for the ImportCalendar structure.
+type ImportCalendarReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ImportCalendarReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewImportCalendarOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewImportCalendarBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewImportCalendarNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewImportCalendarDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewImportCalendarOK creates a ImportCalendarOK with default headers values
+func NewImportCalendarOK() *ImportCalendarOK {
+	return &ImportCalendarOK{}
+}
+
+/*
+ImportCalendarOK describes a response with status code 200, with default header values.
+
+per event report
+*/
+type ImportCalendarOK struct {
+	Payload *models.ImportReport
+}
+
+// IsSuccess returns true when this import calendar o k response has a 2xx status code
+func (o *ImportCalendarOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this import calendar o k response has a 3xx status code
+func (o *ImportCalendarOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this import calendar o k response has a 4xx status code
+func (o *ImportCalendarOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this import calendar o k response has a 5xx status code
+func (o *ImportCalendarOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this import calendar o k response a status code equal to that given
+func (o *ImportCalendarOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the import calendar o k response
+func (o *ImportCalendarOK) Code() int {
+	return 200
+}
+
+func (o *ImportCalendarOK) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *ImportCalendarOK) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *ImportCalendarOK) GetPayload() *models.ImportReport {
+	return o.Payload
+}
+
+func (o *ImportCalendarOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ImportReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportCalendarBadRequest creates a ImportCalendarBadRequest with default headers values
+func NewImportCalendarBadRequest() *ImportCalendarBadRequest {
+	return &ImportCalendarBadRequest{}
+}
+
+/*
+ImportCalendarBadRequest describes a response with status code 400, with default header values.
+
+malformed iCalendar
+*/
+type ImportCalendarBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this import calendar bad request response has a 2xx status code
+func (o *ImportCalendarBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this import calendar bad request response has a 3xx status code
+func (o *ImportCalendarBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this import calendar bad request response has a 4xx status code
+func (o *ImportCalendarBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this import calendar bad request response has a 5xx status code
+func (o *ImportCalendarBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this import calendar bad request response a status code equal to that given
+func (o *ImportCalendarBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the import calendar bad request response
+func (o *ImportCalendarBadRequest) Code() int {
+	return 400
+}
+
+func (o *ImportCalendarBadRequest) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendarBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ImportCalendarBadRequest) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendarBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ImportCalendarBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ImportCalendarBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportCalendarNotFound creates a ImportCalendarNotFound with default headers values
+func NewImportCalendarNotFound() *ImportCalendarNotFound {
+	return &ImportCalendarNotFound{}
+}
+
+/*
+ImportCalendarNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type ImportCalendarNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this import calendar not found response has a 2xx status code
+func (o *ImportCalendarNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this import calendar not found response has a 3xx status code
+func (o *ImportCalendarNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this import calendar not found response has a 4xx status code
+func (o *ImportCalendarNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this import calendar not found response has a 5xx status code
+func (o *ImportCalendarNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this import calendar not found response a status code equal to that given
+func (o *ImportCalendarNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the import calendar not found response
+func (o *ImportCalendarNotFound) Code() int {
+	return 404
+}
+
+func (o *ImportCalendarNotFound) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ImportCalendarNotFound) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ImportCalendarNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ImportCalendarNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportCalendarDefault creates a ImportCalendarDefault with default headers values
+func NewImportCalendarDefault(code int) *ImportCalendarDefault {
+	return &ImportCalendarDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ImportCalendarDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ImportCalendarDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this import calendar default response has a 2xx status code
+func (o *ImportCalendarDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this import calendar default response has a 3xx status code
+func (o *ImportCalendarDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this import calendar default response has a 4xx status code
+func (o *ImportCalendarDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this import calendar default response has a 5xx status code
+func (o *ImportCalendarDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this import calendar default response a status code equal to that given
+func (o *ImportCalendarDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the import calendar default response
+func (o *ImportCalendarDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ImportCalendarDefault) Error() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ImportCalendarDefault) String() string {
+	return fmt.Sprintf("[POST /api/users/{login}/import][%d] importCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ImportCalendarDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ImportCalendarDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
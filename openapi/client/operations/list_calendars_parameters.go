@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListCalendarsParams creates a new ListCalendarsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListCalendarsParams() *ListCalendarsParams {
+	return &ListCalendarsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListCalendarsParamsWithTimeout creates a new ListCalendarsParams object
+// with the ability to set a timeout on a request.
+func NewListCalendarsParamsWithTimeout(timeout time.Duration) *ListCalendarsParams {
+	return &ListCalendarsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListCalendarsParamsWithContext creates a new ListCalendarsParams object
+// with the ability to set a context for a request.
+func NewListCalendarsParamsWithContext(ctx context.Context) *ListCalendarsParams {
+	return &ListCalendarsParams{
+		Context: ctx,
+	}
+}
+
+// NewListCalendarsParamsWithHTTPClient creates a new ListCalendarsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListCalendarsParamsWithHTTPClient(client *http.Client) *ListCalendarsParams {
+	return &ListCalendarsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListCalendarsParams contains all the parameters to send to the API endpoint
+
+	for the list calendars operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListCalendarsParams struct {
+
+	// Login.
+	Login string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list calendars params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListCalendarsParams) WithDefaults() *ListCalendarsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list calendars params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListCalendarsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list calendars params
+func (o *ListCalendarsParams) WithTimeout(timeout time.Duration) *ListCalendarsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list calendars params
+func (o *ListCalendarsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list calendars params
+func (o *ListCalendarsParams) WithContext(ctx context.Context) *ListCalendarsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list calendars params
+func (o *ListCalendarsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list calendars params
+func (o *ListCalendarsParams) WithHTTPClient(client *http.Client) *ListCalendarsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list calendars params
+func (o *ListCalendarsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLogin adds the login to the list calendars params
+func (o *ListCalendarsParams) WithLogin(login string) *ListCalendarsParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the list calendars params
+func (o *ListCalendarsParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListCalendarsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// ListCalendarsReader is a Reader for the ListCalendars structure.
+type ListCalendarsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListCalendarsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListCalendarsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewListCalendarsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListCalendarsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListCalendarsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListCalendarsOK creates a ListCalendarsOK with default headers values
+func NewListCalendarsOK() *ListCalendarsOK {
+	return &ListCalendarsOK{}
+}
+
+/*
+ListCalendarsOK describes a response with status code 200, with default header values.
+
+calendars of the user, the default calendar first
+*/
+type ListCalendarsOK struct {
+	Payload []*models.Calendar
+}
+
+// IsSuccess returns true when this list calendars o k response has a 2xx status code
+func (o *ListCalendarsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list calendars o k response has a 3xx status code
+func (o *ListCalendarsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list calendars o k response has a 4xx status code
+func (o *ListCalendarsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list calendars o k response has a 5xx status code
+func (o *ListCalendarsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list calendars o k response a status code equal to that given
+func (o *ListCalendarsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list calendars o k response
+func (o *ListCalendarsOK) Code() int {
+	return 200
+}
+
+func (o *ListCalendarsOK) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendarsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListCalendarsOK) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendarsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListCalendarsOK) GetPayload() []*models.Calendar {
+	return o.Payload
+}
+
+func (o *ListCalendarsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCalendarsForbidden creates a ListCalendarsForbidden with default headers values
+func NewListCalendarsForbidden() *ListCalendarsForbidden {
+	return &ListCalendarsForbidden{}
+}
+
+/*
+ListCalendarsForbidden describes a response with status code 403, with default header values.
+
+the user doesn't share their calendar with the caller
+*/
+type ListCalendarsForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list calendars forbidden response has a 2xx status code
+func (o *ListCalendarsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list calendars forbidden response has a 3xx status code
+func (o *ListCalendarsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list calendars forbidden response has a 4xx status code
+func (o *ListCalendarsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list calendars forbidden response has a 5xx status code
+func (o *ListCalendarsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list calendars forbidden response a status code equal to that given
+func (o *ListCalendarsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the list calendars forbidden response
+func (o *ListCalendarsForbidden) Code() int {
+	return 403
+}
+
+func (o *ListCalendarsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendarsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListCalendarsForbidden) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendarsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListCalendarsForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListCalendarsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCalendarsNotFound creates a ListCalendarsNotFound with default headers values
+func NewListCalendarsNotFound() *ListCalendarsNotFound {
+	return &ListCalendarsNotFound{}
+}
+
+/*
+ListCalendarsNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type ListCalendarsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list calendars not found response has a 2xx status code
+func (o *ListCalendarsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list calendars not found response has a 3xx status code
+func (o *ListCalendarsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list calendars not found response has a 4xx status code
+func (o *ListCalendarsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list calendars not found response has a 5xx status code
+func (o *ListCalendarsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list calendars not found response a status code equal to that given
+func (o *ListCalendarsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the list calendars not found response
+func (o *ListCalendarsNotFound) Code() int {
+	return 404
+}
+
+func (o *ListCalendarsNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendarsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListCalendarsNotFound) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendarsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListCalendarsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListCalendarsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCalendarsDefault creates a ListCalendarsDefault with default headers values
+func NewListCalendarsDefault(code int) *ListCalendarsDefault {
+	return &ListCalendarsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListCalendarsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListCalendarsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list calendars default response has a 2xx status code
+func (o *ListCalendarsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list calendars default response has a 3xx status code
+func (o *ListCalendarsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list calendars default response has a 4xx status code
+func (o *ListCalendarsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list calendars default response has a 5xx status code
+func (o *ListCalendarsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list calendars default response a status code equal to that given
+func (o *ListCalendarsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the list calendars default response
+func (o *ListCalendarsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListCalendarsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendars default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListCalendarsDefault) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/calendars][%d] listCalendars default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListCalendarsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListCalendarsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
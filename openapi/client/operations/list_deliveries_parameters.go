@@ -0,0 +1,211 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListDeliveriesParams creates a new ListDeliveriesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListDeliveriesParams() *ListDeliveriesParams {
+	return &ListDeliveriesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListDeliveriesParamsWithTimeout creates a new ListDeliveriesParams object
+// with the ability to set a timeout on a request.
+func NewListDeliveriesParamsWithTimeout(timeout time.Duration) *ListDeliveriesParams {
+	return &ListDeliveriesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListDeliveriesParamsWithContext creates a new ListDeliveriesParams object
+// with the ability to set a context for a request.
+func NewListDeliveriesParamsWithContext(ctx context.Context) *ListDeliveriesParams {
+	return &ListDeliveriesParams{
+		Context: ctx,
+	}
+}
+
+// NewListDeliveriesParamsWithHTTPClient creates a new ListDeliveriesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListDeliveriesParamsWithHTTPClient(client *http.Client) *ListDeliveriesParams {
+	return &ListDeliveriesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListDeliveriesParams contains all the parameters to send to the API endpoint
+
+	for the list deliveries operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListDeliveriesParams struct {
+
+	// ID.
+	ID string
+
+	// Limit.
+	Limit *int64
+
+	// Status.
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListDeliveriesParams) WithDefaults() *ListDeliveriesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListDeliveriesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list deliveries params
+func (o *ListDeliveriesParams) WithTimeout(timeout time.Duration) *ListDeliveriesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list deliveries params
+func (o *ListDeliveriesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list deliveries params
+func (o *ListDeliveriesParams) WithContext(ctx context.Context) *ListDeliveriesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list deliveries params
+func (o *ListDeliveriesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list deliveries params
+func (o *ListDeliveriesParams) WithHTTPClient(client *http.Client) *ListDeliveriesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list deliveries params
+func (o *ListDeliveriesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the list deliveries params
+func (o *ListDeliveriesParams) WithID(id string) *ListDeliveriesParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the list deliveries params
+func (o *ListDeliveriesParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLimit adds the limit to the list deliveries params
+func (o *ListDeliveriesParams) WithLimit(limit *int64) *ListDeliveriesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list deliveries params
+func (o *ListDeliveriesParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithStatus adds the status to the list deliveries params
+func (o *ListDeliveriesParams) WithStatus(status *string) *ListDeliveriesParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list deliveries params
+func (o *ListDeliveriesParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListDeliveriesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// ListDeliveriesReader is a Reader for the ListDeliveries structure.
+type ListDeliveriesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListDeliveriesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListDeliveriesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewListDeliveriesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListDeliveriesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListDeliveriesOK creates a ListDeliveriesOK with default headers values
+func NewListDeliveriesOK() *ListDeliveriesOK {
+	return &ListDeliveriesOK{}
+}
+
+/*
+ListDeliveriesOK describes a response with status code 200, with default header values.
+
+most recent deliveries first
+*/
+type ListDeliveriesOK struct {
+	Payload []*models.Delivery
+}
+
+// IsSuccess returns true when this list deliveries o k response has a 2xx status code
+func (o *ListDeliveriesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list deliveries o k response has a 3xx status code
+func (o *ListDeliveriesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list deliveries o k response has a 4xx status code
+func (o *ListDeliveriesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list deliveries o k response has a 5xx status code
+func (o *ListDeliveriesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list deliveries o k response a status code equal to that given
+func (o *ListDeliveriesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list deliveries o k response
+func (o *ListDeliveriesOK) Code() int {
+	return 200
+}
+
+func (o *ListDeliveriesOK) Error() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}/deliveries][%d] listDeliveriesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListDeliveriesOK) String() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}/deliveries][%d] listDeliveriesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListDeliveriesOK) GetPayload() []*models.Delivery {
+	return o.Payload
+}
+
+func (o *ListDeliveriesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListDeliveriesNotFound creates a ListDeliveriesNotFound with default headers values
+func NewListDeliveriesNotFound() *ListDeliveriesNotFound {
+	return &ListDeliveriesNotFound{}
+}
+
+/*
+ListDeliveriesNotFound describes a response with status code 404, with default header values.
+
+no such webhook
+*/
+type ListDeliveriesNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list deliveries not found response has a 2xx status code
+func (o *ListDeliveriesNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list deliveries not found response has a 3xx status code
+func (o *ListDeliveriesNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list deliveries not found response has a 4xx status code
+func (o *ListDeliveriesNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list deliveries not found response has a 5xx status code
+func (o *ListDeliveriesNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list deliveries not found response a status code equal to that given
+func (o *ListDeliveriesNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the list deliveries not found response
+func (o *ListDeliveriesNotFound) Code() int {
+	return 404
+}
+
+func (o *ListDeliveriesNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}/deliveries][%d] listDeliveriesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListDeliveriesNotFound) String() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}/deliveries][%d] listDeliveriesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListDeliveriesNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListDeliveriesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListDeliveriesDefault creates a ListDeliveriesDefault with default headers values
+func NewListDeliveriesDefault(code int) *ListDeliveriesDefault {
+	return &ListDeliveriesDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListDeliveriesDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListDeliveriesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list deliveries default response has a 2xx status code
+func (o *ListDeliveriesDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list deliveries default response has a 3xx status code
+func (o *ListDeliveriesDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list deliveries default response has a 4xx status code
+func (o *ListDeliveriesDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list deliveries default response has a 5xx status code
+func (o *ListDeliveriesDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list deliveries default response a status code equal to that given
+func (o *ListDeliveriesDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the list deliveries default response
+func (o *ListDeliveriesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListDeliveriesDefault) Error() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}/deliveries][%d] listDeliveries default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListDeliveriesDefault) String() string {
+	return fmt.Sprintf("[GET /api/webhooks/{id}/deliveries][%d] listDeliveries default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListDeliveriesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListDeliveriesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,449 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListMeetingsParams creates a new ListMeetingsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListMeetingsParams() *ListMeetingsParams {
+	return &ListMeetingsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListMeetingsParamsWithTimeout creates a new ListMeetingsParams object
+// with the ability to set a timeout on a request.
+func NewListMeetingsParamsWithTimeout(timeout time.Duration) *ListMeetingsParams {
+	return &ListMeetingsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListMeetingsParamsWithContext creates a new ListMeetingsParams object
+// with the ability to set a context for a request.
+func NewListMeetingsParamsWithContext(ctx context.Context) *ListMeetingsParams {
+	return &ListMeetingsParams{
+		Context: ctx,
+	}
+}
+
+// NewListMeetingsParamsWithHTTPClient creates a new ListMeetingsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListMeetingsParamsWithHTTPClient(client *http.Client) *ListMeetingsParams {
+	return &ListMeetingsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListMeetingsParams contains all the parameters to send to the API endpoint
+
+	for the list meetings operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListMeetingsParams struct {
+
+	/* Calendars.
+
+	   comma separated ids of calendars, meetings in other calendars are left out
+	*/
+	Calendars *string
+
+	/* Cursor.
+
+	   of the next page, from the Link header of the previous one
+	*/
+	Cursor *string
+
+	// EndTime.
+	//
+	// Format: date-time
+	EndTime strfmt.DateTime
+
+	/* ExcludeCalendars.
+
+	   comma separated ids of calendars whose meetings are left out
+	*/
+	ExcludeCalendars *string
+
+	// Limit.
+	//
+	// Default: 100
+	Limit *int64
+
+	// Login.
+	Login string
+
+	/* Q.
+
+	   only meetings whose title, description or location contains the text, ignoring case
+	*/
+	Q *string
+
+	/* Role.
+
+	   only meetings the user owns, or is invited to
+	*/
+	Role *string
+
+	// StartTime.
+	//
+	// Format: date-time
+	StartTime strfmt.DateTime
+
+	/* Status.
+
+	   only meetings the user answered so, owners have accepted
+	*/
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListMeetingsParams) WithDefaults() *ListMeetingsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListMeetingsParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+	)
+
+	val := ListMeetingsParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the list meetings params
+func (o *ListMeetingsParams) WithTimeout(timeout time.Duration) *ListMeetingsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list meetings params
+func (o *ListMeetingsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list meetings params
+func (o *ListMeetingsParams) WithContext(ctx context.Context) *ListMeetingsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list meetings params
+func (o *ListMeetingsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list meetings params
+func (o *ListMeetingsParams) WithHTTPClient(client *http.Client) *ListMeetingsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list meetings params
+func (o *ListMeetingsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCalendars adds the calendars to the list meetings params
+func (o *ListMeetingsParams) WithCalendars(calendars *string) *ListMeetingsParams {
+	o.SetCalendars(calendars)
+	return o
+}
+
+// SetCalendars adds the calendars to the list meetings params
+func (o *ListMeetingsParams) SetCalendars(calendars *string) {
+	o.Calendars = calendars
+}
+
+// WithCursor adds the cursor to the list meetings params
+func (o *ListMeetingsParams) WithCursor(cursor *string) *ListMeetingsParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list meetings params
+func (o *ListMeetingsParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithEndTime adds the endTime to the list meetings params
+func (o *ListMeetingsParams) WithEndTime(endTime strfmt.DateTime) *ListMeetingsParams {
+	o.SetEndTime(endTime)
+	return o
+}
+
+// SetEndTime adds the endTime to the list meetings params
+func (o *ListMeetingsParams) SetEndTime(endTime strfmt.DateTime) {
+	o.EndTime = endTime
+}
+
+// WithExcludeCalendars adds the excludeCalendars to the list meetings params
+func (o *ListMeetingsParams) WithExcludeCalendars(excludeCalendars *string) *ListMeetingsParams {
+	o.SetExcludeCalendars(excludeCalendars)
+	return o
+}
+
+// SetExcludeCalendars adds the excludeCalendars to the list meetings params
+func (o *ListMeetingsParams) SetExcludeCalendars(excludeCalendars *string) {
+	o.ExcludeCalendars = excludeCalendars
+}
+
+// WithLimit adds the limit to the list meetings params
+func (o *ListMeetingsParams) WithLimit(limit *int64) *ListMeetingsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list meetings params
+func (o *ListMeetingsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithLogin adds the login to the list meetings params
+func (o *ListMeetingsParams) WithLogin(login string) *ListMeetingsParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the list meetings params
+func (o *ListMeetingsParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WithQ adds the q to the list meetings params
+func (o *ListMeetingsParams) WithQ(q *string) *ListMeetingsParams {
+	o.SetQ(q)
+	return o
+}
+
+// SetQ adds the q to the list meetings params
+func (o *ListMeetingsParams) SetQ(q *string) {
+	o.Q = q
+}
+
+// WithRole adds the role to the list meetings params
+func (o *ListMeetingsParams) WithRole(role *string) *ListMeetingsParams {
+	o.SetRole(role)
+	return o
+}
+
+// SetRole adds the role to the list meetings params
+func (o *ListMeetingsParams) SetRole(role *string) {
+	o.Role = role
+}
+
+// WithStartTime adds the startTime to the list meetings params
+func (o *ListMeetingsParams) WithStartTime(startTime strfmt.DateTime) *ListMeetingsParams {
+	o.SetStartTime(startTime)
+	return o
+}
+
+// SetStartTime adds the startTime to the list meetings params
+func (o *ListMeetingsParams) SetStartTime(startTime strfmt.DateTime) {
+	o.StartTime = startTime
+}
+
+// WithStatus adds the status to the list meetings params
+func (o *ListMeetingsParams) WithStatus(status *string) *ListMeetingsParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list meetings params
+func (o *ListMeetingsParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListMeetingsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Calendars != nil {
+
+		// query param calendars
+		var qrCalendars string
+
+		if o.Calendars != nil {
+			qrCalendars = *o.Calendars
+		}
+		qCalendars := qrCalendars
+		if qCalendars != "" {
+
+			if err := r.SetQueryParam("calendars", qCalendars); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param endTime
+	qrEndTime := o.EndTime
+	qEndTime := qrEndTime.String()
+	if qEndTime != "" {
+
+		if err := r.SetQueryParam("endTime", qEndTime); err != nil {
+			return err
+		}
+	}
+
+	if o.ExcludeCalendars != nil {
+
+		// query param excludeCalendars
+		var qrExcludeCalendars string
+
+		if o.ExcludeCalendars != nil {
+			qrExcludeCalendars = *o.ExcludeCalendars
+		}
+		qExcludeCalendars := qrExcludeCalendars
+		if qExcludeCalendars != "" {
+
+			if err := r.SetQueryParam("excludeCalendars", qExcludeCalendars); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+
+	if o.Q != nil {
+
+		// query param q
+		var qrQ string
+
+		if o.Q != nil {
+			qrQ = *o.Q
+		}
+		qQ := qrQ
+		if qQ != "" {
+
+			if err := r.SetQueryParam("q", qQ); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Role != nil {
+
+		// query param role
+		var qrRole string
+
+		if o.Role != nil {
+			qrRole = *o.Role
+		}
+		qRole := qrRole
+		if qRole != "" {
+
+			if err := r.SetQueryParam("role", qRole); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param startTime
+	qrStartTime := o.StartTime
+	qStartTime := qrStartTime.String()
+	if qStartTime != "" {
+
+		if err := r.SetQueryParam("startTime", qStartTime); err != nil {
+			return err
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
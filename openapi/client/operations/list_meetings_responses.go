@@ -0,0 +1,340 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// ListMeetingsReader is a Reader for the ListMeetings structure.
+type ListMeetingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListMeetingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListMeetingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListMeetingsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListMeetingsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListMeetingsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListMeetingsOK creates a ListMeetingsOK with default headers values
+func NewListMeetingsOK() *ListMeetingsOK {
+	return &ListMeetingsOK{}
+}
+
+/*
+ListMeetingsOK describes a response with status code 200, with default header values.
+
+meetings in the range ordered by start, recurring ones expanded. Meetings the caller may not see only have their time, and don't match role, status and q
+*/
+type ListMeetingsOK struct {
+
+	/* <url>; rel="next" of the next page, when there is one
+	 */
+	Link string
+
+	Payload []*models.Meeting
+}
+
+// IsSuccess returns true when this list meetings o k response has a 2xx status code
+func (o *ListMeetingsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list meetings o k response has a 3xx status code
+func (o *ListMeetingsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list meetings o k response has a 4xx status code
+func (o *ListMeetingsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list meetings o k response has a 5xx status code
+func (o *ListMeetingsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list meetings o k response a status code equal to that given
+func (o *ListMeetingsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list meetings o k response
+func (o *ListMeetingsOK) Code() int {
+	return 200
+}
+
+func (o *ListMeetingsOK) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListMeetingsOK) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListMeetingsOK) GetPayload() []*models.Meeting {
+	return o.Payload
+}
+
+func (o *ListMeetingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Link
+	hdrLink := response.GetHeader("Link")
+
+	if hdrLink != "" {
+		o.Link = hdrLink
+	}
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListMeetingsBadRequest creates a ListMeetingsBadRequest with default headers values
+func NewListMeetingsBadRequest() *ListMeetingsBadRequest {
+	return &ListMeetingsBadRequest{}
+}
+
+/*
+ListMeetingsBadRequest describes a response with status code 400, with default header values.
+
+invalid cursor or limit
+*/
+type ListMeetingsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list meetings bad request response has a 2xx status code
+func (o *ListMeetingsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list meetings bad request response has a 3xx status code
+func (o *ListMeetingsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list meetings bad request response has a 4xx status code
+func (o *ListMeetingsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list meetings bad request response has a 5xx status code
+func (o *ListMeetingsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list meetings bad request response a status code equal to that given
+func (o *ListMeetingsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list meetings bad request response
+func (o *ListMeetingsBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListMeetingsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListMeetingsBadRequest) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListMeetingsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListMeetingsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListMeetingsForbidden creates a ListMeetingsForbidden with default headers values
+func NewListMeetingsForbidden() *ListMeetingsForbidden {
+	return &ListMeetingsForbidden{}
+}
+
+/*
+ListMeetingsForbidden describes a response with status code 403, with default header values.
+
+the user doesn't share their calendar with the caller
+*/
+type ListMeetingsForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list meetings forbidden response has a 2xx status code
+func (o *ListMeetingsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list meetings forbidden response has a 3xx status code
+func (o *ListMeetingsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list meetings forbidden response has a 4xx status code
+func (o *ListMeetingsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list meetings forbidden response has a 5xx status code
+func (o *ListMeetingsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list meetings forbidden response a status code equal to that given
+func (o *ListMeetingsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the list meetings forbidden response
+func (o *ListMeetingsForbidden) Code() int {
+	return 403
+}
+
+func (o *ListMeetingsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetingsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListMeetingsForbidden) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetingsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListMeetingsForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListMeetingsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListMeetingsDefault creates a ListMeetingsDefault with default headers values
+func NewListMeetingsDefault(code int) *ListMeetingsDefault {
+	return &ListMeetingsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListMeetingsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListMeetingsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list meetings default response has a 2xx status code
+func (o *ListMeetingsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list meetings default response has a 3xx status code
+func (o *ListMeetingsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list meetings default response has a 4xx status code
+func (o *ListMeetingsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list meetings default response has a 5xx status code
+func (o *ListMeetingsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list meetings default response a status code equal to that given
+func (o *ListMeetingsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the list meetings default response
+func (o *ListMeetingsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListMeetingsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListMeetingsDefault) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/meetings][%d] listMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListMeetingsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListMeetingsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
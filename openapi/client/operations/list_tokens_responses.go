@@ -0,0 +1,254 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// ListTokensReader is a Reader for the ListTokens structure.
+type ListTokensReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListTokensReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListTokensOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewListTokensForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListTokensDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListTokensOK creates a ListTokensOK with default headers values
+func NewListTokensOK() *ListTokensOK {
+	return &ListTokensOK{}
+}
+
+/*
+ListTokensOK describes a response with status code 200, with default header values.
+
+tokens of the user
+*/
+type ListTokensOK struct {
+	Payload []*models.APIToken
+}
+
+// IsSuccess returns true when this list tokens o k response has a 2xx status code
+func (o *ListTokensOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list tokens o k response has a 3xx status code
+func (o *ListTokensOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list tokens o k response has a 4xx status code
+func (o *ListTokensOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list tokens o k response has a 5xx status code
+func (o *ListTokensOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list tokens o k response a status code equal to that given
+func (o *ListTokensOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list tokens o k response
+func (o *ListTokensOK) Code() int {
+	return 200
+}
+
+func (o *ListTokensOK) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/tokens][%d] listTokensOK  %+v", 200, o.Payload)
+}
+
+func (o *ListTokensOK) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/tokens][%d] listTokensOK  %+v", 200, o.Payload)
+}
+
+func (o *ListTokensOK) GetPayload() []*models.APIToken {
+	return o.Payload
+}
+
+func (o *ListTokensOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListTokensForbidden creates a ListTokensForbidden with default headers values
+func NewListTokensForbidden() *ListTokensForbidden {
+	return &ListTokensForbidden{}
+}
+
+/*
+ListTokensForbidden describes a response with status code 403, with default header values.
+
+not the user
+*/
+type ListTokensForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list tokens forbidden response has a 2xx status code
+func (o *ListTokensForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list tokens forbidden response has a 3xx status code
+func (o *ListTokensForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list tokens forbidden response has a 4xx status code
+func (o *ListTokensForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list tokens forbidden response has a 5xx status code
+func (o *ListTokensForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list tokens forbidden response a status code equal to that given
+func (o *ListTokensForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the list tokens forbidden response
+func (o *ListTokensForbidden) Code() int {
+	return 403
+}
+
+func (o *ListTokensForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/tokens][%d] listTokensForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListTokensForbidden) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/tokens][%d] listTokensForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListTokensForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListTokensForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListTokensDefault creates a ListTokensDefault with default headers values
+func NewListTokensDefault(code int) *ListTokensDefault {
+	return &ListTokensDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListTokensDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListTokensDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list tokens default response has a 2xx status code
+func (o *ListTokensDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list tokens default response has a 3xx status code
+func (o *ListTokensDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list tokens default response has a 4xx status code
+func (o *ListTokensDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list tokens default response has a 5xx status code
+func (o *ListTokensDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list tokens default response a status code equal to that given
+func (o *ListTokensDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the list tokens default response
+func (o *ListTokensDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListTokensDefault) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/tokens][%d] listTokens default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListTokensDefault) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/tokens][%d] listTokens default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListTokensDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListTokensDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,1718 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new operations API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for operations API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	AcceptMeeting(params *AcceptMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AcceptMeetingOK, error)
+
+	AddMeeting(params *AddMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AddMeetingOK, error)
+
+	AddUser(params *AddUserParams, opts ...ClientOption) (*AddUserOK, error)
+
+	AddWebhook(params *AddWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AddWebhookOK, error)
+
+	CreateCalendar(params *CreateCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateCalendarOK, error)
+
+	CreateGroup(params *CreateGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateGroupOK, error)
+
+	CreateSession(params *CreateSessionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateSessionOK, error)
+
+	CreateToken(params *CreateTokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateTokenOK, error)
+
+	DeleteAttachment(params *DeleteAttachmentParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteAttachmentNoContent, error)
+
+	DeleteCalendar(params *DeleteCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteCalendarNoContent, error)
+
+	DeleteGroup(params *DeleteGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteGroupNoContent, error)
+
+	DeleteMeeting(params *DeleteMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteMeetingNoContent, error)
+
+	DeleteToken(params *DeleteTokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteTokenNoContent, error)
+
+	DeleteUser(params *DeleteUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteUserNoContent, error)
+
+	DeleteWebhook(params *DeleteWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteWebhookNoContent, error)
+
+	DownloadAttachment(params *DownloadAttachmentParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DownloadAttachmentOK, error)
+
+	ExportCalendar(params *ExportCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ExportCalendarOK, error)
+
+	FindSlot(params *FindSlotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*FindSlotOK, error)
+
+	GetGroup(params *GetGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetGroupOK, error)
+
+	GetMeeting(params *GetMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMeetingOK, error)
+
+	GetSpec(params *GetSpecParams, opts ...ClientOption) (*GetSpecOK, error)
+
+	GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserOK, error)
+
+	GetWebhook(params *GetWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetWebhookOK, error)
+
+	ImportCalendar(params *ImportCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportCalendarOK, error)
+
+	ListCalendars(params *ListCalendarsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListCalendarsOK, error)
+
+	ListDeliveries(params *ListDeliveriesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListDeliveriesOK, error)
+
+	ListGroups(params *ListGroupsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListGroupsOK, error)
+
+	ListMeetings(params *ListMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListMeetingsOK, error)
+
+	ListTokens(params *ListTokensParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListTokensOK, error)
+
+	ListUsers(params *ListUsersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListUsersOK, error)
+
+	ListWebhooks(params *ListWebhooksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListWebhooksOK, error)
+
+	ReceiveItip(params *ReceiveItipParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReceiveItipOK, error)
+
+	SearchMeetings(params *SearchMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SearchMeetingsOK, error)
+
+	SetReminders(params *SetRemindersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetRemindersOK, error)
+
+	SetShares(params *SetSharesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetSharesOK, error)
+
+	StreamMeetings(params *StreamMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StreamMeetingsOK, error)
+
+	SyncMeetings(params *SyncMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SyncMeetingsOK, error)
+
+	UpdateCalendar(params *UpdateCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateCalendarOK, error)
+
+	UpdateGroup(params *UpdateGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateGroupOK, error)
+
+	UpdateMeeting(params *UpdateMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateMeetingOK, error)
+
+	UpdateUser(params *UpdateUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateUserOK, error)
+
+	UploadAttachment(params *UploadAttachmentParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UploadAttachmentOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+AcceptMeeting accept meeting API
+*/
+func (a *Client) AcceptMeeting(params *AcceptMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AcceptMeetingOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAcceptMeetingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "acceptMeeting",
+		Method:             "POST",
+		PathPattern:        "/api/acceptMeeting",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AcceptMeetingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AcceptMeetingOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AcceptMeetingDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+AddMeeting add meeting API
+*/
+func (a *Client) AddMeeting(params *AddMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AddMeetingOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddMeetingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "addMeeting",
+		Method:             "POST",
+		PathPattern:        "/api/meetings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AddMeetingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AddMeetingOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AddMeetingDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+AddUser creates a user, the response carries their first api token. Takes the admin token, or no token when signup is open
+*/
+func (a *Client) AddUser(params *AddUserParams, opts ...ClientOption) (*AddUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "addUser",
+		Method:             "POST",
+		PathPattern:        "/api/users",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AddUserReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AddUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AddUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+AddWebhook subscribes an url to meeting events of a user, or of everyone when login is omitted. Payloads are signed, see the X-Calendar-Signature header, and carry meetings as the user of the webhook sees them
+*/
+func (a *Client) AddWebhook(params *AddWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AddWebhookOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddWebhookParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "addWebhook",
+		Method:             "POST",
+		PathPattern:        "/api/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AddWebhookReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AddWebhookOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AddWebhookDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CreateCalendar create calendar API
+*/
+func (a *Client) CreateCalendar(params *CreateCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateCalendarOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateCalendarParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createCalendar",
+		Method:             "POST",
+		PathPattern:        "/api/users/{login}/calendars",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateCalendarReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateCalendarOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateCalendarDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CreateGroup create group API
+*/
+func (a *Client) CreateGroup(params *CreateGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateGroupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateGroupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createGroup",
+		Method:             "POST",
+		PathPattern:        "/api/groups",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateGroupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateGroupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateGroupDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CreateSession exchanges the token of the request for a session token valid for 12 hours
+*/
+func (a *Client) CreateSession(params *CreateSessionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateSessionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateSessionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createSession",
+		Method:             "POST",
+		PathPattern:        "/api/sessions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateSessionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateSessionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateSessionDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+CreateToken create token API
+*/
+func (a *Client) CreateToken(params *CreateTokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateTokenOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateTokenParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createToken",
+		Method:             "POST",
+		PathPattern:        "/api/users/{login}/tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateTokenReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateTokenOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateTokenDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteAttachment removes a stored file from the meeting and deletes it
+*/
+func (a *Client) DeleteAttachment(params *DeleteAttachmentParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteAttachmentNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteAttachmentParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteAttachment",
+		Method:             "DELETE",
+		PathPattern:        "/api/meetings/{id}/attachments/{attachmentId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteAttachmentReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteAttachmentNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteAttachmentDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteCalendar deletes a calendar, its meetings move to the default calendar
+*/
+func (a *Client) DeleteCalendar(params *DeleteCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteCalendarNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteCalendarParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteCalendar",
+		Method:             "DELETE",
+		PathPattern:        "/api/users/{login}/calendars/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteCalendarReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteCalendarNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteCalendarDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteGroup deletes a group, the members it invited stay invited
+*/
+func (a *Client) DeleteGroup(params *DeleteGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteGroupNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteGroupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteGroup",
+		Method:             "DELETE",
+		PathPattern:        "/api/groups/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteGroupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteGroupNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteGroupDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteMeeting cancels the meeting and mails the invitees
+*/
+func (a *Client) DeleteMeeting(params *DeleteMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteMeetingNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteMeetingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteMeeting",
+		Method:             "DELETE",
+		PathPattern:        "/api/meetings/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteMeetingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteMeetingNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteMeetingDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteToken delete token API
+*/
+func (a *Client) DeleteToken(params *DeleteTokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteTokenNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteTokenParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteToken",
+		Method:             "DELETE",
+		PathPattern:        "/api/users/{login}/tokens/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteTokenReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteTokenNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteTokenDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteUser deletes the user with their tokens, calendars and webhooks. Meetings they own are cancelled, or transferred, their invitations and shares with them removed
+*/
+func (a *Client) DeleteUser(params *DeleteUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteUserNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteUser",
+		Method:             "DELETE",
+		PathPattern:        "/api/users/{login}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteUserNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteWebhook delete webhook API
+*/
+func (a *Client) DeleteWebhook(params *DeleteWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteWebhookNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteWebhookParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteWebhook",
+		Method:             "DELETE",
+		PathPattern:        "/api/webhooks/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteWebhookReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteWebhookNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteWebhookDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DownloadAttachment sends a stored file as a download, to whoever may see the details of the meeting
+*/
+func (a *Client) DownloadAttachment(params *DownloadAttachmentParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DownloadAttachmentOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDownloadAttachmentParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "downloadAttachment",
+		Method:             "GET",
+		PathPattern:        "/api/meetings/{id}/attachments/{attachmentId}",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DownloadAttachmentReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DownloadAttachmentOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DownloadAttachmentDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ExportCalendar RFC 5545 subscription feed of all meetings of the user, recurring meetings carry an RRULE. Meetings the caller may not see are exported as Busy
+*/
+func (a *Client) ExportCalendar(params *ExportCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ExportCalendarOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportCalendarParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "exportCalendar",
+		Method:             "GET",
+		PathPattern:        "/api/users/{login}/calendar.ics",
+		ProducesMediaTypes: []string{"text/calendar"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ExportCalendarReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExportCalendarOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ExportCalendarDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+FindSlot find slot API
+*/
+func (a *Client) FindSlot(params *FindSlotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*FindSlotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewFindSlotParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "findSlot",
+		Method:             "GET",
+		PathPattern:        "/api/findSlot",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &FindSlotReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*FindSlotOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*FindSlotDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetGroup get group API
+*/
+func (a *Client) GetGroup(params *GetGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetGroupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetGroupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getGroup",
+		Method:             "GET",
+		PathPattern:        "/api/groups/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetGroupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetGroupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetGroupDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetMeeting get meeting API
+*/
+func (a *Client) GetMeeting(params *GetMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMeetingOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetMeetingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getMeeting",
+		Method:             "GET",
+		PathPattern:        "/api/meetings/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetMeetingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetMeetingOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetMeetingDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetSpec get spec API
+*/
+func (a *Client) GetSpec(params *GetSpecParams, opts ...ClientOption) (*GetSpecOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSpecParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getSpec",
+		Method:             "GET",
+		PathPattern:        "/api/openapi.json",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSpecReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetSpecOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getSpec: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetUser get user API
+*/
+func (a *Client) GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getUser",
+		Method:             "GET",
+		PathPattern:        "/api/users/{login}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetWebhook get webhook API
+*/
+func (a *Client) GetWebhook(params *GetWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetWebhookOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWebhookParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getWebhook",
+		Method:             "GET",
+		PathPattern:        "/api/webhooks/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWebhookReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetWebhookOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetWebhookDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ImportCalendar creates or, matching on UID, updates meetings from the VEVENTs of an iCalendar file
+*/
+func (a *Client) ImportCalendar(params *ImportCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportCalendarOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportCalendarParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "importCalendar",
+		Method:             "POST",
+		PathPattern:        "/api/users/{login}/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data", "text/calendar"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ImportCalendarReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportCalendarOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ImportCalendarDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListCalendars list calendars API
+*/
+func (a *Client) ListCalendars(params *ListCalendarsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListCalendarsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListCalendarsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listCalendars",
+		Method:             "GET",
+		PathPattern:        "/api/users/{login}/calendars",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListCalendarsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListCalendarsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListCalendarsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListDeliveries list deliveries API
+*/
+func (a *Client) ListDeliveries(params *ListDeliveriesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListDeliveriesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListDeliveriesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listDeliveries",
+		Method:             "GET",
+		PathPattern:        "/api/webhooks/{id}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListDeliveriesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListDeliveriesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListDeliveriesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListGroups list groups API
+*/
+func (a *Client) ListGroups(params *ListGroupsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListGroupsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListGroupsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listGroups",
+		Method:             "GET",
+		PathPattern:        "/api/groups",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListGroupsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListGroupsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListGroupsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListMeetings list meetings API
+*/
+func (a *Client) ListMeetings(params *ListMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListMeetingsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListMeetingsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listMeetings",
+		Method:             "GET",
+		PathPattern:        "/api/users/{login}/meetings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListMeetingsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListMeetingsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListMeetingsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListTokens list tokens API
+*/
+func (a *Client) ListTokens(params *ListTokensParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListTokensOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListTokensParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listTokens",
+		Method:             "GET",
+		PathPattern:        "/api/users/{login}/tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListTokensReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListTokensOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListTokensDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListUsers list users API
+*/
+func (a *Client) ListUsers(params *ListUsersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListUsersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListUsersParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listUsers",
+		Method:             "GET",
+		PathPattern:        "/api/users",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListUsersReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListUsersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListUsersDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListWebhooks list webhooks API
+*/
+func (a *Client) ListWebhooks(params *ListWebhooksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListWebhooksOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListWebhooksParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listWebhooks",
+		Method:             "GET",
+		PathPattern:        "/api/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListWebhooksReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListWebhooksOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListWebhooksDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ReceiveItip applies iTIP REPLY messages of invitees, either the calendar object or the whole email
+*/
+func (a *Client) ReceiveItip(params *ReceiveItipParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReceiveItipOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReceiveItipParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "receiveItip",
+		Method:             "POST",
+		PathPattern:        "/api/itip",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"message/rfc822", "text/calendar"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReceiveItipReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReceiveItipOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ReceiveItipDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+SearchMeetings meetings whose titles, descriptions or locations contain the words of q, the most relevant first, titles weigh the most. Only meetings whose details the caller may see are included
+*/
+func (a *Client) SearchMeetings(params *SearchMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SearchMeetingsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchMeetingsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "searchMeetings",
+		Method:             "GET",
+		PathPattern:        "/api/meetings/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchMeetingsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SearchMeetingsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SearchMeetingsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+SetReminders replaces the default reminders of the user, they apply to meetings without reminders of their own
+*/
+func (a *Client) SetReminders(params *SetRemindersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetRemindersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetRemindersParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "setReminders",
+		Method:             "PUT",
+		PathPattern:        "/api/users/{login}/reminders",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetRemindersReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetRemindersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SetRemindersDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+SetShares replaces the users the user shares their calendar with
+*/
+func (a *Client) SetShares(params *SetSharesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetSharesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetSharesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "setShares",
+		Method:             "PUT",
+		PathPattern:        "/api/users/{login}/shares",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetSharesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetSharesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SetSharesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+StreamMeetings pushes events of the user's meetings as Server-Sent Events, or as WebSocket text messages when the request asks to upgrade. Browsers open WebSockets only from the origin of the service or an allowed one. Streams lagging behind are closed, clients should reconnect and re-read their range
+*/
+func (a *Client) StreamMeetings(params *StreamMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StreamMeetingsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStreamMeetingsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "streamMeetings",
+		Method:             "GET",
+		PathPattern:        "/api/users/{login}/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StreamMeetingsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StreamMeetingsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*StreamMeetingsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+SyncMeetings meetings of the user changed since the sync token, or all of them without one. Meetings the user was removed from are listed as deleted. Updated meetings may be new to the client and should be upserted by id
+*/
+func (a *Client) SyncMeetings(params *SyncMeetingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SyncMeetingsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSyncMeetingsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "syncMeetings",
+		Method:             "GET",
+		PathPattern:        "/api/users/{login}/sync",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SyncMeetingsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SyncMeetingsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SyncMeetingsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateCalendar update calendar API
+*/
+func (a *Client) UpdateCalendar(params *UpdateCalendarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateCalendarOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateCalendarParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateCalendar",
+		Method:             "PUT",
+		PathPattern:        "/api/users/{login}/calendars/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateCalendarReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateCalendarOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateCalendarDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateGroup replaces the members and nested groups, meetings inviting the group invite its new members
+*/
+func (a *Client) UpdateGroup(params *UpdateGroupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateGroupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateGroupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateGroup",
+		Method:             "PUT",
+		PathPattern:        "/api/groups/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateGroupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateGroupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateGroupDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateMeeting replaces the meeting and mails the invitees, answers are reset when the meeting is rescheduled
+*/
+func (a *Client) UpdateMeeting(params *UpdateMeetingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateMeetingOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateMeetingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateMeeting",
+		Method:             "PUT",
+		PathPattern:        "/api/meetings/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateMeetingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateMeetingOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateMeetingDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UpdateUser changes the profile, absent fields are kept and empty ones cleared. Logins can't change
+*/
+func (a *Client) UpdateUser(params *UpdateUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateUser",
+		Method:             "PATCH",
+		PathPattern:        "/api/users/{login}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+UploadAttachment stores a file of at most 25 MiB with the meeting, the "file" field of a form or the body, named by name. Its type is sniffed from the content, the declared one is taken when the content doesn't tell
+*/
+func (a *Client) UploadAttachment(params *UploadAttachmentParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UploadAttachmentOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUploadAttachmentParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "uploadAttachment",
+		Method:             "POST",
+		PathPattern:        "/api/meetings/{id}/attachments",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"*/*", "application/octet-stream", "multipart/form-data"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UploadAttachmentReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UploadAttachmentOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UploadAttachmentDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
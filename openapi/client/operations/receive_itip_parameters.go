@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReceiveItipParams creates a new ReceiveItipParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReceiveItipParams() *ReceiveItipParams {
+	return &ReceiveItipParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReceiveItipParamsWithTimeout creates a new ReceiveItipParams object
+// with the ability to set a timeout on a request.
+func NewReceiveItipParamsWithTimeout(timeout time.Duration) *ReceiveItipParams {
+	return &ReceiveItipParams{
+		timeout: timeout,
+	}
+}
+
+// NewReceiveItipParamsWithContext creates a new ReceiveItipParams object
+// with the ability to set a context for a request.
+func NewReceiveItipParamsWithContext(ctx context.Context) *ReceiveItipParams {
+	return &ReceiveItipParams{
+		Context: ctx,
+	}
+}
+
+// NewReceiveItipParamsWithHTTPClient creates a new ReceiveItipParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReceiveItipParamsWithHTTPClient(client *http.Client) *ReceiveItipParams {
+	return &ReceiveItipParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReceiveItipParams contains all the parameters to send to the API endpoint
+
+	for the receive itip operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReceiveItipParams struct {
+
+	// Message.
+	Message string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the receive itip params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReceiveItipParams) WithDefaults() *ReceiveItipParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the receive itip params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReceiveItipParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the receive itip params
+func (o *ReceiveItipParams) WithTimeout(timeout time.Duration) *ReceiveItipParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the receive itip params
+func (o *ReceiveItipParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the receive itip params
+func (o *ReceiveItipParams) WithContext(ctx context.Context) *ReceiveItipParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the receive itip params
+func (o *ReceiveItipParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the receive itip params
+func (o *ReceiveItipParams) WithHTTPClient(client *http.Client) *ReceiveItipParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the receive itip params
+func (o *ReceiveItipParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithMessage adds the message to the receive itip params
+func (o *ReceiveItipParams) WithMessage(message string) *ReceiveItipParams {
+	o.SetMessage(message)
+	return o
+}
+
+// SetMessage adds the message to the receive itip params
+func (o *ReceiveItipParams) SetMessage(message string) {
+	o.Message = message
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReceiveItipParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Message); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// ReceiveItipReader is a Reader for the ReceiveItip structure.
+type ReceiveItipReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReceiveItipReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReceiveItipOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewReceiveItipNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReceiveItipUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewReceiveItipDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewReceiveItipOK creates a ReceiveItipOK with default headers values
+func NewReceiveItipOK() *ReceiveItipOK {
+	return &ReceiveItipOK{}
+}
+
+/*
+ReceiveItipOK describes a response with status code 200, with default header values.
+
+updated meetings
+*/
+type ReceiveItipOK struct {
+	Payload []*models.Meeting
+}
+
+// IsSuccess returns true when this receive itip o k response has a 2xx status code
+func (o *ReceiveItipOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this receive itip o k response has a 3xx status code
+func (o *ReceiveItipOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this receive itip o k response has a 4xx status code
+func (o *ReceiveItipOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this receive itip o k response has a 5xx status code
+func (o *ReceiveItipOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this receive itip o k response a status code equal to that given
+func (o *ReceiveItipOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the receive itip o k response
+func (o *ReceiveItipOK) Code() int {
+	return 200
+}
+
+func (o *ReceiveItipOK) Error() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItipOK  %+v", 200, o.Payload)
+}
+
+func (o *ReceiveItipOK) String() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItipOK  %+v", 200, o.Payload)
+}
+
+func (o *ReceiveItipOK) GetPayload() []*models.Meeting {
+	return o.Payload
+}
+
+func (o *ReceiveItipOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReceiveItipNotFound creates a ReceiveItipNotFound with default headers values
+func NewReceiveItipNotFound() *ReceiveItipNotFound {
+	return &ReceiveItipNotFound{}
+}
+
+/*
+ReceiveItipNotFound describes a response with status code 404, with default header values.
+
+no such meeting
+*/
+type ReceiveItipNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this receive itip not found response has a 2xx status code
+func (o *ReceiveItipNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this receive itip not found response has a 3xx status code
+func (o *ReceiveItipNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this receive itip not found response has a 4xx status code
+func (o *ReceiveItipNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this receive itip not found response has a 5xx status code
+func (o *ReceiveItipNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this receive itip not found response a status code equal to that given
+func (o *ReceiveItipNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the receive itip not found response
+func (o *ReceiveItipNotFound) Code() int {
+	return 404
+}
+
+func (o *ReceiveItipNotFound) Error() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItipNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReceiveItipNotFound) String() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItipNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReceiveItipNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ReceiveItipNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReceiveItipUnprocessableEntity creates a ReceiveItipUnprocessableEntity with default headers values
+func NewReceiveItipUnprocessableEntity() *ReceiveItipUnprocessableEntity {
+	return &ReceiveItipUnprocessableEntity{}
+}
+
+/*
+ReceiveItipUnprocessableEntity describes a response with status code 422, with default header values.
+
+not a REPLY or unknown attendee
+*/
+type ReceiveItipUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this receive itip unprocessable entity response has a 2xx status code
+func (o *ReceiveItipUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this receive itip unprocessable entity response has a 3xx status code
+func (o *ReceiveItipUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this receive itip unprocessable entity response has a 4xx status code
+func (o *ReceiveItipUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this receive itip unprocessable entity response has a 5xx status code
+func (o *ReceiveItipUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this receive itip unprocessable entity response a status code equal to that given
+func (o *ReceiveItipUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the receive itip unprocessable entity response
+func (o *ReceiveItipUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReceiveItipUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItipUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReceiveItipUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItipUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReceiveItipUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ReceiveItipUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReceiveItipDefault creates a ReceiveItipDefault with default headers values
+func NewReceiveItipDefault(code int) *ReceiveItipDefault {
+	return &ReceiveItipDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ReceiveItipDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ReceiveItipDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this receive itip default response has a 2xx status code
+func (o *ReceiveItipDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this receive itip default response has a 3xx status code
+func (o *ReceiveItipDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this receive itip default response has a 4xx status code
+func (o *ReceiveItipDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this receive itip default response has a 5xx status code
+func (o *ReceiveItipDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this receive itip default response a status code equal to that given
+func (o *ReceiveItipDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the receive itip default response
+func (o *ReceiveItipDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ReceiveItipDefault) Error() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItip default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ReceiveItipDefault) String() string {
+	return fmt.Sprintf("[POST /api/itip][%d] receiveItip default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ReceiveItipDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ReceiveItipDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewSearchMeetingsParams creates a new SearchMeetingsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSearchMeetingsParams() *SearchMeetingsParams {
+	return &SearchMeetingsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSearchMeetingsParamsWithTimeout creates a new SearchMeetingsParams object
+// with the ability to set a timeout on a request.
+func NewSearchMeetingsParamsWithTimeout(timeout time.Duration) *SearchMeetingsParams {
+	return &SearchMeetingsParams{
+		timeout: timeout,
+	}
+}
+
+// NewSearchMeetingsParamsWithContext creates a new SearchMeetingsParams object
+// with the ability to set a context for a request.
+func NewSearchMeetingsParamsWithContext(ctx context.Context) *SearchMeetingsParams {
+	return &SearchMeetingsParams{
+		Context: ctx,
+	}
+}
+
+// NewSearchMeetingsParamsWithHTTPClient creates a new SearchMeetingsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSearchMeetingsParamsWithHTTPClient(client *http.Client) *SearchMeetingsParams {
+	return &SearchMeetingsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SearchMeetingsParams contains all the parameters to send to the API endpoint
+
+	for the search meetings operation.
+
+	Typically these are written to a http.Request.
+*/
+type SearchMeetingsParams struct {
+
+	/* From.
+
+	   only meetings, or series, ending after this time
+
+	   Format: date-time
+	*/
+	From *strfmt.DateTime
+
+	// Limit.
+	//
+	// Default: 20
+	Limit *int64
+
+	/* Participants.
+
+	   comma separated logins who all take part
+	*/
+	Participants *string
+
+	/* Q.
+
+	   words, "quoted phrases" and -excluded words
+	*/
+	Q string
+
+	/* To.
+
+	   only meetings, or series, starting before this time
+
+	   Format: date-time
+	*/
+	To *strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the search meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchMeetingsParams) WithDefaults() *SearchMeetingsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the search meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchMeetingsParams) SetDefaults() {
+	var (
+		limitDefault = int64(20)
+	)
+
+	val := SearchMeetingsParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the search meetings params
+func (o *SearchMeetingsParams) WithTimeout(timeout time.Duration) *SearchMeetingsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the search meetings params
+func (o *SearchMeetingsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the search meetings params
+func (o *SearchMeetingsParams) WithContext(ctx context.Context) *SearchMeetingsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the search meetings params
+func (o *SearchMeetingsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the search meetings params
+func (o *SearchMeetingsParams) WithHTTPClient(client *http.Client) *SearchMeetingsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the search meetings params
+func (o *SearchMeetingsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFrom adds the from to the search meetings params
+func (o *SearchMeetingsParams) WithFrom(from *strfmt.DateTime) *SearchMeetingsParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the search meetings params
+func (o *SearchMeetingsParams) SetFrom(from *strfmt.DateTime) {
+	o.From = from
+}
+
+// WithLimit adds the limit to the search meetings params
+func (o *SearchMeetingsParams) WithLimit(limit *int64) *SearchMeetingsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the search meetings params
+func (o *SearchMeetingsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithParticipants adds the participants to the search meetings params
+func (o *SearchMeetingsParams) WithParticipants(participants *string) *SearchMeetingsParams {
+	o.SetParticipants(participants)
+	return o
+}
+
+// SetParticipants adds the participants to the search meetings params
+func (o *SearchMeetingsParams) SetParticipants(participants *string) {
+	o.Participants = participants
+}
+
+// WithQ adds the q to the search meetings params
+func (o *SearchMeetingsParams) WithQ(q string) *SearchMeetingsParams {
+	o.SetQ(q)
+	return o
+}
+
+// SetQ adds the q to the search meetings params
+func (o *SearchMeetingsParams) SetQ(q string) {
+	o.Q = q
+}
+
+// WithTo adds the to to the search meetings params
+func (o *SearchMeetingsParams) WithTo(to *strfmt.DateTime) *SearchMeetingsParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the search meetings params
+func (o *SearchMeetingsParams) SetTo(to *strfmt.DateTime) {
+	o.To = to
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SearchMeetingsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.From != nil {
+
+		// query param from
+		var qrFrom strfmt.DateTime
+
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := qrFrom.String()
+		if qFrom != "" {
+
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Participants != nil {
+
+		// query param participants
+		var qrParticipants string
+
+		if o.Participants != nil {
+			qrParticipants = *o.Participants
+		}
+		qParticipants := qrParticipants
+		if qParticipants != "" {
+
+			if err := r.SetQueryParam("participants", qParticipants); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param q
+	qrQ := o.Q
+	qQ := qrQ
+	if qQ != "" {
+
+		if err := r.SetQueryParam("q", qQ); err != nil {
+			return err
+		}
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo strfmt.DateTime
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo.String()
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// SearchMeetingsReader is a Reader for the SearchMeetings structure.
+type SearchMeetingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SearchMeetingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSearchMeetingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSearchMeetingsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewSearchMeetingsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSearchMeetingsOK creates a SearchMeetingsOK with default headers values
+func NewSearchMeetingsOK() *SearchMeetingsOK {
+	return &SearchMeetingsOK{}
+}
+
+/*
+SearchMeetingsOK describes a response with status code 200, with default header values.
+
+matching meetings, series of recurring ones are not expanded
+*/
+type SearchMeetingsOK struct {
+	Payload []*models.Meeting
+}
+
+// IsSuccess returns true when this search meetings o k response has a 2xx status code
+func (o *SearchMeetingsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this search meetings o k response has a 3xx status code
+func (o *SearchMeetingsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search meetings o k response has a 4xx status code
+func (o *SearchMeetingsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this search meetings o k response has a 5xx status code
+func (o *SearchMeetingsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search meetings o k response a status code equal to that given
+func (o *SearchMeetingsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the search meetings o k response
+func (o *SearchMeetingsOK) Code() int {
+	return 200
+}
+
+func (o *SearchMeetingsOK) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/search][%d] searchMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchMeetingsOK) String() string {
+	return fmt.Sprintf("[GET /api/meetings/search][%d] searchMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchMeetingsOK) GetPayload() []*models.Meeting {
+	return o.Payload
+}
+
+func (o *SearchMeetingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchMeetingsBadRequest creates a SearchMeetingsBadRequest with default headers values
+func NewSearchMeetingsBadRequest() *SearchMeetingsBadRequest {
+	return &SearchMeetingsBadRequest{}
+}
+
+/*
+SearchMeetingsBadRequest describes a response with status code 400, with default header values.
+
+invalid limit
+*/
+type SearchMeetingsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this search meetings bad request response has a 2xx status code
+func (o *SearchMeetingsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this search meetings bad request response has a 3xx status code
+func (o *SearchMeetingsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search meetings bad request response has a 4xx status code
+func (o *SearchMeetingsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this search meetings bad request response has a 5xx status code
+func (o *SearchMeetingsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search meetings bad request response a status code equal to that given
+func (o *SearchMeetingsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the search meetings bad request response
+func (o *SearchMeetingsBadRequest) Code() int {
+	return 400
+}
+
+func (o *SearchMeetingsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/search][%d] searchMeetingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchMeetingsBadRequest) String() string {
+	return fmt.Sprintf("[GET /api/meetings/search][%d] searchMeetingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchMeetingsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SearchMeetingsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchMeetingsDefault creates a SearchMeetingsDefault with default headers values
+func NewSearchMeetingsDefault(code int) *SearchMeetingsDefault {
+	return &SearchMeetingsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SearchMeetingsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SearchMeetingsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this search meetings default response has a 2xx status code
+func (o *SearchMeetingsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this search meetings default response has a 3xx status code
+func (o *SearchMeetingsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this search meetings default response has a 4xx status code
+func (o *SearchMeetingsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this search meetings default response has a 5xx status code
+func (o *SearchMeetingsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this search meetings default response a status code equal to that given
+func (o *SearchMeetingsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the search meetings default response
+func (o *SearchMeetingsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SearchMeetingsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/meetings/search][%d] searchMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SearchMeetingsDefault) String() string {
+	return fmt.Sprintf("[GET /api/meetings/search][%d] searchMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SearchMeetingsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SearchMeetingsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// NewSetRemindersParams creates a new SetRemindersParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSetRemindersParams() *SetRemindersParams {
+	return &SetRemindersParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSetRemindersParamsWithTimeout creates a new SetRemindersParams object
+// with the ability to set a timeout on a request.
+func NewSetRemindersParamsWithTimeout(timeout time.Duration) *SetRemindersParams {
+	return &SetRemindersParams{
+		timeout: timeout,
+	}
+}
+
+// NewSetRemindersParamsWithContext creates a new SetRemindersParams object
+// with the ability to set a context for a request.
+func NewSetRemindersParamsWithContext(ctx context.Context) *SetRemindersParams {
+	return &SetRemindersParams{
+		Context: ctx,
+	}
+}
+
+// NewSetRemindersParamsWithHTTPClient creates a new SetRemindersParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSetRemindersParamsWithHTTPClient(client *http.Client) *SetRemindersParams {
+	return &SetRemindersParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SetRemindersParams contains all the parameters to send to the API endpoint
+
+	for the set reminders operation.
+
+	Typically these are written to a http.Request.
+*/
+type SetRemindersParams struct {
+
+	// Login.
+	Login string
+
+	// Reminders.
+	Reminders []*models.Reminder
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the set reminders params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetRemindersParams) WithDefaults() *SetRemindersParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the set reminders params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetRemindersParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the set reminders params
+func (o *SetRemindersParams) WithTimeout(timeout time.Duration) *SetRemindersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the set reminders params
+func (o *SetRemindersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the set reminders params
+func (o *SetRemindersParams) WithContext(ctx context.Context) *SetRemindersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the set reminders params
+func (o *SetRemindersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the set reminders params
+func (o *SetRemindersParams) WithHTTPClient(client *http.Client) *SetRemindersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the set reminders params
+func (o *SetRemindersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLogin adds the login to the set reminders params
+func (o *SetRemindersParams) WithLogin(login string) *SetRemindersParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the set reminders params
+func (o *SetRemindersParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WithReminders adds the reminders to the set reminders params
+func (o *SetRemindersParams) WithReminders(reminders []*models.Reminder) *SetRemindersParams {
+	o.SetReminders(reminders)
+	return o
+}
+
+// SetReminders adds the reminders to the set reminders params
+func (o *SetRemindersParams) SetReminders(reminders []*models.Reminder) {
+	o.Reminders = reminders
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SetRemindersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+	if o.Reminders != nil {
+		if err := r.SetBodyParam(o.Reminders); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
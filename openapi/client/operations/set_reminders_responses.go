@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// SetRemindersReader is a Reader for the SetReminders structure.
+type SetRemindersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetRemindersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSetRemindersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewSetRemindersNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSetRemindersUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewSetRemindersDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSetRemindersOK creates a SetRemindersOK with default headers values
+func NewSetRemindersOK() *SetRemindersOK {
+	return &SetRemindersOK{}
+}
+
+/*
+SetRemindersOK describes a response with status code 200, with default header values.
+
+updated user
+*/
+type SetRemindersOK struct {
+	Payload *models.User
+}
+
+// IsSuccess returns true when this set reminders o k response has a 2xx status code
+func (o *SetRemindersOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this set reminders o k response has a 3xx status code
+func (o *SetRemindersOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set reminders o k response has a 4xx status code
+func (o *SetRemindersOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this set reminders o k response has a 5xx status code
+func (o *SetRemindersOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set reminders o k response a status code equal to that given
+func (o *SetRemindersOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the set reminders o k response
+func (o *SetRemindersOK) Code() int {
+	return 200
+}
+
+func (o *SetRemindersOK) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setRemindersOK  %+v", 200, o.Payload)
+}
+
+func (o *SetRemindersOK) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setRemindersOK  %+v", 200, o.Payload)
+}
+
+func (o *SetRemindersOK) GetPayload() *models.User {
+	return o.Payload
+}
+
+func (o *SetRemindersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.User)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetRemindersNotFound creates a SetRemindersNotFound with default headers values
+func NewSetRemindersNotFound() *SetRemindersNotFound {
+	return &SetRemindersNotFound{}
+}
+
+/*
+SetRemindersNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type SetRemindersNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this set reminders not found response has a 2xx status code
+func (o *SetRemindersNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this set reminders not found response has a 3xx status code
+func (o *SetRemindersNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set reminders not found response has a 4xx status code
+func (o *SetRemindersNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this set reminders not found response has a 5xx status code
+func (o *SetRemindersNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set reminders not found response a status code equal to that given
+func (o *SetRemindersNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the set reminders not found response
+func (o *SetRemindersNotFound) Code() int {
+	return 404
+}
+
+func (o *SetRemindersNotFound) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setRemindersNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SetRemindersNotFound) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setRemindersNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SetRemindersNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetRemindersNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetRemindersUnprocessableEntity creates a SetRemindersUnprocessableEntity with default headers values
+func NewSetRemindersUnprocessableEntity() *SetRemindersUnprocessableEntity {
+	return &SetRemindersUnprocessableEntity{}
+}
+
+/*
+SetRemindersUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid reminders
+*/
+type SetRemindersUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this set reminders unprocessable entity response has a 2xx status code
+func (o *SetRemindersUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this set reminders unprocessable entity response has a 3xx status code
+func (o *SetRemindersUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set reminders unprocessable entity response has a 4xx status code
+func (o *SetRemindersUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this set reminders unprocessable entity response has a 5xx status code
+func (o *SetRemindersUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set reminders unprocessable entity response a status code equal to that given
+func (o *SetRemindersUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the set reminders unprocessable entity response
+func (o *SetRemindersUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SetRemindersUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setRemindersUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SetRemindersUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setRemindersUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SetRemindersUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetRemindersUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetRemindersDefault creates a SetRemindersDefault with default headers values
+func NewSetRemindersDefault(code int) *SetRemindersDefault {
+	return &SetRemindersDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SetRemindersDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SetRemindersDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this set reminders default response has a 2xx status code
+func (o *SetRemindersDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this set reminders default response has a 3xx status code
+func (o *SetRemindersDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this set reminders default response has a 4xx status code
+func (o *SetRemindersDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this set reminders default response has a 5xx status code
+func (o *SetRemindersDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this set reminders default response a status code equal to that given
+func (o *SetRemindersDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the set reminders default response
+func (o *SetRemindersDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SetRemindersDefault) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setReminders default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SetRemindersDefault) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/reminders][%d] setReminders default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SetRemindersDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetRemindersDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
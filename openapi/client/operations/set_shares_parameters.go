@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// NewSetSharesParams creates a new SetSharesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSetSharesParams() *SetSharesParams {
+	return &SetSharesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSetSharesParamsWithTimeout creates a new SetSharesParams object
+// with the ability to set a timeout on a request.
+func NewSetSharesParamsWithTimeout(timeout time.Duration) *SetSharesParams {
+	return &SetSharesParams{
+		timeout: timeout,
+	}
+}
+
+// NewSetSharesParamsWithContext creates a new SetSharesParams object
+// with the ability to set a context for a request.
+func NewSetSharesParamsWithContext(ctx context.Context) *SetSharesParams {
+	return &SetSharesParams{
+		Context: ctx,
+	}
+}
+
+// NewSetSharesParamsWithHTTPClient creates a new SetSharesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSetSharesParamsWithHTTPClient(client *http.Client) *SetSharesParams {
+	return &SetSharesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SetSharesParams contains all the parameters to send to the API endpoint
+
+	for the set shares operation.
+
+	Typically these are written to a http.Request.
+*/
+type SetSharesParams struct {
+
+	// Login.
+	Login string
+
+	// Shares.
+	Shares []*models.Share
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the set shares params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetSharesParams) WithDefaults() *SetSharesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the set shares params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetSharesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the set shares params
+func (o *SetSharesParams) WithTimeout(timeout time.Duration) *SetSharesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the set shares params
+func (o *SetSharesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the set shares params
+func (o *SetSharesParams) WithContext(ctx context.Context) *SetSharesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the set shares params
+func (o *SetSharesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the set shares params
+func (o *SetSharesParams) WithHTTPClient(client *http.Client) *SetSharesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the set shares params
+func (o *SetSharesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLogin adds the login to the set shares params
+func (o *SetSharesParams) WithLogin(login string) *SetSharesParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the set shares params
+func (o *SetSharesParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WithShares adds the shares to the set shares params
+func (o *SetSharesParams) WithShares(shares []*models.Share) *SetSharesParams {
+	o.SetShares(shares)
+	return o
+}
+
+// SetShares adds the shares to the set shares params
+func (o *SetSharesParams) SetShares(shares []*models.Share) {
+	o.Shares = shares
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SetSharesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+	if o.Shares != nil {
+		if err := r.SetBodyParam(o.Shares); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
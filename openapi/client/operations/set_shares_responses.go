@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// SetSharesReader is a Reader for the SetShares structure.
+type SetSharesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetSharesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSetSharesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewSetSharesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSetSharesUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewSetSharesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSetSharesOK creates a SetSharesOK with default headers values
+func NewSetSharesOK() *SetSharesOK {
+	return &SetSharesOK{}
+}
+
+/*
+SetSharesOK describes a response with status code 200, with default header values.
+
+updated user
+*/
+type SetSharesOK struct {
+	Payload *models.User
+}
+
+// IsSuccess returns true when this set shares o k response has a 2xx status code
+func (o *SetSharesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this set shares o k response has a 3xx status code
+func (o *SetSharesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set shares o k response has a 4xx status code
+func (o *SetSharesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this set shares o k response has a 5xx status code
+func (o *SetSharesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set shares o k response a status code equal to that given
+func (o *SetSharesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the set shares o k response
+func (o *SetSharesOK) Code() int {
+	return 200
+}
+
+func (o *SetSharesOK) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setSharesOK  %+v", 200, o.Payload)
+}
+
+func (o *SetSharesOK) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setSharesOK  %+v", 200, o.Payload)
+}
+
+func (o *SetSharesOK) GetPayload() *models.User {
+	return o.Payload
+}
+
+func (o *SetSharesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.User)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetSharesNotFound creates a SetSharesNotFound with default headers values
+func NewSetSharesNotFound() *SetSharesNotFound {
+	return &SetSharesNotFound{}
+}
+
+/*
+SetSharesNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type SetSharesNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this set shares not found response has a 2xx status code
+func (o *SetSharesNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this set shares not found response has a 3xx status code
+func (o *SetSharesNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set shares not found response has a 4xx status code
+func (o *SetSharesNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this set shares not found response has a 5xx status code
+func (o *SetSharesNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set shares not found response a status code equal to that given
+func (o *SetSharesNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the set shares not found response
+func (o *SetSharesNotFound) Code() int {
+	return 404
+}
+
+func (o *SetSharesNotFound) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setSharesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SetSharesNotFound) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setSharesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SetSharesNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetSharesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetSharesUnprocessableEntity creates a SetSharesUnprocessableEntity with default headers values
+func NewSetSharesUnprocessableEntity() *SetSharesUnprocessableEntity {
+	return &SetSharesUnprocessableEntity{}
+}
+
+/*
+SetSharesUnprocessableEntity describes a response with status code 422, with default header values.
+
+unknown users or levels
+*/
+type SetSharesUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this set shares unprocessable entity response has a 2xx status code
+func (o *SetSharesUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this set shares unprocessable entity response has a 3xx status code
+func (o *SetSharesUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this set shares unprocessable entity response has a 4xx status code
+func (o *SetSharesUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this set shares unprocessable entity response has a 5xx status code
+func (o *SetSharesUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this set shares unprocessable entity response a status code equal to that given
+func (o *SetSharesUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the set shares unprocessable entity response
+func (o *SetSharesUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SetSharesUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setSharesUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SetSharesUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setSharesUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SetSharesUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetSharesUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetSharesDefault creates a SetSharesDefault with default headers values
+func NewSetSharesDefault(code int) *SetSharesDefault {
+	return &SetSharesDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SetSharesDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SetSharesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this set shares default response has a 2xx status code
+func (o *SetSharesDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this set shares default response has a 3xx status code
+func (o *SetSharesDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this set shares default response has a 4xx status code
+func (o *SetSharesDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this set shares default response has a 5xx status code
+func (o *SetSharesDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this set shares default response a status code equal to that given
+func (o *SetSharesDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the set shares default response
+func (o *SetSharesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SetSharesDefault) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setShares default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SetSharesDefault) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/shares][%d] setShares default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SetSharesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetSharesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
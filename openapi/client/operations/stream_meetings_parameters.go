@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewStreamMeetingsParams creates a new StreamMeetingsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStreamMeetingsParams() *StreamMeetingsParams {
+	return &StreamMeetingsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStreamMeetingsParamsWithTimeout creates a new StreamMeetingsParams object
+// with the ability to set a timeout on a request.
+func NewStreamMeetingsParamsWithTimeout(timeout time.Duration) *StreamMeetingsParams {
+	return &StreamMeetingsParams{
+		timeout: timeout,
+	}
+}
+
+// NewStreamMeetingsParamsWithContext creates a new StreamMeetingsParams object
+// with the ability to set a context for a request.
+func NewStreamMeetingsParamsWithContext(ctx context.Context) *StreamMeetingsParams {
+	return &StreamMeetingsParams{
+		Context: ctx,
+	}
+}
+
+// NewStreamMeetingsParamsWithHTTPClient creates a new StreamMeetingsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStreamMeetingsParamsWithHTTPClient(client *http.Client) *StreamMeetingsParams {
+	return &StreamMeetingsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StreamMeetingsParams contains all the parameters to send to the API endpoint
+
+	for the stream meetings operation.
+
+	Typically these are written to a http.Request.
+*/
+type StreamMeetingsParams struct {
+
+	/* AccessToken.
+
+	   the token, for clients that can't set headers
+	*/
+	AccessToken *string
+
+	// Login.
+	Login string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the stream meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamMeetingsParams) WithDefaults() *StreamMeetingsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the stream meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamMeetingsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the stream meetings params
+func (o *StreamMeetingsParams) WithTimeout(timeout time.Duration) *StreamMeetingsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the stream meetings params
+func (o *StreamMeetingsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the stream meetings params
+func (o *StreamMeetingsParams) WithContext(ctx context.Context) *StreamMeetingsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the stream meetings params
+func (o *StreamMeetingsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the stream meetings params
+func (o *StreamMeetingsParams) WithHTTPClient(client *http.Client) *StreamMeetingsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the stream meetings params
+func (o *StreamMeetingsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccessToken adds the accessToken to the stream meetings params
+func (o *StreamMeetingsParams) WithAccessToken(accessToken *string) *StreamMeetingsParams {
+	o.SetAccessToken(accessToken)
+	return o
+}
+
+// SetAccessToken adds the accessToken to the stream meetings params
+func (o *StreamMeetingsParams) SetAccessToken(accessToken *string) {
+	o.AccessToken = accessToken
+}
+
+// WithLogin adds the login to the stream meetings params
+func (o *StreamMeetingsParams) WithLogin(login string) *StreamMeetingsParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the stream meetings params
+func (o *StreamMeetingsParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StreamMeetingsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AccessToken != nil {
+
+		// query param access_token
+		var qrAccessToken string
+
+		if o.AccessToken != nil {
+			qrAccessToken = *o.AccessToken
+		}
+		qAccessToken := qrAccessToken
+		if qAccessToken != "" {
+
+			if err := r.SetQueryParam("access_token", qAccessToken); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
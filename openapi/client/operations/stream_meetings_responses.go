@@ -0,0 +1,392 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// StreamMeetingsReader is a Reader for the StreamMeetings structure.
+type StreamMeetingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StreamMeetingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 101:
+		result := NewStreamMeetingsSwitchingProtocols()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 200:
+		result := NewStreamMeetingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewStreamMeetingsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewStreamMeetingsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewStreamMeetingsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewStreamMeetingsSwitchingProtocols creates a StreamMeetingsSwitchingProtocols with default headers values
+func NewStreamMeetingsSwitchingProtocols() *StreamMeetingsSwitchingProtocols {
+	return &StreamMeetingsSwitchingProtocols{}
+}
+
+/*
+StreamMeetingsSwitchingProtocols describes a response with status code 101, with default header values.
+
+switched to a WebSocket
+*/
+type StreamMeetingsSwitchingProtocols struct {
+}
+
+// IsSuccess returns true when this stream meetings switching protocols response has a 2xx status code
+func (o *StreamMeetingsSwitchingProtocols) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream meetings switching protocols response has a 3xx status code
+func (o *StreamMeetingsSwitchingProtocols) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream meetings switching protocols response has a 4xx status code
+func (o *StreamMeetingsSwitchingProtocols) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream meetings switching protocols response has a 5xx status code
+func (o *StreamMeetingsSwitchingProtocols) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream meetings switching protocols response a status code equal to that given
+func (o *StreamMeetingsSwitchingProtocols) IsCode(code int) bool {
+	return code == 101
+}
+
+// Code gets the status code for the stream meetings switching protocols response
+func (o *StreamMeetingsSwitchingProtocols) Code() int {
+	return 101
+}
+
+func (o *StreamMeetingsSwitchingProtocols) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsSwitchingProtocols ", 101)
+}
+
+func (o *StreamMeetingsSwitchingProtocols) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsSwitchingProtocols ", 101)
+}
+
+func (o *StreamMeetingsSwitchingProtocols) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewStreamMeetingsOK creates a StreamMeetingsOK with default headers values
+func NewStreamMeetingsOK() *StreamMeetingsOK {
+	return &StreamMeetingsOK{}
+}
+
+/*
+StreamMeetingsOK describes a response with status code 200, with default header values.
+
+event stream, the data of every event is an Event
+*/
+type StreamMeetingsOK struct {
+	Payload *models.Event
+}
+
+// IsSuccess returns true when this stream meetings o k response has a 2xx status code
+func (o *StreamMeetingsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this stream meetings o k response has a 3xx status code
+func (o *StreamMeetingsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream meetings o k response has a 4xx status code
+func (o *StreamMeetingsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream meetings o k response has a 5xx status code
+func (o *StreamMeetingsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream meetings o k response a status code equal to that given
+func (o *StreamMeetingsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the stream meetings o k response
+func (o *StreamMeetingsOK) Code() int {
+	return 200
+}
+
+func (o *StreamMeetingsOK) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamMeetingsOK) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamMeetingsOK) GetPayload() *models.Event {
+	return o.Payload
+}
+
+func (o *StreamMeetingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Event)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamMeetingsForbidden creates a StreamMeetingsForbidden with default headers values
+func NewStreamMeetingsForbidden() *StreamMeetingsForbidden {
+	return &StreamMeetingsForbidden{}
+}
+
+/*
+StreamMeetingsForbidden describes a response with status code 403, with default header values.
+
+the WebSocket is opened from an origin which isn't allowed
+*/
+type StreamMeetingsForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this stream meetings forbidden response has a 2xx status code
+func (o *StreamMeetingsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream meetings forbidden response has a 3xx status code
+func (o *StreamMeetingsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream meetings forbidden response has a 4xx status code
+func (o *StreamMeetingsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this stream meetings forbidden response has a 5xx status code
+func (o *StreamMeetingsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream meetings forbidden response a status code equal to that given
+func (o *StreamMeetingsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the stream meetings forbidden response
+func (o *StreamMeetingsForbidden) Code() int {
+	return 403
+}
+
+func (o *StreamMeetingsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *StreamMeetingsForbidden) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *StreamMeetingsForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *StreamMeetingsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamMeetingsNotFound creates a StreamMeetingsNotFound with default headers values
+func NewStreamMeetingsNotFound() *StreamMeetingsNotFound {
+	return &StreamMeetingsNotFound{}
+}
+
+/*
+StreamMeetingsNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type StreamMeetingsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this stream meetings not found response has a 2xx status code
+func (o *StreamMeetingsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream meetings not found response has a 3xx status code
+func (o *StreamMeetingsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream meetings not found response has a 4xx status code
+func (o *StreamMeetingsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this stream meetings not found response has a 5xx status code
+func (o *StreamMeetingsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream meetings not found response a status code equal to that given
+func (o *StreamMeetingsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the stream meetings not found response
+func (o *StreamMeetingsNotFound) Code() int {
+	return 404
+}
+
+func (o *StreamMeetingsNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *StreamMeetingsNotFound) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *StreamMeetingsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *StreamMeetingsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamMeetingsDefault creates a StreamMeetingsDefault with default headers values
+func NewStreamMeetingsDefault(code int) *StreamMeetingsDefault {
+	return &StreamMeetingsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+StreamMeetingsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type StreamMeetingsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this stream meetings default response has a 2xx status code
+func (o *StreamMeetingsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this stream meetings default response has a 3xx status code
+func (o *StreamMeetingsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this stream meetings default response has a 4xx status code
+func (o *StreamMeetingsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this stream meetings default response has a 5xx status code
+func (o *StreamMeetingsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this stream meetings default response a status code equal to that given
+func (o *StreamMeetingsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the stream meetings default response
+func (o *StreamMeetingsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *StreamMeetingsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *StreamMeetingsDefault) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/stream][%d] streamMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *StreamMeetingsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *StreamMeetingsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewSyncMeetingsParams creates a new SyncMeetingsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSyncMeetingsParams() *SyncMeetingsParams {
+	return &SyncMeetingsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSyncMeetingsParamsWithTimeout creates a new SyncMeetingsParams object
+// with the ability to set a timeout on a request.
+func NewSyncMeetingsParamsWithTimeout(timeout time.Duration) *SyncMeetingsParams {
+	return &SyncMeetingsParams{
+		timeout: timeout,
+	}
+}
+
+// NewSyncMeetingsParamsWithContext creates a new SyncMeetingsParams object
+// with the ability to set a context for a request.
+func NewSyncMeetingsParamsWithContext(ctx context.Context) *SyncMeetingsParams {
+	return &SyncMeetingsParams{
+		Context: ctx,
+	}
+}
+
+// NewSyncMeetingsParamsWithHTTPClient creates a new SyncMeetingsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSyncMeetingsParamsWithHTTPClient(client *http.Client) *SyncMeetingsParams {
+	return &SyncMeetingsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SyncMeetingsParams contains all the parameters to send to the API endpoint
+
+	for the sync meetings operation.
+
+	Typically these are written to a http.Request.
+*/
+type SyncMeetingsParams struct {
+
+	// Limit.
+	//
+	// Default: 100
+	Limit *int64
+
+	// Login.
+	Login string
+
+	/* Token.
+
+	   syncToken of the previous sync
+	*/
+	Token *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the sync meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SyncMeetingsParams) WithDefaults() *SyncMeetingsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the sync meetings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SyncMeetingsParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+	)
+
+	val := SyncMeetingsParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the sync meetings params
+func (o *SyncMeetingsParams) WithTimeout(timeout time.Duration) *SyncMeetingsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the sync meetings params
+func (o *SyncMeetingsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the sync meetings params
+func (o *SyncMeetingsParams) WithContext(ctx context.Context) *SyncMeetingsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the sync meetings params
+func (o *SyncMeetingsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the sync meetings params
+func (o *SyncMeetingsParams) WithHTTPClient(client *http.Client) *SyncMeetingsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the sync meetings params
+func (o *SyncMeetingsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the sync meetings params
+func (o *SyncMeetingsParams) WithLimit(limit *int64) *SyncMeetingsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the sync meetings params
+func (o *SyncMeetingsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithLogin adds the login to the sync meetings params
+func (o *SyncMeetingsParams) WithLogin(login string) *SyncMeetingsParams {
+	o.SetLogin(login)
+	return o
+}
+
+// SetLogin adds the login to the sync meetings params
+func (o *SyncMeetingsParams) SetLogin(login string) {
+	o.Login = login
+}
+
+// WithToken adds the token to the sync meetings params
+func (o *SyncMeetingsParams) WithToken(token *string) *SyncMeetingsParams {
+	o.SetToken(token)
+	return o
+}
+
+// SetToken adds the token to the sync meetings params
+func (o *SyncMeetingsParams) SetToken(token *string) {
+	o.Token = token
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SyncMeetingsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param login
+	if err := r.SetPathParam("login", o.Login); err != nil {
+		return err
+	}
+
+	if o.Token != nil {
+
+		// query param token
+		var qrToken string
+
+		if o.Token != nil {
+			qrToken = *o.Token
+		}
+		qToken := qrToken
+		if qToken != "" {
+
+			if err := r.SetQueryParam("token", qToken); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
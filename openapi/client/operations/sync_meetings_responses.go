@@ -0,0 +1,404 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// SyncMeetingsReader is a Reader for the SyncMeetings structure.
+type SyncMeetingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SyncMeetingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSyncMeetingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSyncMeetingsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSyncMeetingsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewSyncMeetingsGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewSyncMeetingsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSyncMeetingsOK creates a SyncMeetingsOK with default headers values
+func NewSyncMeetingsOK() *SyncMeetingsOK {
+	return &SyncMeetingsOK{}
+}
+
+/*
+SyncMeetingsOK describes a response with status code 200, with default header values.
+
+changes, keep syncToken for the next sync
+*/
+type SyncMeetingsOK struct {
+	Payload *models.SyncResult
+}
+
+// IsSuccess returns true when this sync meetings o k response has a 2xx status code
+func (o *SyncMeetingsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this sync meetings o k response has a 3xx status code
+func (o *SyncMeetingsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this sync meetings o k response has a 4xx status code
+func (o *SyncMeetingsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this sync meetings o k response has a 5xx status code
+func (o *SyncMeetingsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this sync meetings o k response a status code equal to that given
+func (o *SyncMeetingsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the sync meetings o k response
+func (o *SyncMeetingsOK) Code() int {
+	return 200
+}
+
+func (o *SyncMeetingsOK) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *SyncMeetingsOK) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsOK  %+v", 200, o.Payload)
+}
+
+func (o *SyncMeetingsOK) GetPayload() *models.SyncResult {
+	return o.Payload
+}
+
+func (o *SyncMeetingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SyncResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSyncMeetingsBadRequest creates a SyncMeetingsBadRequest with default headers values
+func NewSyncMeetingsBadRequest() *SyncMeetingsBadRequest {
+	return &SyncMeetingsBadRequest{}
+}
+
+/*
+SyncMeetingsBadRequest describes a response with status code 400, with default header values.
+
+invalid token
+*/
+type SyncMeetingsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this sync meetings bad request response has a 2xx status code
+func (o *SyncMeetingsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this sync meetings bad request response has a 3xx status code
+func (o *SyncMeetingsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this sync meetings bad request response has a 4xx status code
+func (o *SyncMeetingsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this sync meetings bad request response has a 5xx status code
+func (o *SyncMeetingsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this sync meetings bad request response a status code equal to that given
+func (o *SyncMeetingsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the sync meetings bad request response
+func (o *SyncMeetingsBadRequest) Code() int {
+	return 400
+}
+
+func (o *SyncMeetingsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SyncMeetingsBadRequest) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SyncMeetingsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SyncMeetingsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSyncMeetingsNotFound creates a SyncMeetingsNotFound with default headers values
+func NewSyncMeetingsNotFound() *SyncMeetingsNotFound {
+	return &SyncMeetingsNotFound{}
+}
+
+/*
+SyncMeetingsNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type SyncMeetingsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this sync meetings not found response has a 2xx status code
+func (o *SyncMeetingsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this sync meetings not found response has a 3xx status code
+func (o *SyncMeetingsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this sync meetings not found response has a 4xx status code
+func (o *SyncMeetingsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this sync meetings not found response has a 5xx status code
+func (o *SyncMeetingsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this sync meetings not found response a status code equal to that given
+func (o *SyncMeetingsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the sync meetings not found response
+func (o *SyncMeetingsNotFound) Code() int {
+	return 404
+}
+
+func (o *SyncMeetingsNotFound) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SyncMeetingsNotFound) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SyncMeetingsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SyncMeetingsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSyncMeetingsGone creates a SyncMeetingsGone with default headers values
+func NewSyncMeetingsGone() *SyncMeetingsGone {
+	return &SyncMeetingsGone{}
+}
+
+/*
+SyncMeetingsGone describes a response with status code 410, with default header values.
+
+the token expired, sync again without a token
+*/
+type SyncMeetingsGone struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this sync meetings gone response has a 2xx status code
+func (o *SyncMeetingsGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this sync meetings gone response has a 3xx status code
+func (o *SyncMeetingsGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this sync meetings gone response has a 4xx status code
+func (o *SyncMeetingsGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this sync meetings gone response has a 5xx status code
+func (o *SyncMeetingsGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this sync meetings gone response a status code equal to that given
+func (o *SyncMeetingsGone) IsCode(code int) bool {
+	return code == 410
+}
+
+// Code gets the status code for the sync meetings gone response
+func (o *SyncMeetingsGone) Code() int {
+	return 410
+}
+
+func (o *SyncMeetingsGone) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsGone  %+v", 410, o.Payload)
+}
+
+func (o *SyncMeetingsGone) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetingsGone  %+v", 410, o.Payload)
+}
+
+func (o *SyncMeetingsGone) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SyncMeetingsGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSyncMeetingsDefault creates a SyncMeetingsDefault with default headers values
+func NewSyncMeetingsDefault(code int) *SyncMeetingsDefault {
+	return &SyncMeetingsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SyncMeetingsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SyncMeetingsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this sync meetings default response has a 2xx status code
+func (o *SyncMeetingsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this sync meetings default response has a 3xx status code
+func (o *SyncMeetingsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this sync meetings default response has a 4xx status code
+func (o *SyncMeetingsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this sync meetings default response has a 5xx status code
+func (o *SyncMeetingsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this sync meetings default response a status code equal to that given
+func (o *SyncMeetingsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the sync meetings default response
+func (o *SyncMeetingsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SyncMeetingsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SyncMeetingsDefault) String() string {
+	return fmt.Sprintf("[GET /api/users/{login}/sync][%d] syncMeetings default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SyncMeetingsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SyncMeetingsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
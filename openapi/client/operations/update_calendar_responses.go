@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// UpdateCalendarReader is a Reader for the UpdateCalendar structure.
+type UpdateCalendarReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateCalendarReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateCalendarOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewUpdateCalendarNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewUpdateCalendarUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUpdateCalendarDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateCalendarOK creates a UpdateCalendarOK with default headers values
+func NewUpdateCalendarOK() *UpdateCalendarOK {
+	return &UpdateCalendarOK{}
+}
+
+/*
+UpdateCalendarOK describes a response with status code 200, with default header values.
+
+updated calendar
+*/
+type UpdateCalendarOK struct {
+	Payload *models.Calendar
+}
+
+// IsSuccess returns true when this update calendar o k response has a 2xx status code
+func (o *UpdateCalendarOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update calendar o k response has a 3xx status code
+func (o *UpdateCalendarOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update calendar o k response has a 4xx status code
+func (o *UpdateCalendarOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update calendar o k response has a 5xx status code
+func (o *UpdateCalendarOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update calendar o k response a status code equal to that given
+func (o *UpdateCalendarOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update calendar o k response
+func (o *UpdateCalendarOK) Code() int {
+	return 200
+}
+
+func (o *UpdateCalendarOK) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateCalendarOK) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendarOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateCalendarOK) GetPayload() *models.Calendar {
+	return o.Payload
+}
+
+func (o *UpdateCalendarOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Calendar)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCalendarNotFound creates a UpdateCalendarNotFound with default headers values
+func NewUpdateCalendarNotFound() *UpdateCalendarNotFound {
+	return &UpdateCalendarNotFound{}
+}
+
+/*
+UpdateCalendarNotFound describes a response with status code 404, with default header values.
+
+no such calendar
+*/
+type UpdateCalendarNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update calendar not found response has a 2xx status code
+func (o *UpdateCalendarNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update calendar not found response has a 3xx status code
+func (o *UpdateCalendarNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update calendar not found response has a 4xx status code
+func (o *UpdateCalendarNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update calendar not found response has a 5xx status code
+func (o *UpdateCalendarNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update calendar not found response a status code equal to that given
+func (o *UpdateCalendarNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update calendar not found response
+func (o *UpdateCalendarNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateCalendarNotFound) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateCalendarNotFound) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendarNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateCalendarNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateCalendarNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCalendarUnprocessableEntity creates a UpdateCalendarUnprocessableEntity with default headers values
+func NewUpdateCalendarUnprocessableEntity() *UpdateCalendarUnprocessableEntity {
+	return &UpdateCalendarUnprocessableEntity{}
+}
+
+/*
+UpdateCalendarUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid calendar, or the default calendar
+*/
+type UpdateCalendarUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update calendar unprocessable entity response has a 2xx status code
+func (o *UpdateCalendarUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update calendar unprocessable entity response has a 3xx status code
+func (o *UpdateCalendarUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update calendar unprocessable entity response has a 4xx status code
+func (o *UpdateCalendarUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update calendar unprocessable entity response has a 5xx status code
+func (o *UpdateCalendarUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update calendar unprocessable entity response a status code equal to that given
+func (o *UpdateCalendarUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the update calendar unprocessable entity response
+func (o *UpdateCalendarUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *UpdateCalendarUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendarUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateCalendarUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendarUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateCalendarUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateCalendarUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateCalendarDefault creates a UpdateCalendarDefault with default headers values
+func NewUpdateCalendarDefault(code int) *UpdateCalendarDefault {
+	return &UpdateCalendarDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateCalendarDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type UpdateCalendarDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update calendar default response has a 2xx status code
+func (o *UpdateCalendarDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this update calendar default response has a 3xx status code
+func (o *UpdateCalendarDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this update calendar default response has a 4xx status code
+func (o *UpdateCalendarDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this update calendar default response has a 5xx status code
+func (o *UpdateCalendarDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this update calendar default response a status code equal to that given
+func (o *UpdateCalendarDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the update calendar default response
+func (o *UpdateCalendarDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateCalendarDefault) Error() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateCalendarDefault) String() string {
+	return fmt.Sprintf("[PUT /api/users/{login}/calendars/{id}][%d] updateCalendar default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateCalendarDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateCalendarDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
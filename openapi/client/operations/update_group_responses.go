@@ -0,0 +1,404 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// UpdateGroupReader is a Reader for the UpdateGroup structure.
+type UpdateGroupReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateGroupReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateGroupOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewUpdateGroupForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateGroupNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewUpdateGroupUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUpdateGroupDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateGroupOK creates a UpdateGroupOK with default headers values
+func NewUpdateGroupOK() *UpdateGroupOK {
+	return &UpdateGroupOK{}
+}
+
+/*
+UpdateGroupOK describes a response with status code 200, with default header values.
+
+updated group
+*/
+type UpdateGroupOK struct {
+	Payload *models.Group
+}
+
+// IsSuccess returns true when this update group o k response has a 2xx status code
+func (o *UpdateGroupOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update group o k response has a 3xx status code
+func (o *UpdateGroupOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update group o k response has a 4xx status code
+func (o *UpdateGroupOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update group o k response has a 5xx status code
+func (o *UpdateGroupOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update group o k response a status code equal to that given
+func (o *UpdateGroupOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update group o k response
+func (o *UpdateGroupOK) Code() int {
+	return 200
+}
+
+func (o *UpdateGroupOK) Error() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateGroupOK) String() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateGroupOK) GetPayload() *models.Group {
+	return o.Payload
+}
+
+func (o *UpdateGroupOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Group)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateGroupForbidden creates a UpdateGroupForbidden with default headers values
+func NewUpdateGroupForbidden() *UpdateGroupForbidden {
+	return &UpdateGroupForbidden{}
+}
+
+/*
+UpdateGroupForbidden describes a response with status code 403, with default header values.
+
+not the owner of the group
+*/
+type UpdateGroupForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update group forbidden response has a 2xx status code
+func (o *UpdateGroupForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update group forbidden response has a 3xx status code
+func (o *UpdateGroupForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update group forbidden response has a 4xx status code
+func (o *UpdateGroupForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update group forbidden response has a 5xx status code
+func (o *UpdateGroupForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update group forbidden response a status code equal to that given
+func (o *UpdateGroupForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the update group forbidden response
+func (o *UpdateGroupForbidden) Code() int {
+	return 403
+}
+
+func (o *UpdateGroupForbidden) Error() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UpdateGroupForbidden) String() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UpdateGroupForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateGroupForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateGroupNotFound creates a UpdateGroupNotFound with default headers values
+func NewUpdateGroupNotFound() *UpdateGroupNotFound {
+	return &UpdateGroupNotFound{}
+}
+
+/*
+UpdateGroupNotFound describes a response with status code 404, with default header values.
+
+no such group
+*/
+type UpdateGroupNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update group not found response has a 2xx status code
+func (o *UpdateGroupNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update group not found response has a 3xx status code
+func (o *UpdateGroupNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update group not found response has a 4xx status code
+func (o *UpdateGroupNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update group not found response has a 5xx status code
+func (o *UpdateGroupNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update group not found response a status code equal to that given
+func (o *UpdateGroupNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update group not found response
+func (o *UpdateGroupNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateGroupNotFound) Error() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateGroupNotFound) String() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateGroupNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateGroupNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateGroupUnprocessableEntity creates a UpdateGroupUnprocessableEntity with default headers values
+func NewUpdateGroupUnprocessableEntity() *UpdateGroupUnprocessableEntity {
+	return &UpdateGroupUnprocessableEntity{}
+}
+
+/*
+UpdateGroupUnprocessableEntity describes a response with status code 422, with default header values.
+
+unknown members or nested groups, or nested in a cycle
+*/
+type UpdateGroupUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update group unprocessable entity response has a 2xx status code
+func (o *UpdateGroupUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update group unprocessable entity response has a 3xx status code
+func (o *UpdateGroupUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update group unprocessable entity response has a 4xx status code
+func (o *UpdateGroupUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update group unprocessable entity response has a 5xx status code
+func (o *UpdateGroupUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update group unprocessable entity response a status code equal to that given
+func (o *UpdateGroupUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the update group unprocessable entity response
+func (o *UpdateGroupUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *UpdateGroupUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateGroupUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroupUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateGroupUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateGroupUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateGroupDefault creates a UpdateGroupDefault with default headers values
+func NewUpdateGroupDefault(code int) *UpdateGroupDefault {
+	return &UpdateGroupDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateGroupDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type UpdateGroupDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update group default response has a 2xx status code
+func (o *UpdateGroupDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this update group default response has a 3xx status code
+func (o *UpdateGroupDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this update group default response has a 4xx status code
+func (o *UpdateGroupDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this update group default response has a 5xx status code
+func (o *UpdateGroupDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this update group default response a status code equal to that given
+func (o *UpdateGroupDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the update group default response
+func (o *UpdateGroupDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateGroupDefault) Error() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateGroupDefault) String() string {
+	return fmt.Sprintf("[PUT /api/groups/{name}][%d] updateGroup default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateGroupDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateGroupDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
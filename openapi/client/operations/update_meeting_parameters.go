@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// NewUpdateMeetingParams creates a new UpdateMeetingParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateMeetingParams() *UpdateMeetingParams {
+	return &UpdateMeetingParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateMeetingParamsWithTimeout creates a new UpdateMeetingParams object
+// with the ability to set a timeout on a request.
+func NewUpdateMeetingParamsWithTimeout(timeout time.Duration) *UpdateMeetingParams {
+	return &UpdateMeetingParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateMeetingParamsWithContext creates a new UpdateMeetingParams object
+// with the ability to set a context for a request.
+func NewUpdateMeetingParamsWithContext(ctx context.Context) *UpdateMeetingParams {
+	return &UpdateMeetingParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateMeetingParamsWithHTTPClient creates a new UpdateMeetingParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateMeetingParamsWithHTTPClient(client *http.Client) *UpdateMeetingParams {
+	return &UpdateMeetingParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateMeetingParams contains all the parameters to send to the API endpoint
+
+	for the update meeting operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateMeetingParams struct {
+
+	// ID.
+	ID string
+
+	// Meeting.
+	Meeting *models.Meeting
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateMeetingParams) WithDefaults() *UpdateMeetingParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update meeting params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateMeetingParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update meeting params
+func (o *UpdateMeetingParams) WithTimeout(timeout time.Duration) *UpdateMeetingParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update meeting params
+func (o *UpdateMeetingParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update meeting params
+func (o *UpdateMeetingParams) WithContext(ctx context.Context) *UpdateMeetingParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update meeting params
+func (o *UpdateMeetingParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update meeting params
+func (o *UpdateMeetingParams) WithHTTPClient(client *http.Client) *UpdateMeetingParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update meeting params
+func (o *UpdateMeetingParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the update meeting params
+func (o *UpdateMeetingParams) WithID(id string) *UpdateMeetingParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update meeting params
+func (o *UpdateMeetingParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithMeeting adds the meeting to the update meeting params
+func (o *UpdateMeetingParams) WithMeeting(meeting *models.Meeting) *UpdateMeetingParams {
+	o.SetMeeting(meeting)
+	return o
+}
+
+// SetMeeting adds the meeting to the update meeting params
+func (o *UpdateMeetingParams) SetMeeting(meeting *models.Meeting) {
+	o.Meeting = meeting
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateMeetingParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+	if o.Meeting != nil {
+		if err := r.SetBodyParam(o.Meeting); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
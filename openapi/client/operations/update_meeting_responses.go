@@ -0,0 +1,478 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// UpdateMeetingReader is a Reader for the UpdateMeeting structure.
+type UpdateMeetingReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateMeetingReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateMeetingOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewUpdateMeetingForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateMeetingNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewUpdateMeetingConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewUpdateMeetingUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUpdateMeetingDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateMeetingOK creates a UpdateMeetingOK with default headers values
+func NewUpdateMeetingOK() *UpdateMeetingOK {
+	return &UpdateMeetingOK{}
+}
+
+/*
+UpdateMeetingOK describes a response with status code 200, with default header values.
+
+updated meeting
+*/
+type UpdateMeetingOK struct {
+	Payload *models.Meeting
+}
+
+// IsSuccess returns true when this update meeting o k response has a 2xx status code
+func (o *UpdateMeetingOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update meeting o k response has a 3xx status code
+func (o *UpdateMeetingOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update meeting o k response has a 4xx status code
+func (o *UpdateMeetingOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update meeting o k response has a 5xx status code
+func (o *UpdateMeetingOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update meeting o k response a status code equal to that given
+func (o *UpdateMeetingOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update meeting o k response
+func (o *UpdateMeetingOK) Code() int {
+	return 200
+}
+
+func (o *UpdateMeetingOK) Error() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateMeetingOK) String() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateMeetingOK) GetPayload() *models.Meeting {
+	return o.Payload
+}
+
+func (o *UpdateMeetingOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Meeting)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateMeetingForbidden creates a UpdateMeetingForbidden with default headers values
+func NewUpdateMeetingForbidden() *UpdateMeetingForbidden {
+	return &UpdateMeetingForbidden{}
+}
+
+/*
+UpdateMeetingForbidden describes a response with status code 403, with default header values.
+
+the owner doesn't share edit with the caller
+*/
+type UpdateMeetingForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update meeting forbidden response has a 2xx status code
+func (o *UpdateMeetingForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update meeting forbidden response has a 3xx status code
+func (o *UpdateMeetingForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update meeting forbidden response has a 4xx status code
+func (o *UpdateMeetingForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update meeting forbidden response has a 5xx status code
+func (o *UpdateMeetingForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update meeting forbidden response a status code equal to that given
+func (o *UpdateMeetingForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the update meeting forbidden response
+func (o *UpdateMeetingForbidden) Code() int {
+	return 403
+}
+
+func (o *UpdateMeetingForbidden) Error() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UpdateMeetingForbidden) String() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UpdateMeetingForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateMeetingForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateMeetingNotFound creates a UpdateMeetingNotFound with default headers values
+func NewUpdateMeetingNotFound() *UpdateMeetingNotFound {
+	return &UpdateMeetingNotFound{}
+}
+
+/*
+UpdateMeetingNotFound describes a response with status code 404, with default header values.
+
+no such meeting
+*/
+type UpdateMeetingNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update meeting not found response has a 2xx status code
+func (o *UpdateMeetingNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update meeting not found response has a 3xx status code
+func (o *UpdateMeetingNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update meeting not found response has a 4xx status code
+func (o *UpdateMeetingNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update meeting not found response has a 5xx status code
+func (o *UpdateMeetingNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update meeting not found response a status code equal to that given
+func (o *UpdateMeetingNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update meeting not found response
+func (o *UpdateMeetingNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateMeetingNotFound) Error() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateMeetingNotFound) String() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateMeetingNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateMeetingNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateMeetingConflict creates a UpdateMeetingConflict with default headers values
+func NewUpdateMeetingConflict() *UpdateMeetingConflict {
+	return &UpdateMeetingConflict{}
+}
+
+/*
+UpdateMeetingConflict describes a response with status code 409, with default header values.
+
+the meeting was changed meanwhile
+*/
+type UpdateMeetingConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update meeting conflict response has a 2xx status code
+func (o *UpdateMeetingConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update meeting conflict response has a 3xx status code
+func (o *UpdateMeetingConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update meeting conflict response has a 4xx status code
+func (o *UpdateMeetingConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update meeting conflict response has a 5xx status code
+func (o *UpdateMeetingConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update meeting conflict response a status code equal to that given
+func (o *UpdateMeetingConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the update meeting conflict response
+func (o *UpdateMeetingConflict) Code() int {
+	return 409
+}
+
+func (o *UpdateMeetingConflict) Error() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingConflict  %+v", 409, o.Payload)
+}
+
+func (o *UpdateMeetingConflict) String() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingConflict  %+v", 409, o.Payload)
+}
+
+func (o *UpdateMeetingConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateMeetingConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateMeetingUnprocessableEntity creates a UpdateMeetingUnprocessableEntity with default headers values
+func NewUpdateMeetingUnprocessableEntity() *UpdateMeetingUnprocessableEntity {
+	return &UpdateMeetingUnprocessableEntity{}
+}
+
+/*
+UpdateMeetingUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid meeting
+*/
+type UpdateMeetingUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update meeting unprocessable entity response has a 2xx status code
+func (o *UpdateMeetingUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update meeting unprocessable entity response has a 3xx status code
+func (o *UpdateMeetingUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update meeting unprocessable entity response has a 4xx status code
+func (o *UpdateMeetingUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update meeting unprocessable entity response has a 5xx status code
+func (o *UpdateMeetingUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update meeting unprocessable entity response a status code equal to that given
+func (o *UpdateMeetingUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the update meeting unprocessable entity response
+func (o *UpdateMeetingUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *UpdateMeetingUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateMeetingUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeetingUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateMeetingUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateMeetingUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateMeetingDefault creates a UpdateMeetingDefault with default headers values
+func NewUpdateMeetingDefault(code int) *UpdateMeetingDefault {
+	return &UpdateMeetingDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateMeetingDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type UpdateMeetingDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update meeting default response has a 2xx status code
+func (o *UpdateMeetingDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this update meeting default response has a 3xx status code
+func (o *UpdateMeetingDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this update meeting default response has a 4xx status code
+func (o *UpdateMeetingDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this update meeting default response has a 5xx status code
+func (o *UpdateMeetingDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this update meeting default response a status code equal to that given
+func (o *UpdateMeetingDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the update meeting default response
+func (o *UpdateMeetingDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateMeetingDefault) Error() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateMeetingDefault) String() string {
+	return fmt.Sprintf("[PUT /api/meetings/{id}][%d] updateMeeting default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateMeetingDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateMeetingDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// UpdateUserReader is a Reader for the UpdateUser structure.
+type UpdateUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewUpdateUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewUpdateUserUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUpdateUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateUserOK creates a UpdateUserOK with default headers values
+func NewUpdateUserOK() *UpdateUserOK {
+	return &UpdateUserOK{}
+}
+
+/*
+UpdateUserOK describes a response with status code 200, with default header values.
+
+updated user
+*/
+type UpdateUserOK struct {
+	Payload *models.User
+}
+
+// IsSuccess returns true when this update user o k response has a 2xx status code
+func (o *UpdateUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update user o k response has a 3xx status code
+func (o *UpdateUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user o k response has a 4xx status code
+func (o *UpdateUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update user o k response has a 5xx status code
+func (o *UpdateUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user o k response a status code equal to that given
+func (o *UpdateUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update user o k response
+func (o *UpdateUserOK) Code() int {
+	return 200
+}
+
+func (o *UpdateUserOK) Error() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUserOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateUserOK) String() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUserOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateUserOK) GetPayload() *models.User {
+	return o.Payload
+}
+
+func (o *UpdateUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.User)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserNotFound creates a UpdateUserNotFound with default headers values
+func NewUpdateUserNotFound() *UpdateUserNotFound {
+	return &UpdateUserNotFound{}
+}
+
+/*
+UpdateUserNotFound describes a response with status code 404, with default header values.
+
+no such user
+*/
+type UpdateUserNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update user not found response has a 2xx status code
+func (o *UpdateUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user not found response has a 3xx status code
+func (o *UpdateUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user not found response has a 4xx status code
+func (o *UpdateUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user not found response has a 5xx status code
+func (o *UpdateUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user not found response a status code equal to that given
+func (o *UpdateUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update user not found response
+func (o *UpdateUserNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateUserNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUserNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateUserNotFound) String() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUserNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateUserNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserUnprocessableEntity creates a UpdateUserUnprocessableEntity with default headers values
+func NewUpdateUserUnprocessableEntity() *UpdateUserUnprocessableEntity {
+	return &UpdateUserUnprocessableEntity{}
+}
+
+/*
+UpdateUserUnprocessableEntity describes a response with status code 422, with default header values.
+
+invalid profile
+*/
+type UpdateUserUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update user unprocessable entity response has a 2xx status code
+func (o *UpdateUserUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user unprocessable entity response has a 3xx status code
+func (o *UpdateUserUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user unprocessable entity response has a 4xx status code
+func (o *UpdateUserUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user unprocessable entity response has a 5xx status code
+func (o *UpdateUserUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user unprocessable entity response a status code equal to that given
+func (o *UpdateUserUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the update user unprocessable entity response
+func (o *UpdateUserUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *UpdateUserUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateUserUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUserUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UpdateUserUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateUserUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserDefault creates a UpdateUserDefault with default headers values
+func NewUpdateUserDefault(code int) *UpdateUserDefault {
+	return &UpdateUserDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateUserDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type UpdateUserDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this update user default response has a 2xx status code
+func (o *UpdateUserDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this update user default response has a 3xx status code
+func (o *UpdateUserDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this update user default response has a 4xx status code
+func (o *UpdateUserDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this update user default response has a 5xx status code
+func (o *UpdateUserDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this update user default response a status code equal to that given
+func (o *UpdateUserDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the update user default response
+func (o *UpdateUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateUserDefault) Error() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUser default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateUserDefault) String() string {
+	return fmt.Sprintf("[PATCH /api/users/{login}][%d] updateUser default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateUserDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUploadAttachmentParams creates a new UploadAttachmentParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUploadAttachmentParams() *UploadAttachmentParams {
+	return &UploadAttachmentParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUploadAttachmentParamsWithTimeout creates a new UploadAttachmentParams object
+// with the ability to set a timeout on a request.
+func NewUploadAttachmentParamsWithTimeout(timeout time.Duration) *UploadAttachmentParams {
+	return &UploadAttachmentParams{
+		timeout: timeout,
+	}
+}
+
+// NewUploadAttachmentParamsWithContext creates a new UploadAttachmentParams object
+// with the ability to set a context for a request.
+func NewUploadAttachmentParamsWithContext(ctx context.Context) *UploadAttachmentParams {
+	return &UploadAttachmentParams{
+		Context: ctx,
+	}
+}
+
+// NewUploadAttachmentParamsWithHTTPClient creates a new UploadAttachmentParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUploadAttachmentParamsWithHTTPClient(client *http.Client) *UploadAttachmentParams {
+	return &UploadAttachmentParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UploadAttachmentParams contains all the parameters to send to the API endpoint
+
+	for the upload attachment operation.
+
+	Typically these are written to a http.Request.
+*/
+type UploadAttachmentParams struct {
+
+	// File.
+	File string
+
+	// ID.
+	ID string
+
+	/* Name.
+
+	   file name of a body that is not a form
+	*/
+	Name *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the upload attachment params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UploadAttachmentParams) WithDefaults() *UploadAttachmentParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the upload attachment params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UploadAttachmentParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the upload attachment params
+func (o *UploadAttachmentParams) WithTimeout(timeout time.Duration) *UploadAttachmentParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the upload attachment params
+func (o *UploadAttachmentParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the upload attachment params
+func (o *UploadAttachmentParams) WithContext(ctx context.Context) *UploadAttachmentParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the upload attachment params
+func (o *UploadAttachmentParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the upload attachment params
+func (o *UploadAttachmentParams) WithHTTPClient(client *http.Client) *UploadAttachmentParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the upload attachment params
+func (o *UploadAttachmentParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFile adds the file to the upload attachment params
+func (o *UploadAttachmentParams) WithFile(file string) *UploadAttachmentParams {
+	o.SetFile(file)
+	return o
+}
+
+// SetFile adds the file to the upload attachment params
+func (o *UploadAttachmentParams) SetFile(file string) {
+	o.File = file
+}
+
+// WithID adds the id to the upload attachment params
+func (o *UploadAttachmentParams) WithID(id string) *UploadAttachmentParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the upload attachment params
+func (o *UploadAttachmentParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithName adds the name to the upload attachment params
+func (o *UploadAttachmentParams) WithName(name *string) *UploadAttachmentParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the upload attachment params
+func (o *UploadAttachmentParams) SetName(name *string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UploadAttachmentParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.File); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Name != nil {
+
+		// query param name
+		var qrName string
+
+		if o.Name != nil {
+			qrName = *o.Name
+		}
+		qName := qrName
+		if qName != "" {
+
+			if err := r.SetQueryParam("name", qName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
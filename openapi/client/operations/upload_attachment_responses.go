@@ -0,0 +1,478 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/vladem/calendar/openapi/models"
+)
+
+// UploadAttachmentReader is a Reader for the UploadAttachment structure.
+type UploadAttachmentReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UploadAttachmentReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUploadAttachmentOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewUploadAttachmentForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUploadAttachmentNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 413:
+		result := NewUploadAttachmentRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewUploadAttachmentUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUploadAttachmentDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUploadAttachmentOK creates a UploadAttachmentOK with default headers values
+func NewUploadAttachmentOK() *UploadAttachmentOK {
+	return &UploadAttachmentOK{}
+}
+
+/*
+UploadAttachmentOK describes a response with status code 200, with default header values.
+
+the attachment added to the meeting
+*/
+type UploadAttachmentOK struct {
+	Payload *models.Attachment
+}
+
+// IsSuccess returns true when this upload attachment o k response has a 2xx status code
+func (o *UploadAttachmentOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this upload attachment o k response has a 3xx status code
+func (o *UploadAttachmentOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upload attachment o k response has a 4xx status code
+func (o *UploadAttachmentOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this upload attachment o k response has a 5xx status code
+func (o *UploadAttachmentOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upload attachment o k response a status code equal to that given
+func (o *UploadAttachmentOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the upload attachment o k response
+func (o *UploadAttachmentOK) Code() int {
+	return 200
+}
+
+func (o *UploadAttachmentOK) Error() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentOK  %+v", 200, o.Payload)
+}
+
+func (o *UploadAttachmentOK) String() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentOK  %+v", 200, o.Payload)
+}
+
+func (o *UploadAttachmentOK) GetPayload() *models.Attachment {
+	return o.Payload
+}
+
+func (o *UploadAttachmentOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Attachment)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUploadAttachmentForbidden creates a UploadAttachmentForbidden with default headers values
+func NewUploadAttachmentForbidden() *UploadAttachmentForbidden {
+	return &UploadAttachmentForbidden{}
+}
+
+/*
+UploadAttachmentForbidden describes a response with status code 403, with default header values.
+
+the owner doesn't share edit with the caller
+*/
+type UploadAttachmentForbidden struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this upload attachment forbidden response has a 2xx status code
+func (o *UploadAttachmentForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upload attachment forbidden response has a 3xx status code
+func (o *UploadAttachmentForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upload attachment forbidden response has a 4xx status code
+func (o *UploadAttachmentForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this upload attachment forbidden response has a 5xx status code
+func (o *UploadAttachmentForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upload attachment forbidden response a status code equal to that given
+func (o *UploadAttachmentForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the upload attachment forbidden response
+func (o *UploadAttachmentForbidden) Code() int {
+	return 403
+}
+
+func (o *UploadAttachmentForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UploadAttachmentForbidden) String() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UploadAttachmentForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadAttachmentForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUploadAttachmentNotFound creates a UploadAttachmentNotFound with default headers values
+func NewUploadAttachmentNotFound() *UploadAttachmentNotFound {
+	return &UploadAttachmentNotFound{}
+}
+
+/*
+UploadAttachmentNotFound describes a response with status code 404, with default header values.
+
+no such meeting
+*/
+type UploadAttachmentNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this upload attachment not found response has a 2xx status code
+func (o *UploadAttachmentNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upload attachment not found response has a 3xx status code
+func (o *UploadAttachmentNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upload attachment not found response has a 4xx status code
+func (o *UploadAttachmentNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this upload attachment not found response has a 5xx status code
+func (o *UploadAttachmentNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upload attachment not found response a status code equal to that given
+func (o *UploadAttachmentNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the upload attachment not found response
+func (o *UploadAttachmentNotFound) Code() int {
+	return 404
+}
+
+func (o *UploadAttachmentNotFound) Error() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UploadAttachmentNotFound) String() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UploadAttachmentNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadAttachmentNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUploadAttachmentRequestEntityTooLarge creates a UploadAttachmentRequestEntityTooLarge with default headers values
+func NewUploadAttachmentRequestEntityTooLarge() *UploadAttachmentRequestEntityTooLarge {
+	return &UploadAttachmentRequestEntityTooLarge{}
+}
+
+/*
+UploadAttachmentRequestEntityTooLarge describes a response with status code 413, with default header values.
+
+the file is too large
+*/
+type UploadAttachmentRequestEntityTooLarge struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this upload attachment request entity too large response has a 2xx status code
+func (o *UploadAttachmentRequestEntityTooLarge) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upload attachment request entity too large response has a 3xx status code
+func (o *UploadAttachmentRequestEntityTooLarge) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upload attachment request entity too large response has a 4xx status code
+func (o *UploadAttachmentRequestEntityTooLarge) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this upload attachment request entity too large response has a 5xx status code
+func (o *UploadAttachmentRequestEntityTooLarge) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upload attachment request entity too large response a status code equal to that given
+func (o *UploadAttachmentRequestEntityTooLarge) IsCode(code int) bool {
+	return code == 413
+}
+
+// Code gets the status code for the upload attachment request entity too large response
+func (o *UploadAttachmentRequestEntityTooLarge) Code() int {
+	return 413
+}
+
+func (o *UploadAttachmentRequestEntityTooLarge) Error() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentRequestEntityTooLarge  %+v", 413, o.Payload)
+}
+
+func (o *UploadAttachmentRequestEntityTooLarge) String() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentRequestEntityTooLarge  %+v", 413, o.Payload)
+}
+
+func (o *UploadAttachmentRequestEntityTooLarge) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadAttachmentRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUploadAttachmentUnprocessableEntity creates a UploadAttachmentUnprocessableEntity with default headers values
+func NewUploadAttachmentUnprocessableEntity() *UploadAttachmentUnprocessableEntity {
+	return &UploadAttachmentUnprocessableEntity{}
+}
+
+/*
+UploadAttachmentUnprocessableEntity describes a response with status code 422, with default header values.
+
+no file name, or the meeting has 20 attachments
+*/
+type UploadAttachmentUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this upload attachment unprocessable entity response has a 2xx status code
+func (o *UploadAttachmentUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upload attachment unprocessable entity response has a 3xx status code
+func (o *UploadAttachmentUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upload attachment unprocessable entity response has a 4xx status code
+func (o *UploadAttachmentUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this upload attachment unprocessable entity response has a 5xx status code
+func (o *UploadAttachmentUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upload attachment unprocessable entity response a status code equal to that given
+func (o *UploadAttachmentUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the upload attachment unprocessable entity response
+func (o *UploadAttachmentUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *UploadAttachmentUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UploadAttachmentUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachmentUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *UploadAttachmentUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadAttachmentUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUploadAttachmentDefault creates a UploadAttachmentDefault with default headers values
+func NewUploadAttachmentDefault(code int) *UploadAttachmentDefault {
+	return &UploadAttachmentDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UploadAttachmentDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type UploadAttachmentDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this upload attachment default response has a 2xx status code
+func (o *UploadAttachmentDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this upload attachment default response has a 3xx status code
+func (o *UploadAttachmentDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this upload attachment default response has a 4xx status code
+func (o *UploadAttachmentDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this upload attachment default response has a 5xx status code
+func (o *UploadAttachmentDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this upload attachment default response a status code equal to that given
+func (o *UploadAttachmentDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the upload attachment default response
+func (o *UploadAttachmentDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UploadAttachmentDefault) Error() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachment default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UploadAttachmentDefault) String() string {
+	return fmt.Sprintf("[POST /api/meetings/{id}/attachments][%d] uploadAttachment default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UploadAttachmentDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadAttachmentDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
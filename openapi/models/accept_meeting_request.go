@@ -0,0 +1,97 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AcceptMeetingRequest accept meeting request
+//
+// swagger:model AcceptMeetingRequest
+type AcceptMeetingRequest struct {
+
+	// calendar of the invitee to keep the meeting in, default for the default calendar. It stays in its calendar when empty
+	CalendarID string `json:"calendarId,omitempty"`
+
+	// decline
+	Decline bool `json:"decline,omitempty"`
+
+	// the authenticated user, unless the declared invitee shares respond with them
+	// Min Length: 1
+	Login string `json:"login,omitempty"`
+
+	// meeting Id
+	// Required: true
+	MeetingID *string `json:"meetingId"`
+}
+
+// Validate validates this accept meeting request
+func (m *AcceptMeetingRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLogin(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMeetingID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AcceptMeetingRequest) validateLogin(formats strfmt.Registry) error {
+	if swag.IsZero(m.Login) { // not required
+		return nil
+	}
+
+	if err := validate.MinLength("login", "body", m.Login, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptMeetingRequest) validateMeetingID(formats strfmt.Registry) error {
+
+	if err := validate.Required("meetingId", "body", m.MeetingID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this accept meeting request based on context it is used
+func (m *AcceptMeetingRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AcceptMeetingRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AcceptMeetingRequest) UnmarshalBinary(b []byte) error {
+	var res AcceptMeetingRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
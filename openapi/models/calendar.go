@@ -0,0 +1,189 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Calendar calendar
+//
+// swagger:model Calendar
+type Calendar struct {
+
+	// color
+	// Pattern: ^#[0-9a-fA-F]{6}$
+	Color string `json:"color,omitempty"`
+
+	// default for the default calendar every user has
+	// Read Only: true
+	ID string `json:"id,omitempty"`
+
+	// name
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// owner
+	// Read Only: true
+	Owner string `json:"owner,omitempty"`
+
+	// of the meetings in the calendar without a visibility
+	// Enum: [public private confidential]
+	Visibility string `json:"visibility,omitempty"`
+}
+
+// Validate validates this calendar
+func (m *Calendar) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateColor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVisibility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Calendar) validateColor(formats strfmt.Registry) error {
+	if swag.IsZero(m.Color) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("color", "body", m.Color, `^#[0-9a-fA-F]{6}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Calendar) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var calendarTypeVisibilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["public","private","confidential"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		calendarTypeVisibilityPropEnum = append(calendarTypeVisibilityPropEnum, v)
+	}
+}
+
+const (
+
+	// CalendarVisibilityPublic captures enum value "public"
+	CalendarVisibilityPublic string = "public"
+
+	// CalendarVisibilityPrivate captures enum value "private"
+	CalendarVisibilityPrivate string = "private"
+
+	// CalendarVisibilityConfidential captures enum value "confidential"
+	CalendarVisibilityConfidential string = "confidential"
+)
+
+// prop value enum
+func (m *Calendar) validateVisibilityEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, calendarTypeVisibilityPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Calendar) validateVisibility(formats strfmt.Registry) error {
+	if swag.IsZero(m.Visibility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateVisibilityEnum("visibility", "body", m.Visibility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this calendar based on the context it is used
+func (m *Calendar) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOwner(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Calendar) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", string(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Calendar) contextValidateOwner(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "owner", "body", string(m.Owner)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Calendar) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Calendar) UnmarshalBinary(b []byte) error {
+	var res Calendar
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
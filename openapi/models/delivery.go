@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Delivery delivery
+//
+// swagger:model Delivery
+type Delivery struct {
+
+	// attempts
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
+
+	// event
+	Event *Event `json:"event,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// last error
+	LastError string `json:"lastError,omitempty"`
+
+	// next attempt
+	// Format: date-time
+	NextAttempt strfmt.DateTime `json:"nextAttempt,omitempty"`
+
+	// response status
+	ResponseStatus int64 `json:"responseStatus,omitempty"`
+
+	// status
+	// Enum: [pending succeeded failed]
+	Status string `json:"status,omitempty"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
+
+	// webhook Id
+	WebhookID string `json:"webhookId,omitempty"`
+}
+
+// Validate validates this delivery
+func (m *Delivery) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEvent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextAttempt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Delivery) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Delivery) validateEvent(formats strfmt.Registry) error {
+	if swag.IsZero(m.Event) { // not required
+		return nil
+	}
+
+	if m.Event != nil {
+		if err := m.Event.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("event")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("event")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Delivery) validateNextAttempt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextAttempt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("nextAttempt", "body", "date-time", m.NextAttempt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var deliveryTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","succeeded","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		deliveryTypeStatusPropEnum = append(deliveryTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// DeliveryStatusPending captures enum value "pending"
+	DeliveryStatusPending string = "pending"
+
+	// DeliveryStatusSucceeded captures enum value "succeeded"
+	DeliveryStatusSucceeded string = "succeeded"
+
+	// DeliveryStatusFailed captures enum value "failed"
+	DeliveryStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *Delivery) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, deliveryTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Delivery) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Delivery) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updatedAt", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this delivery based on the context it is used
+func (m *Delivery) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEvent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Delivery) contextValidateEvent(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Event != nil {
+		if err := m.Event.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("event")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("event")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Delivery) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Delivery) UnmarshalBinary(b []byte) error {
+	var res Delivery
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// DialIn dial in
+//
+// swagger:model DialIn
+type DialIn struct {
+
+	// number
+	Number string `json:"number,omitempty"`
+
+	// entered after dialing the number
+	Pin string `json:"pin,omitempty"`
+}
+
+// Validate validates this dial in
+func (m *DialIn) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this dial in based on context it is used
+func (m *DialIn) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DialIn) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DialIn) UnmarshalBinary(b []byte) error {
+	var res DialIn
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Error error
+//
+// swagger:model Error
+type Error struct {
+
+	// code
+	// Required: true
+	// Enum: [bad_request unauthorized forbidden not_found method_not_allowed conflict precondition_failed validation_failed too_large sync_token_expired internal]
+	Code *string `json:"code"`
+
+	// details
+	Details []*ErrorDetail `json:"details"`
+
+	// message
+	// Required: true
+	Message *string `json:"message"`
+
+	// request Id
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// Validate validates this error
+func (m *Error) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDetails(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var errorTypeCodePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["bad_request","unauthorized","forbidden","not_found","method_not_allowed","conflict","precondition_failed","validation_failed","too_large","sync_token_expired","internal"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		errorTypeCodePropEnum = append(errorTypeCodePropEnum, v)
+	}
+}
+
+const (
+
+	// ErrorCodeBadRequest captures enum value "bad_request"
+	ErrorCodeBadRequest string = "bad_request"
+
+	// ErrorCodeUnauthorized captures enum value "unauthorized"
+	ErrorCodeUnauthorized string = "unauthorized"
+
+	// ErrorCodeForbidden captures enum value "forbidden"
+	ErrorCodeForbidden string = "forbidden"
+
+	// ErrorCodeNotFound captures enum value "not_found"
+	ErrorCodeNotFound string = "not_found"
+
+	// ErrorCodeMethodNotAllowed captures enum value "method_not_allowed"
+	ErrorCodeMethodNotAllowed string = "method_not_allowed"
+
+	// ErrorCodeConflict captures enum value "conflict"
+	ErrorCodeConflict string = "conflict"
+
+	// ErrorCodePreconditionFailed captures enum value "precondition_failed"
+	ErrorCodePreconditionFailed string = "precondition_failed"
+
+	// ErrorCodeValidationFailed captures enum value "validation_failed"
+	ErrorCodeValidationFailed string = "validation_failed"
+
+	// ErrorCodeTooLarge captures enum value "too_large"
+	ErrorCodeTooLarge string = "too_large"
+
+	// ErrorCodeSyncTokenExpired captures enum value "sync_token_expired"
+	ErrorCodeSyncTokenExpired string = "sync_token_expired"
+
+	// ErrorCodeInternal captures enum value "internal"
+	ErrorCodeInternal string = "internal"
+)
+
+// prop value enum
+func (m *Error) validateCodeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, errorTypeCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Error) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateCodeEnum("code", "body", *m.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Error) validateDetails(formats strfmt.Registry) error {
+	if swag.IsZero(m.Details) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Details); i++ {
+		if swag.IsZero(m.Details[i]) { // not required
+			continue
+		}
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Error) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("message", "body", m.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this error based on the context it is used
+func (m *Error) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDetails(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Error) contextValidateDetails(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Details); i++ {
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Error) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Error) UnmarshalBinary(b []byte) error {
+	var res Error
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
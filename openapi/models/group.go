@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Group group
+//
+// swagger:model Group
+type Group struct {
+
+	// names of nested groups, their members are members too
+	Groups []string `json:"groups,omitempty"`
+
+	// id
+	// Read Only: true
+	ID string `json:"id,omitempty"`
+
+	// members
+	Members []string `json:"members,omitempty"`
+
+	// invited as team:NAME
+	// Required: true
+	// Pattern: ^[a-zA-Z0-9._-]+$
+	Name *string `json:"name"`
+
+	// the authenticated user, who manages the group
+	Owner string `json:"owner,omitempty"`
+}
+
+// Validate validates this group
+func (m *Group) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Group) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("name", "body", *m.Name, `^[a-zA-Z0-9._-]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this group based on the context it is used
+func (m *Group) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Group) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", string(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Group) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Group) UnmarshalBinary(b []byte) error {
+	var res Group
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
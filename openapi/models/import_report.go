@@ -0,0 +1,231 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ImportReport import report
+//
+// swagger:model ImportReport
+type ImportReport struct {
+
+	// created
+	Created int64 `json:"created,omitempty"`
+
+	// events
+	Events []*ImportReportEventsItems0 `json:"events"`
+
+	// skipped
+	Skipped int64 `json:"skipped,omitempty"`
+
+	// updated
+	Updated int64 `json:"updated,omitempty"`
+}
+
+// Validate validates this import report
+func (m *ImportReport) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportReport) validateEvents(formats strfmt.Registry) error {
+	if swag.IsZero(m.Events) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Events); i++ {
+		if swag.IsZero(m.Events[i]) { // not required
+			continue
+		}
+
+		if m.Events[i] != nil {
+			if err := m.Events[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("events" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("events" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this import report based on the context it is used
+func (m *ImportReport) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEvents(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportReport) contextValidateEvents(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Events); i++ {
+
+		if m.Events[i] != nil {
+			if err := m.Events[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("events" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("events" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportReport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportReport) UnmarshalBinary(b []byte) error {
+	var res ImportReport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// ImportReportEventsItems0 import report events items0
+//
+// swagger:model ImportReportEventsItems0
+type ImportReportEventsItems0 struct {
+
+	// meeting Id
+	MeetingID string `json:"meetingId,omitempty"`
+
+	// reason
+	Reason string `json:"reason,omitempty"`
+
+	// status
+	// Enum: [created updated skipped]
+	Status string `json:"status,omitempty"`
+
+	// uid
+	UID string `json:"uid,omitempty"`
+
+	// warnings
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this import report events items0
+func (m *ImportReportEventsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var importReportEventsItems0TypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["created","updated","skipped"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		importReportEventsItems0TypeStatusPropEnum = append(importReportEventsItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ImportReportEventsItems0StatusCreated captures enum value "created"
+	ImportReportEventsItems0StatusCreated string = "created"
+
+	// ImportReportEventsItems0StatusUpdated captures enum value "updated"
+	ImportReportEventsItems0StatusUpdated string = "updated"
+
+	// ImportReportEventsItems0StatusSkipped captures enum value "skipped"
+	ImportReportEventsItems0StatusSkipped string = "skipped"
+)
+
+// prop value enum
+func (m *ImportReportEventsItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, importReportEventsItems0TypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ImportReportEventsItems0) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this import report events items0 based on context it is used
+func (m *ImportReportEventsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportReportEventsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportReportEventsItems0) UnmarshalBinary(b []byte) error {
+	var res ImportReportEventsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
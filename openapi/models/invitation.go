@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Invitation invitation
+//
+// swagger:model Invitation
+type Invitation struct {
+
+	// 0 - not reviewed, 1 - accepted, 2 - declined
+	// Enum: [0 1 2]
+	Accepted int64 `json:"accepted,omitempty"`
+
+	// calendar of the invitee the meeting is in, the default calendar when empty, see acceptMeeting
+	// Read Only: true
+	CalendarID string `json:"calendarId,omitempty"`
+
+	// group of the meeting the invitee is invited as a member of
+	// Read Only: true
+	Group string `json:"group,omitempty"`
+
+	// login, or team:NAME for the current members of a group. Neither the owner nor anyone twice
+	// Required: true
+	// Min Length: 1
+	Invitee *string `json:"invitee"`
+
+	// who answered for the invitee
+	// Read Only: true
+	RespondedBy string `json:"respondedBy,omitempty"`
+}
+
+// Validate validates this invitation
+func (m *Invitation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAccepted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInvitee(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var invitationTypeAcceptedPropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[0,1,2]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		invitationTypeAcceptedPropEnum = append(invitationTypeAcceptedPropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *Invitation) validateAcceptedEnum(path, location string, value int64) error {
+	if err := validate.EnumCase(path, location, value, invitationTypeAcceptedPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Invitation) validateAccepted(formats strfmt.Registry) error {
+	if swag.IsZero(m.Accepted) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAcceptedEnum("accepted", "body", m.Accepted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invitation) validateInvitee(formats strfmt.Registry) error {
+
+	if err := validate.Required("invitee", "body", m.Invitee); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("invitee", "body", *m.Invitee, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this invitation based on the context it is used
+func (m *Invitation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCalendarID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGroup(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRespondedBy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Invitation) contextValidateCalendarID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "calendarId", "body", string(m.CalendarID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invitation) contextValidateGroup(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "group", "body", string(m.Group)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Invitation) contextValidateRespondedBy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "respondedBy", "body", string(m.RespondedBy)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Invitation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Invitation) UnmarshalBinary(b []byte) error {
+	var res Invitation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,669 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Meeting meeting
+//
+// swagger:model Meeting
+type Meeting struct {
+
+	// at most 20
+	Attachments []*Attachment `json:"attachments,omitempty"`
+
+	// only the time is shown, the caller doesn't take part in the meeting
+	// Read Only: true
+	Busy *bool `json:"busy,omitempty"`
+
+	// calendar of the owner the meeting is in, the default calendar when empty
+	CalendarID string `json:"calendarId,omitempty"`
+
+	// http(s) url of the video call, at most 2048 characters. Created by the conference provider of the deployment, if any, when empty, and kept by updates leaving it out
+	ConferenceURL string `json:"conferenceUrl,omitempty"`
+
+	// deleted
+	// Read Only: true
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// what the meeting is about, required without a title, at most 10000 characters
+	Description string `json:"description,omitempty"`
+
+	// phone numbers of calls created by the conference provider
+	// Read Only: true
+	DialIns []*DialIn `json:"dialIns,omitempty"`
+
+	// end time
+	// Required: true
+	// Format: date-time
+	EndTime *strfmt.DateTime `json:"endTime"`
+
+	// starts of cancelled occurrences
+	ExDates []strfmt.DateTime `json:"exDates,omitempty"`
+
+	// names of groups whose members are invited while they are members
+	Groups []string `json:"groups,omitempty"`
+
+	// id
+	// Read Only: true
+	ID string `json:"id,omitempty"`
+
+	// invited
+	Invited []*Invitation `json:"invited,omitempty"`
+
+	// where the meeting takes place, at most 500 characters
+	Location string `json:"location,omitempty"`
+
+	// the authenticated user, unless the declared owner shares edit with them
+	// Min Length: 1
+	Owner string `json:"owner,omitempty"`
+
+	// apply to every participant instead of their defaults
+	Reminders []*Reminder `json:"reminders,omitempty"`
+
+	// no occurrences start after this time
+	// Format: date-time
+	ReoccurUntil strfmt.DateTime `json:"reoccurUntil,omitempty"`
+
+	// 0 - none, 1 - daily, 2 - working days, 3 - weekly, 4 - monthly, 5 - yearly
+	// Enum: [0 1 2 3 4 5]
+	Reoccurance int64 `json:"reoccurance,omitempty"`
+
+	// revision, incremented by every update
+	// Read Only: true
+	Sequence int64 `json:"sequence,omitempty"`
+
+	// start time
+	// Required: true
+	// Format: date-time
+	StartTime *strfmt.DateTime `json:"startTime"`
+
+	// IANA time zone, e.g. Europe/Berlin, recurring meetings keep their local time in it when daylight saving time begins or ends. UTC when empty
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// at most 200 characters, the first line of the description when empty
+	Title string `json:"title,omitempty"`
+
+	// iCalendar UID of imported meetings
+	// Read Only: true
+	UID string `json:"uid,omitempty"`
+
+	// updated at
+	// Read Only: true
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
+
+	// who made the last change, the owner or an editor
+	// Read Only: true
+	UpdatedBy string `json:"updatedBy,omitempty"`
+
+	// increases with every change of any meeting
+	// Read Only: true
+	Version int64 `json:"version,omitempty"`
+
+	// who sees the details: every user, participants and users they share details with (the default) or participants only. The visibility of the calendar when empty
+	// Enum: [public private confidential]
+	Visibility string `json:"visibility,omitempty"`
+}
+
+// Validate validates this meeting
+func (m *Meeting) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttachments(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDialIns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEndTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExDates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInvited(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOwner(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReminders(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReoccurUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReoccurance(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVisibility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Meeting) validateAttachments(formats strfmt.Registry) error {
+	if swag.IsZero(m.Attachments) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Attachments); i++ {
+		if swag.IsZero(m.Attachments[i]) { // not required
+			continue
+		}
+
+		if m.Attachments[i] != nil {
+			if err := m.Attachments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("attachments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("attachments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateDialIns(formats strfmt.Registry) error {
+	if swag.IsZero(m.DialIns) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DialIns); i++ {
+		if swag.IsZero(m.DialIns[i]) { // not required
+			continue
+		}
+
+		if m.DialIns[i] != nil {
+			if err := m.DialIns[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dialIns" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("dialIns" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateEndTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("endTime", "body", m.EndTime); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("endTime", "body", "date-time", m.EndTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateExDates(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExDates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ExDates); i++ {
+
+		if err := validate.FormatOf("exDates"+"."+strconv.Itoa(i), "body", "date-time", m.ExDates[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateInvited(formats strfmt.Registry) error {
+	if swag.IsZero(m.Invited) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Invited); i++ {
+		if swag.IsZero(m.Invited[i]) { // not required
+			continue
+		}
+
+		if m.Invited[i] != nil {
+			if err := m.Invited[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("invited" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("invited" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateOwner(formats strfmt.Registry) error {
+	if swag.IsZero(m.Owner) { // not required
+		return nil
+	}
+
+	if err := validate.MinLength("owner", "body", m.Owner, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateReminders(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reminders) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Reminders); i++ {
+		if swag.IsZero(m.Reminders[i]) { // not required
+			continue
+		}
+
+		if m.Reminders[i] != nil {
+			if err := m.Reminders[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("reminders" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("reminders" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateReoccurUntil(formats strfmt.Registry) error {
+	if swag.IsZero(m.ReoccurUntil) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("reoccurUntil", "body", "date-time", m.ReoccurUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var meetingTypeReoccurancePropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[0,1,2,3,4,5]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		meetingTypeReoccurancePropEnum = append(meetingTypeReoccurancePropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *Meeting) validateReoccuranceEnum(path, location string, value int64) error {
+	if err := validate.EnumCase(path, location, value, meetingTypeReoccurancePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Meeting) validateReoccurance(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reoccurance) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateReoccuranceEnum("reoccurance", "body", m.Reoccurance); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateStartTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("startTime", "body", m.StartTime); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("startTime", "body", "date-time", m.StartTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updatedAt", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var meetingTypeVisibilityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["public","private","confidential"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		meetingTypeVisibilityPropEnum = append(meetingTypeVisibilityPropEnum, v)
+	}
+}
+
+const (
+
+	// MeetingVisibilityPublic captures enum value "public"
+	MeetingVisibilityPublic string = "public"
+
+	// MeetingVisibilityPrivate captures enum value "private"
+	MeetingVisibilityPrivate string = "private"
+
+	// MeetingVisibilityConfidential captures enum value "confidential"
+	MeetingVisibilityConfidential string = "confidential"
+)
+
+// prop value enum
+func (m *Meeting) validateVisibilityEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, meetingTypeVisibilityPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Meeting) validateVisibility(formats strfmt.Registry) error {
+	if swag.IsZero(m.Visibility) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateVisibilityEnum("visibility", "body", m.Visibility); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this meeting based on the context it is used
+func (m *Meeting) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAttachments(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateBusy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDeleted(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDialIns(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateInvited(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReminders(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSequence(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUpdatedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUpdatedBy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVersion(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Meeting) contextValidateAttachments(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Attachments); i++ {
+
+		if m.Attachments[i] != nil {
+			if err := m.Attachments[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("attachments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("attachments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateBusy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "busy", "body", m.Busy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateDeleted(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "deleted", "body", m.Deleted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateDialIns(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "dialIns", "body", []*DialIn(m.DialIns)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.DialIns); i++ {
+
+		if m.DialIns[i] != nil {
+			if err := m.DialIns[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dialIns" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("dialIns" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", string(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateInvited(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Invited); i++ {
+
+		if m.Invited[i] != nil {
+			if err := m.Invited[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("invited" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("invited" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateReminders(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Reminders); i++ {
+
+		if m.Reminders[i] != nil {
+			if err := m.Reminders[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("reminders" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("reminders" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateSequence(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "sequence", "body", int64(m.Sequence)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateUID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "uid", "body", string(m.UID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateUpdatedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "updatedAt", "body", strfmt.DateTime(m.UpdatedAt)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateUpdatedBy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "updatedBy", "body", string(m.UpdatedBy)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Meeting) contextValidateVersion(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "version", "body", int64(m.Version)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Meeting) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Meeting) UnmarshalBinary(b []byte) error {
+	var res Meeting
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
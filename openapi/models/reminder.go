@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Reminder reminder
+//
+// swagger:model Reminder
+type Reminder struct {
+
+	// log, email or webhook (meeting.reminder events), deployments may add channels
+	// Required: true
+	Channel *string `json:"channel"`
+
+	// before the start of every occurrence, at most 1440
+	// Required: true
+	// Minimum: 0
+	OffsetMinutes *int64 `json:"offsetMinutes"`
+}
+
+// Validate validates this reminder
+func (m *Reminder) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChannel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOffsetMinutes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Reminder) validateChannel(formats strfmt.Registry) error {
+
+	if err := validate.Required("channel", "body", m.Channel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Reminder) validateOffsetMinutes(formats strfmt.Registry) error {
+
+	if err := validate.Required("offsetMinutes", "body", m.OffsetMinutes); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("offsetMinutes", "body", *m.OffsetMinutes, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this reminder based on context it is used
+func (m *Reminder) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Reminder) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Reminder) UnmarshalBinary(b []byte) error {
+	var res Reminder
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
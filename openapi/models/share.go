@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Share share
+//
+// swagger:model Share
+type Share struct {
+
+	// grantee
+	// Required: true
+	// Min Length: 1
+	Grantee *string `json:"grantee"`
+
+	// each level includes the ones before: see when the user is busy, the details of private meetings, create, edit and delete meetings for the user, answer invitations for the user
+	// Required: true
+	// Enum: [freeBusy details edit respond]
+	Level *string `json:"level"`
+}
+
+// Validate validates this share
+func (m *Share) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGrantee(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Share) validateGrantee(formats strfmt.Registry) error {
+
+	if err := validate.Required("grantee", "body", m.Grantee); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("grantee", "body", *m.Grantee, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var shareTypeLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["freeBusy","details","edit","respond"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		shareTypeLevelPropEnum = append(shareTypeLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ShareLevelFreeBusy captures enum value "freeBusy"
+	ShareLevelFreeBusy string = "freeBusy"
+
+	// ShareLevelDetails captures enum value "details"
+	ShareLevelDetails string = "details"
+
+	// ShareLevelEdit captures enum value "edit"
+	ShareLevelEdit string = "edit"
+
+	// ShareLevelRespond captures enum value "respond"
+	ShareLevelRespond string = "respond"
+)
+
+// prop value enum
+func (m *Share) validateLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, shareTypeLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Share) validateLevel(formats strfmt.Registry) error {
+
+	if err := validate.Required("level", "body", m.Level); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateLevelEnum("level", "body", *m.Level); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this share based on context it is used
+func (m *Share) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Share) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Share) UnmarshalBinary(b []byte) error {
+	var res Share
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,261 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// User user
+//
+// swagger:model User
+type User struct {
+
+	// display name
+	// Max Length: 100
+	DisplayName string `json:"displayName,omitempty"`
+
+	// invitations are mailed here, defaults to login@CALENDAR_MAIL_DOMAIN. Only shown to the user
+	Email string `json:"email,omitempty"`
+
+	// id
+	// Read Only: true
+	ID string `json:"id,omitempty"`
+
+	// at most 64 letters, digits, '.', '_' and '-', starting with a letter or digit
+	// Required: true
+	// Min Length: 1
+	Login *string `json:"login"`
+
+	// defaults for meetings without reminders
+	Reminders []*Reminder `json:"reminders,omitempty"`
+
+	// users the calendar is shared with, see setShares
+	// Read Only: true
+	Shares []*Share `json:"shares,omitempty"`
+
+	// IANA time zone, e.g. Europe/Berlin, of the times in mails, UTC by default
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// first api token, returned on sign up
+	// Read Only: true
+	Token string `json:"token,omitempty"`
+}
+
+// Validate validates this user
+func (m *User) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDisplayName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLogin(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReminders(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShares(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *User) validateDisplayName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DisplayName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("displayName", "body", m.DisplayName, 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) validateLogin(formats strfmt.Registry) error {
+
+	if err := validate.Required("login", "body", m.Login); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("login", "body", *m.Login, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) validateReminders(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reminders) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Reminders); i++ {
+		if swag.IsZero(m.Reminders[i]) { // not required
+			continue
+		}
+
+		if m.Reminders[i] != nil {
+			if err := m.Reminders[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("reminders" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("reminders" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *User) validateShares(formats strfmt.Registry) error {
+	if swag.IsZero(m.Shares) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Shares); i++ {
+		if swag.IsZero(m.Shares[i]) { // not required
+			continue
+		}
+
+		if m.Shares[i] != nil {
+			if err := m.Shares[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shares" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shares" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this user based on the context it is used
+func (m *User) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReminders(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateShares(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateToken(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *User) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", string(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) contextValidateReminders(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Reminders); i++ {
+
+		if m.Reminders[i] != nil {
+			if err := m.Reminders[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("reminders" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("reminders" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *User) contextValidateShares(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "shares", "body", []*Share(m.Shares)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Shares); i++ {
+
+		if m.Shares[i] != nil {
+			if err := m.Shares[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shares" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shares" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *User) contextValidateToken(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "token", "body", string(m.Token)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *User) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *User) UnmarshalBinary(b []byte) error {
+	var res User
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// UserUpdate user update
+//
+// swagger:model UserUpdate
+type UserUpdate struct {
+
+	// display name
+	// Max Length: 100
+	DisplayName string `json:"displayName,omitempty"`
+
+	// email
+	Email string `json:"email,omitempty"`
+
+	// time zone
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// Validate validates this user update
+func (m *UserUpdate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDisplayName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UserUpdate) validateDisplayName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DisplayName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("displayName", "body", m.DisplayName, 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this user update based on context it is used
+func (m *UserUpdate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UserUpdate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UserUpdate) UnmarshalBinary(b []byte) error {
+	var res UserUpdate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
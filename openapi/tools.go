@@ -0,0 +1,9 @@
+//go:build tools
+
+// Package openapi holds the client generated from service/openapi.json by
+// go generate ./service, do not edit client and models by hand.
+package openapi
+
+import (
+	_ "github.com/go-swagger/go-swagger/cmd/swagger"
+)
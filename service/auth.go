@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Requests authenticate with a bearer token: a personal api token, a session
+// token (a JWT signed with SessionSecret) or the AdminToken. Users act as
+// themselves, whatever owner or login a request declares, the admin acts as
+// declared. Api tokens are only stored hashed, they are random enough for
+// sha256 to do. Sessions name the id of their user, and end when the user is
+// deleted.
+
+const (
+	sessionLifetime = 12 * time.Hour
+	// lastUsedAt is only refreshed this often, not to write on every request
+	tokenUseResolution = time.Hour
+)
+
+type ApiToken struct {
+	Id         string     `json:"id,omitempty" bson:"_id,omitempty"`
+	Login      string     `json:"login" bson:"login"`
+	Name       string     `json:"name" bson:"name"`
+	Hash       string     `json:"-" bson:"hash"`
+	Token      string     `json:"token,omitempty" bson:"-"` // only returned on creation
+	CreatedAt  time.Time  `json:"createdAt" bson:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" bson:"lastUsedAt,omitempty"`
+}
+
+type Session struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type principal struct {
+	login string // empty for the admin
+	admin bool
+}
+
+type principalKey struct{}
+
+func requestPrincipal(r *http.Request) *principal {
+	p, _ := r.Context().Value(principalKey{}).(*principal)
+	return p
+}
+
+// authenticate resolves the token of requests to operations of openapi.json
+// and rejects unauthenticated ones unless the operation is public. CalDAV
+// authenticates on its own.
+func (s *Service) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation := spec.operation(r)
+		if operation == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		token := bearerToken(r, operation.queryToken())
+		if token == "" {
+			if operation.public() {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.Header().Set("WWW-Authenticate", `Bearer realm="calendar"`)
+			writeError(w, r, unauthorized("authentication required"))
+			return
+		}
+		p, err := s.authenticateToken(token)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="calendar", error="invalid_token"`)
+			writeError(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, p)))
+	})
+}
+
+// bearerToken returns the token of the Authorization header, or of the
+// access_token query parameter when the operation takes it there.
+func bearerToken(r *http.Request, queryToken bool) string {
+	scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	if strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	if queryToken {
+		return r.URL.Query().Get("access_token")
+	}
+	return ""
+}
+
+func (s *Service) authenticateToken(token string) (*principal, error) {
+	if s.AdminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.AdminToken)) == 1 {
+		return &principal{admin: true}, nil
+	}
+	if strings.Count(token, ".") == 2 {
+		claims, err := s.verifySession(token, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		// sessions end with their user, and don't pass to a new user of the login
+		user, err := s.findUser(claims.Subject)
+		if apiErr, ok := err.(*ApiError); ok && apiErr.Code == CodeNotFound || err == nil && user.Id != claims.UserId {
+			return nil, unauthorized("session revoked")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &principal{login: user.Login}, nil
+	}
+	coll := s.DbClient.Database("db").Collection("tokens")
+	var apiToken ApiToken
+	err := coll.FindOne(context.TODO(), bson.D{{"hash", hashToken(token)}}).Decode(&apiToken)
+	if err == mongo.ErrNoDocuments {
+		return nil, unauthorized("invalid token")
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if apiToken.LastUsedAt == nil || now.Sub(*apiToken.LastUsedAt) > tokenUseResolution {
+		objectId, _ := primitive.ObjectIDFromHex(apiToken.Id)
+		coll.UpdateOne(context.TODO(), bson.D{{"_id", objectId}}, bson.D{{"$set", bson.D{{"lastUsedAt", now}}}})
+	}
+	return &principal{login: apiToken.Login}, nil
+}
+
+// authorize checks that the request may act as login.
+func authorize(r *http.Request, login string) error {
+	p := requestPrincipal(r)
+	if p == nil {
+		return unauthorized("authentication required")
+	}
+	if !p.admin && p.login != login {
+		return forbidden("authenticated as %q", p.login)
+	}
+	return nil
+}
+
+// authorizeSignup checks that the request may create a user: anyone may
+// with open signup, otherwise only the admin.
+func (s *Service) authorizeSignup(r *http.Request) error {
+	if s.OpenSignup {
+		return nil
+	}
+	p := requestPrincipal(r)
+	if p == nil {
+		return unauthorized("signup is closed, users are created by the admin")
+	}
+	if !p.admin {
+		return forbidden("only the admin creates users")
+	}
+	return nil
+}
+
+// actingLogin is who the request acts as: the authenticated user, or the
+// declared login for the admin.
+func actingLogin(r *http.Request, declared string) string {
+	if p := requestPrincipal(r); p != nil && !p.admin {
+		return p.login
+	}
+	return declared
+}
+
+func (s *Service) CreateToken(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var request ApiToken
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	token, err := s.createToken(login, request.Name)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, token)
+}
+
+func (s *Service) createToken(login, name string) (*ApiToken, error) {
+	random := make([]byte, 24)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+	token := &ApiToken{
+		Login:     login,
+		Name:      name,
+		Token:     "cal_" + hex.EncodeToString(random),
+		CreatedAt: time.Now().UTC(),
+	}
+	token.Hash = hashToken(token.Token)
+	res, err := s.DbClient.Database("db").Collection("tokens").InsertOne(context.TODO(), token)
+	if err != nil {
+		return nil, err
+	}
+	if oid, ok := res.InsertedID.(primitive.ObjectID); ok {
+		token.Id = oid.Hex()
+	}
+	return token, nil
+}
+
+func (s *Service) ListTokens(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	opts := options.Find().SetSort(bson.D{{"createdAt", 1}})
+	cursor, err := s.DbClient.Database("db").Collection("tokens").Find(context.TODO(), bson.D{{"login", login}}, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	tokens := []ApiToken{}
+	if err = cursor.All(context.TODO(), &tokens); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, tokens)
+}
+
+func (s *Service) DeleteToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if err := authorize(r, vars["login"]); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	objectId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		writeError(w, r, notFound("token %q not found", vars["id"]))
+		return
+	}
+	res, err := s.DbClient.Database("db").Collection("tokens").DeleteOne(context.TODO(), bson.D{{"_id", objectId}, {"login", vars["login"]}})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if res.DeletedCount == 0 {
+		writeError(w, r, notFound("token %q not found", vars["id"]))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateSession exchanges the token of the request for a short-lived session
+// token, e.g. for browsers not to keep api tokens around.
+func (s *Service) CreateSession(w http.ResponseWriter, r *http.Request) {
+	if len(s.SessionSecret) == 0 {
+		writeError(w, r, notFound("sessions are not enabled"))
+		return
+	}
+	p := requestPrincipal(r)
+	if p == nil {
+		writeError(w, r, unauthorized("authentication required"))
+		return
+	}
+	if p.admin {
+		writeError(w, r, badRequest("sessions are for users"))
+		return
+	}
+	user, err := s.findUser(p.login)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	expiresAt := time.Now().UTC().Add(sessionLifetime).Truncate(time.Second)
+	writeJson(w, http.StatusOK, Session{Token: s.signSession(user, expiresAt), ExpiresAt: expiresAt})
+}
+
+type sessionClaims struct {
+	Subject   string `json:"sub"`
+	UserId    string `json:"uid"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+var sessionHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+func (s *Service) signSession(user *User, expiresAt time.Time) string {
+	claims, _ := json.Marshal(sessionClaims{Subject: user.Login, UserId: user.Id, IssuedAt: time.Now().Unix(), ExpiresAt: expiresAt.Unix()})
+	payload := sessionHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+	mac := hmac.New(sha256.New, s.SessionSecret)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifySession returns the claims of a session token, only HS256 tokens
+// signed with SessionSecret are accepted.
+func (s *Service) verifySession(token string, now time.Time) (*sessionClaims, error) {
+	if len(s.SessionSecret) == 0 {
+		return nil, unauthorized("invalid token")
+	}
+	header, rest, _ := strings.Cut(token, ".")
+	claimsPart, signature, _ := strings.Cut(rest, ".")
+	mac := hmac.New(sha256.New, s.SessionSecret)
+	mac.Write([]byte(header + "." + claimsPart))
+	expected := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	if header != sessionHeader || !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, unauthorized("invalid token")
+	}
+	encoded, err := base64.RawURLEncoding.DecodeString(claimsPart)
+	if err != nil {
+		return nil, unauthorized("invalid token")
+	}
+	var claims sessionClaims
+	if err := json.Unmarshal(encoded, &claims); err != nil || claims.Subject == "" || claims.UserId == "" {
+		return nil, unauthorized("invalid token")
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, unauthorized("session expired")
+	}
+	return &claims, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
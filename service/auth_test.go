@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionTokens(t *testing.T) {
+	s := &Service{SessionSecret: []byte("secret")}
+	now := time.Now()
+	token := s.signSession(&User{Id: "640a4862377457548608f50a", Login: "alice"}, now.Add(time.Hour))
+	claims, err := s.verifySession(token, now)
+	require.NoError(t, err)
+	require.Equal(t, "alice", claims.Subject)
+	require.Equal(t, "640a4862377457548608f50a", claims.UserId)
+
+	_, err = s.verifySession(token, now.Add(2*time.Hour))
+	require.Error(t, err)
+	_, err = (&Service{SessionSecret: []byte("other")}).verifySession(token, now)
+	require.Error(t, err)
+	parts := strings.Split(token, ".")
+	_, err = s.verifySession(parts[0]+"."+parts[1]+"x."+parts[2], now)
+	require.Error(t, err)
+	// unsigned tokens are never accepted
+	_, err = s.verifySession(`eyJhbGciOiJub25lIn0.`+parts[1]+".", now)
+	require.Error(t, err)
+	_, err = (&Service{}).verifySession(token, now)
+	require.Error(t, err)
+}
+
+func TestBearerToken(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/users/alice/calendar.ics?access_token=query", nil)
+	require.Equal(t, "", bearerToken(r, false))
+	r.Header.Set("Authorization", "bearer header")
+	require.Equal(t, "header", bearerToken(r, true))
+	r = httptest.NewRequest("GET", "/api/users/alice/stream?access_token=query", nil)
+	require.Equal(t, "query", bearerToken(r, true))
+
+	// only streams take the token in the query, it ends up in logs otherwise
+	for template, operations := range spec.operations {
+		for method, operation := range operations {
+			require.Equal(t, operation.ID == "streamMeetings", operation.queryToken(), "%s %s", method, template)
+		}
+	}
+}
+
+func TestAuthorizeSignup(t *testing.T) {
+	r := httptest.NewRequest("POST", "/api/users", nil)
+	alice := r.WithContext(context.WithValue(r.Context(), principalKey{}, &principal{login: "alice"}))
+	admin := r.WithContext(context.WithValue(r.Context(), principalKey{}, &principal{admin: true}))
+	closed := &Service{}
+	require.Equal(t, CodeUnauthorized, toApiError(closed.authorizeSignup(r)).Code)
+	require.Equal(t, CodeForbidden, toApiError(closed.authorizeSignup(alice)).Code)
+	require.NoError(t, closed.authorizeSignup(admin))
+	require.NoError(t, (&Service{OpenSignup: true}).authorizeSignup(r))
+}
+
+func TestCreateSessionRequiresToken(t *testing.T) {
+	s := &Service{SessionSecret: []byte("secret")}
+	w := httptest.NewRecorder()
+	s.CreateSession(w, httptest.NewRequest("POST", "/api/sessions", nil))
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestAuthorize(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	require.Error(t, authorize(r, "alice"))
+	alice := r.WithContext(context.WithValue(r.Context(), principalKey{}, &principal{login: "alice"}))
+	require.NoError(t, authorize(alice, "alice"))
+	require.Equal(t, CodeForbidden, toApiError(authorize(alice, "bob")).Code)
+	require.Equal(t, "alice", actingLogin(alice, "bob"))
+	admin := r.WithContext(context.WithValue(r.Context(), principalKey{}, &principal{admin: true}))
+	require.NoError(t, authorize(admin, "bob"))
+	require.Equal(t, "bob", actingLogin(admin, "bob"))
+}
+
+func TestPublicOperations(t *testing.T) {
+	for template, operations := range spec.operations {
+		for method, operation := range operations {
+			public := operation.ID == "getSpec" || operation.ID == "addUser"
+			require.Equal(t, public, operation.public(), "%s %s", method, template)
+		}
+	}
+}
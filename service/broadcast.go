@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// subscriberBuffer is how many events a stream may lag behind before it is
+// closed, clients reconnect and re-read the range they display.
+const subscriberBuffer = 64
+
+type subscription struct {
+	login  string
+	events chan *Event
+}
+
+// broadcaster fans events out to the streams of the participants.
+type broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[*subscription]bool
+}
+
+func newBroadcaster() *broadcaster {
+	return &broadcaster{subscribers: map[*subscription]bool{}}
+}
+
+func (b *broadcaster) subscribe(login string) *subscription {
+	sub := &subscription{login: login, events: make(chan *Event, subscriberBuffer)}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[sub] = true
+	return sub
+}
+
+func (b *broadcaster) unsubscribe(sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subscribers[sub] {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// closeAll ends every stream, http.Server.Shutdown waits for them otherwise.
+func (b *broadcaster) closeAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subscribers {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+func (b *broadcaster) publish(event *Event) {
+	participants := map[string]bool{}
+	for _, login := range event.recipients() {
+		participants[login] = true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subscribers {
+		if !participants[sub.login] {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// Streams are fed from a change stream on meetings, so that they see the
+// writes of every replica. Change streams need a replica set, on a standalone
+// server the events emitted by this process are broadcast instead.
+
+type meetingChange struct {
+	OperationType     string   `bson:"operationType"`
+	FullDocument      *Meeting `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.M `bson:"updatedFields"`
+	} `bson:"updateDescription"`
+}
+
+var (
+	answerField    = regexp.MustCompile(`^invited\.(\d+)\.accepted$`)
+	respondedField = regexp.MustCompile(`^invited\.\d+\.(respondedBy|calendarId)$`)
+)
+
+// startStreams picks the source of the events of streams and, for change
+// streams, starts watching until ctx is done.
+func (s *Service) startStreams(ctx context.Context) {
+	s.streams = newBroadcaster()
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	stream, err := s.DbClient.Database("db").Collection("meetings").Watch(ctx, mongo.Pipeline{}, opts)
+	if err != nil {
+		log.Printf("streams: change streams unavailable, broadcasting local events: %v", err)
+		s.localEvents.Store(true)
+		return
+	}
+	s.StopWg.Add(1)
+	go func() {
+		defer s.StopWg.Done()
+		s.watchMeetings(ctx, stream, opts)
+	}()
+}
+
+func (s *Service) watchMeetings(ctx context.Context, stream *mongo.ChangeStream, opts *options.ChangeStreamOptions) {
+	for {
+		for stream.Next(ctx) {
+			var change meetingChange
+			if err := stream.Decode(&change); err != nil {
+				log.Printf("streams: decode change: %v", err)
+				continue
+			}
+			for _, event := range change.events() {
+				s.streams.publish(event)
+			}
+		}
+		token := stream.ResumeToken()
+		err := stream.Err()
+		stream.Close(context.TODO())
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("streams: change stream interrupted: %v", err)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			if token != nil {
+				opts.SetResumeAfter(token)
+			}
+			if stream, err = s.DbClient.Database("db").Collection("meetings").Watch(ctx, mongo.Pipeline{}, opts); err == nil {
+				break
+			}
+			log.Printf("streams: reopen change stream: %v", err)
+		}
+	}
+}
+
+// events are the events of a change: the change, and the removals of the
+// participants it dropped.
+func (c *meetingChange) events() []*Event {
+	event := c.event()
+	if event == nil {
+		return nil
+	}
+	events := []*Event{event}
+	// updates other than removeFromCalendar keep the removals of the
+	// replacement before them
+	_, removed := c.UpdateDescription.UpdatedFields["removed"]
+	if event.Type == MeetingUpdated && (removed || c.OperationType == "replace") {
+		events = append(events, removals(c.FullDocument)...)
+	}
+	return events
+}
+
+func (c *meetingChange) event() *Event {
+	event := &Event{Id: primitive.NewObjectID().Hex(), Time: time.Now().UTC()}
+	switch c.OperationType {
+	case "insert":
+		event.Type = MeetingCreated
+	case "replace":
+		event.Type = MeetingUpdated
+	case "update":
+		event.Type = MeetingUpdated
+		if c.FullDocument != nil && c.FullDocument.Deleted {
+			event.Type = MeetingDeleted
+			break
+		}
+		if c.FullDocument != nil {
+			// an answer updates the version, who answered and where the invitee
+			// keeps the meeting, and nothing else
+			answers := 0
+			for field := range c.UpdateDescription.UpdatedFields {
+				if match := answerField.FindStringSubmatch(field); match != nil {
+					answers++
+					index, _ := strconv.Atoi(match[1])
+					if index < len(c.FullDocument.Invited) {
+						event.Login = c.FullDocument.Invited[index].Invitee
+					}
+				} else if field != "version" && field != "updatedAt" && !respondedField.MatchString(field) {
+					answers = -1
+					break
+				}
+			}
+			if answers == 1 && event.Login != "" {
+				event.Type = MeetingResponded
+			} else {
+				event.Login = ""
+			}
+		}
+	default:
+		// deletes only purge tombstones
+		return nil
+	}
+	if c.FullDocument == nil {
+		// deleted before the lookup
+		return nil
+	}
+	event.Meeting = *c.FullDocument
+	return event
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestBroadcasterRoutesToParticipants(t *testing.T) {
+	b := newBroadcaster()
+	alice := b.subscribe("alice")
+	carl := b.subscribe("carl")
+	defer b.unsubscribe(alice)
+	defer b.unsubscribe(carl)
+	event := &Event{Type: MeetingCreated, Meeting: Meeting{Owner: "bob", Invited: []Invitation{{Invitee: "alice"}}}}
+	b.publish(event)
+	require.Equal(t, event, <-alice.events)
+	require.Equal(t, 0, len(carl.events))
+}
+
+func TestBroadcasterClosesLaggingStreams(t *testing.T) {
+	b := newBroadcaster()
+	bob := b.subscribe("bob")
+	for i := 0; i <= subscriberBuffer; i++ {
+		b.publish(&Event{Meeting: Meeting{Owner: "bob"}})
+	}
+	for range bob.events {
+	}
+	b.unsubscribe(bob) // closing twice is harmless
+}
+
+func TestMeetingChangeEvents(t *testing.T) {
+	meeting := &Meeting{Id: "640a4862377457548608f50a", Owner: "bob", Invited: []Invitation{{Invitee: "alice"}, {Invitee: "carl", Accepted: Declined}}}
+	change := meetingChange{OperationType: "insert", FullDocument: meeting}
+	require.Equal(t, MeetingCreated, change.event().Type)
+
+	change = meetingChange{OperationType: "update", FullDocument: meeting}
+	change.UpdateDescription.UpdatedFields = bson.M{"invited.1.accepted": int32(Declined), "invited.1.respondedBy": "carol", "invited.1.calendarId": "640a4862377457548608f50b", "version": int64(3), "updatedAt": time.Now()}
+	event := change.event()
+	require.Equal(t, MeetingResponded, event.Type)
+	require.Equal(t, "carl", event.Login)
+
+	change.UpdateDescription.UpdatedFields = bson.M{"invited.1.accepted": int32(Declined), "description": "planning"}
+	event = change.event()
+	require.Equal(t, MeetingUpdated, event.Type)
+	require.Equal(t, "", event.Login)
+
+	tombstone := *meeting
+	tombstone.Deleted = true
+	change = meetingChange{OperationType: "update", FullDocument: &tombstone}
+	change.UpdateDescription.UpdatedFields = bson.M{"deleted": true}
+	require.Equal(t, MeetingDeleted, change.event().Type)
+
+	// deletes purge tombstones, which were already announced
+	require.Nil(t, (&meetingChange{OperationType: "delete"}).event())
+}
+
+func TestMeetingChangeRemovals(t *testing.T) {
+	meeting := &Meeting{Id: "640a4862377457548608f50a", Owner: "bob", Invited: []Invitation{{Invitee: "alice"}}, Removed: []string{"carl"}}
+	events := (&meetingChange{OperationType: "replace", FullDocument: meeting}).events()
+	require.Len(t, events, 2)
+	require.Equal(t, MeetingUpdated, events[0].Type)
+	require.Equal(t, MeetingRemoved, events[1].Type)
+	require.Equal(t, []string{"carl"}, events[1].recipients())
+	// carl doesn't see the meeting anymore
+	require.True(t, events[1].Meeting.Busy)
+
+	// answers keep the removals of the change before
+	change := meetingChange{OperationType: "update", FullDocument: meeting}
+	change.UpdateDescription.UpdatedFields = bson.M{"invited.0.accepted": int32(Accepted), "version": int64(4)}
+	require.Len(t, change.events(), 1)
+
+	change.UpdateDescription.UpdatedFields = bson.M{"invited": bson.A{}, "left": bson.A{"carl"}, "removed": bson.A{"carl"}, "version": int64(5)}
+	require.Len(t, change.events(), 2)
+}
+
+func TestWebSocketStream(t *testing.T) {
+	s := &Service{streams: newBroadcaster(), StreamOrigins: []string{"https://app.example.com"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.streamWebSocket(w, r, "bob")
+	}))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	_, response, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://evil.example.com"}})
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	require.Equal(t, http.StatusForbidden, response.StatusCode)
+
+	for _, origin := range []string{"", server.URL, "https://app.example.com"} {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+		conn, _, err := websocket.DefaultDialer.Dial(url, header)
+		require.NoError(t, err, origin)
+		// the subscription is made once the connection is upgraded
+		require.Eventually(t, func() bool {
+			s.streams.mu.Lock()
+			defer s.streams.mu.Unlock()
+			return len(s.streams.subscribers) == 1
+		}, time.Second, 10*time.Millisecond)
+		s.streams.publish(&Event{Id: "1", Type: MeetingCreated, Meeting: Meeting{Owner: "bob"}})
+		var event Event
+		require.NoError(t, conn.ReadJSON(&event))
+		require.Equal(t, MeetingCreated, event.Type)
+		conn.Close()
+		require.Eventually(t, func() bool {
+			s.streams.mu.Lock()
+			defer s.streams.mu.Unlock()
+			return len(s.streams.subscribers) == 0
+		}, time.Second, 10*time.Millisecond)
+	}
+}
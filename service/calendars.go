@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The owner files a meeting in one of their calendars and every invitee in
+// one of theirs, the default calendar unless they choose one. The visibility
+// of a calendar applies to its meetings without one of their own, it is
+// copied to them as CalendarVisibility so that policies need no lookups.
+
+const defaultCalendarId = "default"
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (s *Service) CreateCalendar(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var calendar Calendar
+	if err := json.NewDecoder(r.Body).Decode(&calendar); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	if err := validateCalendar(&calendar); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	calendar.Id, calendar.Owner = "", login
+	res, err := s.DbClient.Database("db").Collection("calendars").InsertOne(context.TODO(), calendar)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if oid, ok := res.InsertedID.(primitive.ObjectID); ok {
+		calendar.Id = oid.Hex()
+	}
+	writeJson(w, http.StatusOK, calendar)
+}
+
+// ListCalendars returns the calendars of a user, the default one first.
+func (s *Service) ListCalendars(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorizeCalendar(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	opts := options.Find().SetSort(bson.D{{"name", 1}})
+	cursor, err := s.DbClient.Database("db").Collection("calendars").Find(context.TODO(), bson.D{{"owner", login}}, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	calendars := []Calendar{}
+	if err = cursor.All(context.TODO(), &calendars); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, append([]Calendar{{Id: defaultCalendarId, Owner: login, Name: "Default"}}, calendars...))
+}
+
+// UpdateCalendar renames a calendar, or changes its color or visibility.
+func (s *Service) UpdateCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if err := authorize(r, vars["login"]); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var calendar Calendar
+	if err := json.NewDecoder(r.Body).Decode(&calendar); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	if err := validateCalendar(&calendar); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	existing, err := s.findCalendar(vars["login"], vars["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if existing == nil {
+		writeError(w, r, validationFailed("the default calendar can't be changed"))
+		return
+	}
+	calendar.Id, calendar.Owner = existing.Id, existing.Owner
+	database := s.DbClient.Database("db")
+	objectId, _ := primitive.ObjectIDFromHex(existing.Id)
+	update := bson.D{{"$set", bson.D{{"name", calendar.Name}, {"color", calendar.Color}, {"visibility", calendar.Visibility}}}}
+	if _, err := database.Collection("calendars").UpdateOne(context.TODO(), bson.D{{"_id", objectId}}, update); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if calendar.Visibility != existing.Visibility {
+		// what others see of the meetings changes, they sync again
+		version, err := s.nextVersion()
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		filter := bson.D{{"owner", existing.Owner}, {"calendarId", existing.Id}}
+		update := bson.D{{"$set", bson.D{{"calendarVisibility", calendar.Visibility}, {"version", version}, {"updatedAt", time.Now().UTC()}}}}
+		if _, err := database.Collection("meetings").UpdateMany(context.TODO(), filter, update); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+	writeJson(w, http.StatusOK, calendar)
+}
+
+// DeleteCalendar deletes a calendar, its meetings move to the default one.
+func (s *Service) DeleteCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if err := authorize(r, vars["login"]); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	calendar, err := s.findCalendar(vars["login"], vars["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if calendar == nil {
+		writeError(w, r, validationFailed("the default calendar can't be deleted"))
+		return
+	}
+	version, err := s.nextVersion()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	database := s.DbClient.Database("db")
+	now := time.Now().UTC()
+	_, err = database.Collection("meetings").UpdateMany(context.TODO(),
+		bson.D{{"owner", calendar.Owner}, {"calendarId", calendar.Id}},
+		bson.D{
+			{"$set", bson.D{{"version", version}, {"updatedAt", now}}},
+			{"$unset", bson.D{{"calendarId", ""}, {"calendarVisibility", ""}}},
+		})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+		bson.D{{"elem.invitee", calendar.Owner}, {"elem.calendarId", calendar.Id}},
+	}})
+	_, err = database.Collection("meetings").UpdateMany(context.TODO(),
+		bson.D{{"invited", bson.D{{"$elemMatch", bson.D{{"invitee", calendar.Owner}, {"calendarId", calendar.Id}}}}}},
+		bson.D{
+			{"$set", bson.D{{"version", version}, {"updatedAt", now}}},
+			{"$unset", bson.D{{"invited.$[elem].calendarId", ""}}},
+		}, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	objectId, _ := primitive.ObjectIDFromHex(calendar.Id)
+	if _, err := database.Collection("calendars").DeleteOne(context.TODO(), bson.D{{"_id", objectId}}); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validateCalendar(calendar *Calendar) error {
+	details := []ErrorDetail{}
+	if calendar.Name = strings.TrimSpace(calendar.Name); calendar.Name == "" {
+		details = append(details, ErrorDetail{"name", "is required"})
+	}
+	if calendar.Color != "" && !colorPattern.MatchString(calendar.Color) {
+		details = append(details, ErrorDetail{"color", "must be #rrggbb"})
+	}
+	switch calendar.Visibility {
+	case "", VisibilityPublic, VisibilityPrivate, VisibilityConfidential:
+	default:
+		details = append(details, ErrorDetail{"visibility", "supported visibilities: public, private, confidential"})
+	}
+	if len(details) != 0 {
+		return validationFailed("invalid calendar", details...)
+	}
+	return nil
+}
+
+// findCalendar returns the calendar of owner, or nil for the default one.
+func (s *Service) findCalendar(owner, id string) (*Calendar, error) {
+	if id == "" || id == defaultCalendarId {
+		return nil, nil
+	}
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, notFound("calendar %q not found", id)
+	}
+	var calendar Calendar
+	err = s.DbClient.Database("db").Collection("calendars").FindOne(context.TODO(), bson.D{{"_id", objectId}, {"owner", owner}}).Decode(&calendar)
+	if err == mongo.ErrNoDocuments {
+		return nil, notFound("calendar %q not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &calendar, nil
+}
+
+// fileMeeting puts the meeting in the calendar of its owner.
+func (s *Service) fileMeeting(meeting *Meeting) error {
+	calendar, err := s.findCalendar(meeting.Owner, meeting.CalendarId)
+	if apiErr, ok := err.(*ApiError); ok && apiErr.Code == CodeNotFound {
+		return validationFailed("unknown calendar", ErrorDetail{"calendarId", apiErr.Message + " for " + meeting.Owner})
+	}
+	if err != nil {
+		return err
+	}
+	meeting.CalendarId, meeting.CalendarVisibility = "", ""
+	if calendar != nil {
+		meeting.CalendarId, meeting.CalendarVisibility = calendar.Id, calendar.Visibility
+	}
+	return nil
+}
+
+// keepCalendars files a meeting replacing existing in the calendars existing
+// is in. Calendar objects carry the visibility of the calendar as their own,
+// it is kept the one of the calendar.
+func keepCalendars(meeting, existing *Meeting) {
+	meeting.CalendarId, meeting.CalendarVisibility = existing.CalendarId, existing.CalendarVisibility
+	if existing.Visibility == "" && meeting.Visibility == existing.CalendarVisibility {
+		meeting.Visibility = ""
+	}
+	calendars := map[string]string{}
+	for _, invitation := range existing.Invited {
+		calendars[invitation.Invitee] = invitation.CalendarId
+	}
+	for i := range meeting.Invited {
+		meeting.Invited[i].CalendarId = calendars[meeting.Invited[i].Invitee]
+	}
+}
+
+// calendarOf returns the id of the calendar of login the meeting is in.
+func (m *Meeting) calendarOf(login string) string {
+	id := ""
+	if m.Owner == login {
+		id = m.CalendarId
+	} else {
+		for _, invitation := range m.Invited {
+			if invitation.Invitee == login {
+				id = invitation.CalendarId
+			}
+		}
+	}
+	if id == "" {
+		return defaultCalendarId
+	}
+	return id
+}
+
+// visibility returns the visibility of the meeting, or of its calendar.
+func (m *Meeting) visibility() Visibility {
+	if m.Visibility != "" {
+		return m.Visibility
+	}
+	return m.CalendarVisibility
+}
+
+// calendarFilter selects meetings by the calendars the users are in them by.
+type calendarFilter struct {
+	include map[string]bool // any calendar when empty
+	exclude map[string]bool
+}
+
+func parseCalendarFilter(r *http.Request) calendarFilter {
+	filter := calendarFilter{include: map[string]bool{}, exclude: map[string]bool{}}
+	for _, id := range splitIds(r.URL.Query().Get("calendars")) {
+		filter.include[id] = true
+	}
+	for _, id := range splitIds(r.URL.Query().Get("excludeCalendars")) {
+		filter.exclude[id] = true
+	}
+	return filter
+}
+
+// matches reports whether one of the users takes part in the meeting by a
+// selected calendar.
+func (f calendarFilter) matches(meeting *Meeting, logins []string) bool {
+	participants := map[string]bool{}
+	for _, login := range meeting.participants() {
+		participants[login] = true
+	}
+	for _, login := range logins {
+		if !participants[login] {
+			continue
+		}
+		id := meeting.calendarOf(login)
+		if (len(f.include) == 0 || f.include[id]) && !f.exclude[id] {
+			return true
+		}
+	}
+	return false
+}
+
+func splitIds(value string) []string {
+	ids := []string{}
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
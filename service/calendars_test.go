@@ -0,0 +1,57 @@
+package service
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarFilter(t *testing.T) {
+	meeting := &Meeting{
+		Owner:      "bob",
+		CalendarId: "work",
+		Invited:    []Invitation{{Invitee: "alice", CalendarId: "personal"}, {Invitee: "carol"}},
+	}
+	require.Equal(t, "work", meeting.calendarOf("bob"))
+	require.Equal(t, "personal", meeting.calendarOf("alice"))
+	require.Equal(t, defaultCalendarId, meeting.calendarOf("carol"))
+
+	all := parseCalendarFilter(httptest.NewRequest("GET", "/", nil))
+	require.True(t, all.matches(meeting, []string{"alice"}))
+	require.False(t, all.matches(meeting, []string{"dave"}))
+
+	work := parseCalendarFilter(httptest.NewRequest("GET", "/?excludeCalendars=personal", nil))
+	require.False(t, work.matches(meeting, []string{"alice"}))
+	require.True(t, work.matches(meeting, []string{"alice", "carol"}))
+
+	only := parseCalendarFilter(httptest.NewRequest("GET", "/?calendars=work,+default", nil))
+	require.True(t, only.matches(meeting, []string{"bob"}))
+	require.True(t, only.matches(meeting, []string{"carol"}))
+	require.False(t, only.matches(meeting, []string{"alice"}))
+}
+
+func TestCalendarVisibility(t *testing.T) {
+	meeting := &Meeting{Owner: "bob", CalendarVisibility: VisibilityPublic, Description: "standup"}
+	other := &policy{principal: &principal{login: "dave"}}
+	require.True(t, other.can(actionView, meeting))
+	meeting.Visibility = VisibilityPrivate
+	require.False(t, other.can(actionView, meeting))
+
+	// calendar objects carry the visibility of the calendar
+	existing := &Meeting{CalendarId: "work", CalendarVisibility: VisibilityPublic, Invited: []Invitation{{Invitee: "alice", CalendarId: "personal"}}}
+	updated := &Meeting{Visibility: VisibilityPublic, Invited: []Invitation{{Invitee: "alice"}, {Invitee: "carol"}}}
+	keepCalendars(updated, existing)
+	require.Equal(t, Visibility(""), updated.Visibility)
+	require.Equal(t, VisibilityPublic, updated.visibility())
+	require.Equal(t, "work", updated.CalendarId)
+	require.Equal(t, "personal", updated.Invited[0].CalendarId)
+	require.Equal(t, "", updated.Invited[1].CalendarId)
+}
+
+func TestValidateCalendar(t *testing.T) {
+	require.NoError(t, validateCalendar(&Calendar{Name: "Personal", Color: "#1a2B3c", Visibility: VisibilityPrivate}))
+	err := toApiError(validateCalendar(&Calendar{Name: " ", Color: "red", Visibility: "secret"}))
+	require.Equal(t, CodeValidationFailed, err.Code)
+	require.Len(t, err.Details, 3)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Conference is the call of a meeting, joined by its url or by phone.
+type Conference struct {
+	Url     string
+	DialIns []DialIn
+}
+
+// ConferenceProvider creates the calls of meetings that have none, when
+// they are created or updated. Meetings it is asked for may have no id yet.
+type ConferenceProvider interface {
+	Conference(ctx context.Context, meeting *Meeting) (*Conference, error)
+}
+
+// JitsiProvider names the rooms of a self-hosted Jitsi Meet deployment after
+// the meeting, the same meeting always gets the same room. Dial-in numbers
+// are the ones of the deployment's gateway, the PIN is derived from the room
+// like the room from the meeting.
+type JitsiProvider struct {
+	BaseUrl string   // e.g. https://meet.example.com
+	Secret  []byte   // makes room names unguessable
+	Numbers []string // dial-in numbers, none when empty
+}
+
+func (p *JitsiProvider) Conference(ctx context.Context, meeting *Meeting) (*Conference, error) {
+	mac := hmac.New(sha256.New, p.Secret)
+	fmt.Fprintf(mac, "%s\n%d\n%s", meeting.Owner, meeting.StartTime.Unix(), summary(meeting))
+	sum := mac.Sum(nil)
+	room := roomName(summary(meeting)) + "-" + hex.EncodeToString(sum[:6])
+	conference := &Conference{Url: strings.TrimSuffix(p.BaseUrl, "/") + "/" + room}
+	pin := fmt.Sprintf("%09d", binary.BigEndian.Uint64(sum[6:14])%1000000000)
+	for _, number := range p.Numbers {
+		conference.DialIns = append(conference.DialIns, DialIn{Number: number, Pin: pin})
+	}
+	return conference, nil
+}
+
+// roomName turns a title into lower case letters and digits, separated by
+// dashes.
+func roomName(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			dash = b.Len() != 0
+			continue
+		}
+		if b.Len() >= 40 {
+			break
+		}
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "meeting"
+	}
+	return b.String()
+}
+
+// FakeConferences creates calls at https://meet.test/{n}, numbered in the
+// order they are asked for, and keeps the meetings they are for. Calls fail
+// with Err when it is set.
+type FakeConferences struct {
+	Err error
+
+	mu       sync.Mutex
+	meetings []Meeting
+}
+
+func (f *FakeConferences) Conference(ctx context.Context, meeting *Meeting) (*Conference, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.meetings = append(f.meetings, *meeting)
+	n := len(f.meetings)
+	return &Conference{
+		Url:     fmt.Sprintf("https://meet.test/%d", n),
+		DialIns: []DialIn{{Number: "+15550100", Pin: fmt.Sprintf("%04d", n)}},
+	}, nil
+}
+
+// Meetings returns the meetings calls were created for so far.
+func (f *FakeConferences) Meetings() []Meeting {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Meeting{}, f.meetings...)
+}
+
+// setConference keeps the call of an updated meeting, unless the meeting
+// brings a call of its own, and has the provider create one for meetings
+// without a call. Dial-ins belong to the calls of the provider, clients
+// can't set them.
+func (s *Service) setConference(meeting *Meeting, existing *Meeting) error {
+	meeting.DialIns = nil
+	switch {
+	case existing != nil && existing.ConferenceUrl != "" && meeting.ConferenceUrl == existing.ConferenceUrl:
+		meeting.DialIns = existing.DialIns
+		return nil
+	case meeting.ConferenceUrl != "" || s.Conferences == nil:
+		return nil
+	case existing != nil && existing.ConferenceUrl != "":
+		// clients that don't know about calls leave them out
+		meeting.ConferenceUrl, meeting.DialIns = existing.ConferenceUrl, existing.DialIns
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	conference, err := s.Conferences.Conference(ctx, meeting)
+	if err != nil {
+		return fmt.Errorf("failed to create the call: %w", err)
+	}
+	meeting.ConferenceUrl, meeting.DialIns = conference.Url, conference.DialIns
+	return nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJitsiProvider(t *testing.T) {
+	jitsi := &JitsiProvider{BaseUrl: "https://meet.example.com/", Secret: []byte("secret"), Numbers: []string{"+49 30 1234567", "+1 555 0100"}}
+	meeting := &Meeting{Owner: "bob", StartTime: time.Date(2023, 3, 7, 16, 0, 0, 0, time.UTC), Title: "Design review: API v2"}
+	conference, err := jitsi.Conference(context.Background(), meeting)
+	require.NoError(t, err)
+	require.Regexp(t, `^https://meet\.example\.com/design-review-api-v2-[0-9a-f]{12}$`, conference.Url)
+	require.Len(t, conference.DialIns, 2)
+	require.Regexp(t, `^[0-9]{9}$`, conference.DialIns[0].Pin)
+	require.Equal(t, conference.DialIns[0].Pin, conference.DialIns[1].Pin)
+
+	// the same meeting gets the same room, others and other secrets don't
+	again, err := jitsi.Conference(context.Background(), meeting)
+	require.NoError(t, err)
+	require.Equal(t, conference, again)
+	other, err := jitsi.Conference(context.Background(), &Meeting{Owner: "alice", StartTime: meeting.StartTime, Title: meeting.Title})
+	require.NoError(t, err)
+	require.NotEqual(t, conference.Url, other.Url)
+	other, err = (&JitsiProvider{BaseUrl: jitsi.BaseUrl}).Conference(context.Background(), meeting)
+	require.NoError(t, err)
+	require.NotEqual(t, conference.Url, other.Url)
+	require.Empty(t, other.DialIns)
+
+	require.Equal(t, "meeting", roomName("  "))
+	require.Equal(t, "1-1-with-bob", roomName("1:1 with Bob!"))
+}
+
+func TestSetConference(t *testing.T) {
+	fake := &FakeConferences{}
+	s := &Service{Conferences: fake}
+	meeting := &Meeting{Owner: "bob", Title: "standup", DialIns: []DialIn{{Number: "+15550199"}}}
+	require.NoError(t, s.setConference(meeting, nil))
+	require.Equal(t, "https://meet.test/1", meeting.ConferenceUrl)
+	require.Equal(t, []DialIn{{Number: "+15550100", Pin: "0001"}}, meeting.DialIns)
+	require.Equal(t, "standup", fake.Meetings()[0].Title)
+
+	// updates keep the call, whether they send it or leave it out
+	existing := *meeting
+	updated := &Meeting{Owner: "bob", Title: "planning", ConferenceUrl: existing.ConferenceUrl}
+	require.NoError(t, s.setConference(updated, &existing))
+	require.Equal(t, existing.DialIns, updated.DialIns)
+	updated = &Meeting{Owner: "bob", Title: "planning"}
+	require.NoError(t, s.setConference(updated, &existing))
+	require.Equal(t, existing.ConferenceUrl, updated.ConferenceUrl)
+	require.Equal(t, existing.DialIns, updated.DialIns)
+	require.Len(t, fake.Meetings(), 1)
+
+	// a call of the owner replaces the one of the provider
+	updated = &Meeting{Owner: "bob", ConferenceUrl: "https://zoom.example.com/j/1", DialIns: existing.DialIns}
+	require.NoError(t, s.setConference(updated, &existing))
+	require.Empty(t, updated.DialIns)
+
+	// meetings stored without a call get one with their next update
+	callless := Meeting{Owner: "bob", Title: "retro"}
+	updated = &Meeting{Owner: "bob", Title: "retro"}
+	require.NoError(t, s.setConference(updated, &callless))
+	require.Equal(t, "https://meet.test/2", updated.ConferenceUrl)
+	require.Equal(t, []DialIn{{Number: "+15550100", Pin: "0002"}}, updated.DialIns)
+
+	fake.Err = errors.New("unavailable")
+	require.Error(t, s.setConference(&Meeting{Owner: "bob"}, nil))
+	meeting = &Meeting{Owner: "bob"}
+	require.NoError(t, (&Service{}).setConference(meeting, nil))
+	require.Empty(t, meeting.ConferenceUrl)
+}
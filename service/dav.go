@@ -0,0 +1,790 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CalDAV (RFC 4791) view of the meetings: every user has a principal and a
+// calendar home with a single calendar holding the meetings they take part in.
+//
+//	/dav/principals/{login}/
+//	/dav/calendars/{login}/
+//	/dav/calendars/{login}/default/
+//	/dav/calendars/{login}/default/{uid}.ics
+
+const (
+	nsDav       = "DAV:"
+	nsCalDav    = "urn:ietf:params:xml:ns:caldav"
+	nsCalServer = "http://calendarserver.org/ns/"
+
+	defaultCalendar = "default"
+)
+
+var davPrefixes = map[string]string{
+	nsDav:       "D",
+	nsCalDav:    "C",
+	nsCalServer: "CS",
+}
+
+var (
+	propResourceType         = xml.Name{nsDav, "resourcetype"}
+	propDisplayName          = xml.Name{nsDav, "displayname"}
+	propGetEtag              = xml.Name{nsDav, "getetag"}
+	propGetContentType       = xml.Name{nsDav, "getcontenttype"}
+	propCurrentUserPrincipal = xml.Name{nsDav, "current-user-principal"}
+	propPrincipalUrl         = xml.Name{nsDav, "principal-URL"}
+	propOwner                = xml.Name{nsDav, "owner"}
+	propPrivilegeSet         = xml.Name{nsDav, "current-user-privilege-set"}
+	propSupportedReportSet   = xml.Name{nsDav, "supported-report-set"}
+	propCalendarHomeSet      = xml.Name{nsCalDav, "calendar-home-set"}
+	propCalendarUserAddress  = xml.Name{nsCalDav, "calendar-user-address-set"}
+	propSupportedComponents  = xml.Name{nsCalDav, "supported-calendar-component-set"}
+	propCalendarData         = xml.Name{nsCalDav, "calendar-data"}
+	propGetCtag              = xml.Name{nsCalServer, "getctag"}
+)
+
+type davAny struct {
+	XMLName xml.Name
+}
+
+type davPropNames struct {
+	Names []davAny `xml:",any"`
+}
+
+type propfindRequest struct {
+	AllProp *struct{}     `xml:"DAV: allprop"`
+	Prop    *davPropNames `xml:"DAV: prop"`
+}
+
+type timeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+type compFilter struct {
+	Name      string       `xml:"name,attr"`
+	TimeRange *timeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	Filters   []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type reportRequest struct {
+	XMLName xml.Name
+	Prop    *davPropNames `xml:"DAV: prop"`
+	Hrefs   []string      `xml:"DAV: href"`
+	Filter  *struct {
+		Comp compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+// davProps holds the inner xml of the properties of a resource.
+type davProps map[xml.Name]string
+
+type davResponse struct {
+	Href    string
+	Props   davProps
+	Missing []xml.Name
+	Status  int
+}
+
+func (s *Service) registerDav(r *mux.Router) {
+	methods := []string{"OPTIONS", "PROPFIND", "REPORT", "GET", "PUT", "DELETE"}
+	r.HandleFunc("/.well-known/caldav", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/dav/", http.StatusPermanentRedirect)
+	})
+	for _, path := range []string{"/dav", "/dav/", "/dav/principals/{login}", "/dav/principals/{login}/"} {
+		r.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			s.DavPrincipal(w, r)
+		}).Methods(methods...)
+	}
+	for _, path := range []string{"/dav/calendars/{login}", "/dav/calendars/{login}/"} {
+		r.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			s.DavHome(w, r)
+		}).Methods(methods...)
+	}
+	for _, path := range []string{"/dav/calendars/{login}/{calendar}", "/dav/calendars/{login}/{calendar}/"} {
+		r.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			s.DavCalendar(w, r)
+		}).Methods(methods...)
+	}
+	r.HandleFunc("/dav/calendars/{login}/{calendar}/{resource}.ics", func(w http.ResponseWriter, r *http.Request) {
+		s.DavObject(w, r)
+	}).Methods(methods...)
+}
+
+// davPolicy returns the policy of the user the client authenticated as,
+// CalDAV clients always send basic credentials. The password is a token.
+func (s *Service) davPolicy(w http.ResponseWriter, r *http.Request) (*policy, bool) {
+	login, token, ok := r.BasicAuth()
+	if ok {
+		// the password is a token of the user, or the admin token, which
+		// acts as the user
+		p, err := s.authenticateToken(token)
+		if err == nil && (p.admin || p.login == login) {
+			if _, err := s.findUser(login); err == nil {
+				pol, err := s.principalPolicy(&principal{login: login})
+				if err != nil {
+					writeError(w, r, err)
+					return nil, false
+				}
+				return pol, true
+			}
+		}
+	}
+	w.Header().Set("WWW-Authenticate", `Basic realm="calendar"`)
+	writeError(w, r, &ApiError{Status: http.StatusUnauthorized, Code: CodeUnauthorized, Message: "authentication required"})
+	return nil, false
+}
+
+func davOptions(w http.ResponseWriter) {
+	w.Header().Set("DAV", "1, 3, calendar-access")
+	w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT, GET, PUT, DELETE")
+	w.WriteHeader(http.StatusOK)
+}
+
+func principalHref(login string) string {
+	return "/dav/principals/" + url.PathEscape(login) + "/"
+}
+
+func homeHref(login string) string {
+	return "/dav/calendars/" + url.PathEscape(login) + "/"
+}
+
+func calendarHref(login string) string {
+	return homeHref(login) + defaultCalendar + "/"
+}
+
+func hrefXml(href string) string {
+	return "<D:href>" + xmlEscape(href) + "</D:href>"
+}
+
+func xmlEscape(value string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
+
+func (s *Service) principalProps(login, current string) davProps {
+	return davProps{
+		propResourceType:         "<D:principal/>",
+		propDisplayName:          xmlEscape(login),
+		propCurrentUserPrincipal: hrefXml(principalHref(current)),
+		propPrincipalUrl:         hrefXml(principalHref(login)),
+		propCalendarHomeSet:      hrefXml(homeHref(login)),
+		propCalendarUserAddress:  hrefXml(s.calendarAddress(login)),
+	}
+}
+
+func (s *Service) DavPrincipal(w http.ResponseWriter, r *http.Request) {
+	pol, ok := s.davPolicy(w, r)
+	if !ok {
+		return
+	}
+	current := pol.principal.login
+	login := mux.Vars(r)["login"]
+	if login == "" {
+		// the service root, clients discover their principal from here
+		login = current
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	switch r.Method {
+	case "OPTIONS":
+		davOptions(w)
+	case "PROPFIND":
+		s.propfind(w, r, []davResponse{{Href: r.URL.Path, Props: s.principalProps(login, current)}})
+	default:
+		writeError(w, r, &ApiError{Status: http.StatusMethodNotAllowed, Code: CodeMethodNotAllowed, Message: "method not allowed"})
+	}
+}
+
+func (s *Service) DavHome(w http.ResponseWriter, r *http.Request) {
+	pol, ok := s.davPolicy(w, r)
+	if !ok {
+		return
+	}
+	current, login := pol.principal.login, mux.Vars(r)["login"]
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorizeCalendar(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	switch r.Method {
+	case "OPTIONS":
+		davOptions(w)
+	case "PROPFIND":
+		responses := []davResponse{{Href: homeHref(login), Props: davProps{
+			propResourceType:         "<D:collection/>",
+			propDisplayName:          xmlEscape(login),
+			propCurrentUserPrincipal: hrefXml(principalHref(current)),
+			propOwner:                hrefXml(principalHref(login)),
+		}}}
+		if r.Header.Get("Depth") != "0" {
+			props, err := s.calendarProps(pol, login)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			responses = append(responses, davResponse{Href: calendarHref(login), Props: props})
+		}
+		s.propfind(w, r, responses)
+	default:
+		writeError(w, r, &ApiError{Status: http.StatusMethodNotAllowed, Code: CodeMethodNotAllowed, Message: "method not allowed"})
+	}
+}
+
+// calendarProps describes the calendar of login as the principal of pol
+// sees it.
+func (s *Service) calendarProps(pol *policy, login string) (davProps, error) {
+	current := pol.principal.login
+	meetings, err := s.calendarMeetings(login)
+	if err != nil {
+		return nil, err
+	}
+	etags := []string{}
+	for i := range meetings {
+		view := pol.view(&meetings[i])
+		etags = append(etags, s.meetingEtag(&view))
+	}
+	sort.Strings(etags)
+	ctag := sha1.Sum([]byte(strings.Join(etags, ",")))
+	privileges := ""
+	if pol.actsFor(login, ShareFreeBusy) {
+		privileges += "<D:privilege><D:read/></D:privilege>"
+	}
+	if login == current {
+		privileges += "<D:privilege><D:write/></D:privilege><D:privilege><D:write-content/></D:privilege><D:privilege><D:bind/></D:privilege><D:privilege><D:unbind/></D:privilege>"
+	}
+	return davProps{
+		propResourceType:         "<D:collection/><C:calendar/>",
+		propDisplayName:          xmlEscape(login),
+		propCurrentUserPrincipal: hrefXml(principalHref(current)),
+		propOwner:                hrefXml(principalHref(login)),
+		propSupportedComponents:  `<C:comp name="VEVENT"/>`,
+		propSupportedReportSet:   "<D:supported-report><D:report><C:calendar-query/></D:report></D:supported-report><D:supported-report><D:report><C:calendar-multiget/></D:report></D:supported-report>",
+		propPrivilegeSet:         privileges,
+		propGetCtag:              xmlEscape(hex.EncodeToString(ctag[:])),
+	}, nil
+}
+
+func (s *Service) DavCalendar(w http.ResponseWriter, r *http.Request) {
+	pol, ok := s.davPolicy(w, r)
+	if !ok {
+		return
+	}
+	login := mux.Vars(r)["login"]
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorizeCalendar(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if mux.Vars(r)["calendar"] != defaultCalendar {
+		writeError(w, r, notFound("calendar %q not found", mux.Vars(r)["calendar"]))
+		return
+	}
+	switch r.Method {
+	case "OPTIONS":
+		davOptions(w)
+	case "PROPFIND":
+		props, err := s.calendarProps(pol, login)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		responses := []davResponse{{Href: calendarHref(login), Props: props}}
+		if r.Header.Get("Depth") != "0" {
+			meetings, err := s.calendarMeetings(login)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			for i := range meetings {
+				responses = append(responses, davResponse{Href: s.objectHref(login, &meetings[i]), Props: s.objectProps(pol, &meetings[i])})
+			}
+		}
+		s.propfind(w, r, responses)
+	case "REPORT":
+		s.report(w, r, pol, login)
+	default:
+		writeError(w, r, &ApiError{Status: http.StatusMethodNotAllowed, Code: CodeMethodNotAllowed, Message: "method not allowed"})
+	}
+}
+
+func (s *Service) calendarMeetings(login string) ([]Meeting, error) {
+	cursor, err := s.DbClient.Database("db").Collection("meetings").Find(context.TODO(), participantFilter([]string{login}))
+	if err != nil {
+		return nil, err
+	}
+	meetings := []Meeting{}
+	if err = cursor.All(context.TODO(), &meetings); err != nil {
+		return nil, err
+	}
+	return meetings, nil
+}
+
+func (s *Service) resourceName(meeting *Meeting) string {
+	if meeting.ResourceName != "" {
+		return meeting.ResourceName
+	}
+	return s.meetingUid(meeting)
+}
+
+func (s *Service) objectHref(login string, meeting *Meeting) string {
+	return calendarHref(login) + url.PathEscape(s.resourceName(meeting)) + ".ics"
+}
+
+// encodeObject renders a calendar object resource. DTSTAMP is the creation
+// time of the meeting, so that the rendering and its etag are stable.
+func (s *Service) encodeObject(meeting *Meeting) string {
+	stamp := time.Unix(0, 0)
+	if objectId, err := primitive.ObjectIDFromHex(meeting.Id); err == nil {
+		stamp = objectId.Timestamp()
+	}
+	w := &icalWriter{}
+	w.beginCalendar()
+	writeTimeZones(w, stamp, meeting)
+	s.writeEvent(w, meeting, stamp)
+	w.line("END", "VCALENDAR")
+	return w.String()
+}
+
+func (s *Service) meetingEtag(meeting *Meeting) string {
+	sum := sha1.Sum([]byte(s.encodeObject(meeting)))
+	return `"` + hex.EncodeToString(sum[:10]) + `"`
+}
+
+// objectProps describes the calendar object of the meeting as the principal
+// of pol may see it, hidden meetings are busy events.
+func (s *Service) objectProps(pol *policy, meeting *Meeting) davProps {
+	view := pol.view(meeting)
+	return davProps{
+		propResourceType:   "",
+		propGetEtag:        xmlEscape(s.meetingEtag(&view)),
+		propGetContentType: "text/calendar; charset=utf-8; component=VEVENT",
+		propCalendarData:   xmlEscape(s.encodeObject(&view)),
+	}
+}
+
+// findMeetingByResource looks a calendar object up by the name of its
+// resource, meetings created through the api are named by their id.
+func (s *Service) findMeetingByResource(login, name string) (*Meeting, error) {
+	filters := bson.A{bson.D{{"resourceName", name}}, bson.D{{"uid", name}}}
+	if objectId, err := primitive.ObjectIDFromHex(strings.TrimSuffix(name, "@calendar")); err == nil && strings.HasSuffix(name, "@calendar") {
+		filters = append(filters, bson.D{{"_id", objectId}})
+	}
+	var meeting Meeting
+	filter := bson.D{{"$and", bson.A{participantFilter([]string{login}), bson.D{{"$or", filters}}}}}
+	err := s.DbClient.Database("db").Collection("meetings").FindOne(context.TODO(), filter).Decode(&meeting)
+	if err != nil {
+		return nil, err
+	}
+	return &meeting, nil
+}
+
+func (s *Service) DavObject(w http.ResponseWriter, r *http.Request) {
+	pol, ok := s.davPolicy(w, r)
+	if !ok {
+		return
+	}
+	vars := mux.Vars(r)
+	current, login := pol.principal.login, vars["login"]
+	if err := pol.authorizeCalendar(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if vars["calendar"] != defaultCalendar {
+		writeError(w, r, notFound("calendar %q not found", vars["calendar"]))
+		return
+	}
+	existing, err := s.findMeetingByResource(login, vars["resource"])
+	if err != nil && err != mongo.ErrNoDocuments {
+		writeError(w, r, err)
+		return
+	}
+	if r.Method == "OPTIONS" {
+		davOptions(w)
+		return
+	}
+	if existing == nil && r.Method != "PUT" {
+		writeError(w, r, notFound("%s not found", r.URL.Path))
+		return
+	}
+	switch r.Method {
+	case "GET":
+		view := pol.view(existing)
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("ETag", s.meetingEtag(&view))
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, s.encodeObject(&view))
+	case "PROPFIND":
+		s.propfind(w, r, []davResponse{{Href: s.objectHref(login, existing), Props: s.objectProps(pol, existing)}})
+	case "PUT":
+		if login != current {
+			writeError(w, r, &ApiError{Status: http.StatusForbidden, Code: CodeForbidden, Message: "can only write to your own calendar"})
+			return
+		}
+		s.putObject(w, r, login, vars["resource"], existing)
+	case "DELETE":
+		if login != current {
+			writeError(w, r, &ApiError{Status: http.StatusForbidden, Code: CodeForbidden, Message: "can only write to your own calendar"})
+			return
+		}
+		if match := r.Header.Get("If-Match"); match != "" && match != "*" && match != s.meetingEtag(existing) {
+			writeError(w, r, &ApiError{Status: http.StatusPreconditionFailed, Code: CodePreconditionFailed, Message: "etag does not match"})
+			return
+		}
+		if err := s.removeFromCalendar(login, existing); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, r, &ApiError{Status: http.StatusMethodNotAllowed, Code: CodeMethodNotAllowed, Message: "method not allowed"})
+	}
+}
+
+func (s *Service) putObject(w http.ResponseWriter, r *http.Request, login, name string, existing *Meeting) {
+	if existing != nil && r.Header.Get("If-None-Match") == "*" ||
+		r.Header.Get("If-Match") != "" && (existing == nil || r.Header.Get("If-Match") != "*" && r.Header.Get("If-Match") != s.meetingEtag(existing)) {
+		writeError(w, r, &ApiError{Status: http.StatusPreconditionFailed, Code: CodePreconditionFailed, Message: "etag does not match"})
+		return
+	}
+	calendar, err := parseCalendar(http.MaxBytesReader(w, r.Body, maxImportSize))
+	if err != nil {
+		writeError(w, r, badRequest("malformed iCalendar: %v", err))
+		return
+	}
+	var event *icalComponent
+	for _, candidate := range calendar.children("VEVENT") {
+		// overridden occurrences can not be represented and are dropped
+		if candidate.property("RECURRENCE-ID") == nil {
+			event = candidate
+			break
+		}
+	}
+	if event == nil || event.value("UID") == "" {
+		writeError(w, r, validationFailed("expected a VEVENT with a UID"))
+		return
+	}
+
+	if existing != nil && existing.Owner != login {
+		// invitees can only change their participation status
+		s.putParticipation(w, r, login, event, existing)
+		return
+	}
+	meeting, _, err := s.meetingFromEvent(login, event, makeTimeZones(calendar))
+	if err != nil {
+		writeError(w, r, validationFailed(err.Error()))
+		return
+	}
+	if meeting.Owner != login {
+		writeError(w, r, &ApiError{Status: http.StatusForbidden, Code: CodeForbidden, Message: "can only organize meetings as yourself"})
+		return
+	}
+	if existing == nil {
+		_, err := s.findMeetingByUid(meeting.Uid)
+		if err == nil {
+			writeError(w, r, conflict("a meeting with UID %q already exists", meeting.Uid))
+			return
+		}
+		if err != mongo.ErrNoDocuments {
+			writeError(w, r, err)
+			return
+		}
+	}
+	if name != meeting.Uid {
+		meeting.ResourceName = name
+	}
+	if existing != nil {
+		meeting.Uid, meeting.ResourceName = existing.Uid, existing.ResourceName
+		// reminders are not carried by calendar objects
+		meeting.Reminders = existing.Reminders
+		keepCalendars(meeting, existing)
+		keepGroups(meeting, existing)
+		keepFiles(meeting, existing)
+		if meeting.Sequence <= existing.Sequence {
+			meeting.Sequence = existing.Sequence + 1
+		}
+	}
+	if err := s.validateMeeting(meeting); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	meeting.UpdatedBy = login
+	if err := s.storeMeeting(meeting, existing); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.notifyInvitees(existing, meeting)
+	w.Header().Set("ETag", s.meetingEtag(meeting))
+	if existing == nil {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Service) putParticipation(w http.ResponseWriter, r *http.Request, login string, event *icalComponent, existing *Meeting) {
+	choice := NotReviewed
+	for _, attendee := range event.all("ATTENDEE") {
+		if s.resolveAddress(attendee.Value) != login {
+			continue
+		}
+		switch strings.ToUpper(attendee.Params["PARTSTAT"]) {
+		case "ACCEPTED":
+			choice = Accepted
+		case "DECLINED":
+			choice = Declined
+		}
+	}
+	meeting, err := s.respond(existing.Id, login, choice, login, "")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("ETag", s.meetingEtag(meeting))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeFromCalendar deletes meetings organized by login, invitees only
+// drop their invitation.
+func (s *Service) removeFromCalendar(login string, meeting *Meeting) error {
+	if meeting.Owner == login {
+		meeting.UpdatedBy = login
+		return s.deleteMeeting(meeting)
+	}
+	objectId, err := primitive.ObjectIDFromHex(meeting.Id)
+	if err != nil {
+		return err
+	}
+	version, err := s.nextVersion()
+	if err != nil {
+		return err
+	}
+	update := bson.D{
+		{"$pull", bson.D{{"invited", bson.D{{"invitee", login}}}}},
+		{"$addToSet", bson.D{{"left", login}}},
+		{"$set", bson.D{{"version", version}, {"updatedAt", time.Now().UTC()}, {"removed", bson.A{login}}}},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated Meeting
+	filter := bson.D{{"_id", objectId}, {"deleted", bson.D{{"$ne", true}}}}
+	err = s.DbClient.Database("db").Collection("meetings").FindOneAndUpdate(context.TODO(), filter, update, opts).Decode(&updated)
+	if err != nil {
+		return err
+	}
+	s.emit(MeetingUpdated, &updated, "")
+	return nil
+}
+
+func (s *Service) report(w http.ResponseWriter, r *http.Request, pol *policy, login string) {
+	var request reportRequest
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, badRequest("malformed REPORT body: %v", err))
+		return
+	}
+	names := []xml.Name{propGetEtag}
+	if request.Prop != nil {
+		names = names[:0]
+		for _, name := range request.Prop.Names {
+			names = append(names, name.XMLName)
+		}
+	}
+	meetings := []Meeting{}
+	switch request.XMLName {
+	case xml.Name{nsCalDav, "calendar-query"}:
+		all, err := s.calendarMeetings(login)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		var filter *compFilter
+		if request.Filter != nil {
+			filter = &request.Filter.Comp
+		}
+		for i := range all {
+			if matchesFilter(&all[i], filter) {
+				meetings = append(meetings, all[i])
+			}
+		}
+	case xml.Name{nsCalDav, "calendar-multiget"}:
+		responses := []davResponse{}
+		for _, href := range request.Hrefs {
+			name := strings.TrimSuffix(href[strings.LastIndex(href, "/")+1:], ".ics")
+			if unescaped, err := url.PathUnescape(name); err == nil {
+				name = unescaped
+			}
+			meeting, err := s.findMeetingByResource(login, name)
+			if err == mongo.ErrNoDocuments {
+				responses = append(responses, davResponse{Href: href, Status: http.StatusNotFound})
+				continue
+			}
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			responses = append(responses, selectProps(davResponse{Href: href, Props: s.objectProps(pol, meeting)}, names))
+		}
+		writeMultistatus(w, responses)
+		return
+	default:
+		writeError(w, r, &ApiError{Status: http.StatusForbidden, Code: CodeForbidden, Message: fmt.Sprintf("unsupported report %s", request.XMLName.Local)})
+		return
+	}
+	responses := []davResponse{}
+	for i := range meetings {
+		responses = append(responses, selectProps(davResponse{Href: s.objectHref(login, &meetings[i]), Props: s.objectProps(pol, &meetings[i])}, names))
+	}
+	writeMultistatus(w, responses)
+}
+
+// matchesFilter implements the VCALENDAR/VEVENT comp-filters with an
+// optional time-range, which is what clients use to sync a window.
+func matchesFilter(meeting *Meeting, filter *compFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.Name != "VCALENDAR" && filter.Name != "VEVENT" {
+		return false
+	}
+	if filter.TimeRange != nil {
+		start, errStart := time.Parse(icalDateLayout, filter.TimeRange.Start)
+		end, errEnd := time.Parse(icalDateLayout, filter.TimeRange.End)
+		occurrence := meeting
+		if errStart == nil && meeting.Reoccurance != NoReoccurence && meeting.EndTime.Before(start) {
+			occurrence = meeting.NextOccurence(&start)
+		}
+		if occurrence == nil || errStart == nil && !occurrence.EndTime.After(start) || errEnd == nil && !occurrence.StartTime.Before(end) {
+			return false
+		}
+	}
+	for i := range filter.Filters {
+		if !matchesFilter(meeting, &filter.Filters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) propfind(w http.ResponseWriter, r *http.Request, responses []davResponse) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, r, badRequest("failed to read request body: %v", err))
+		return
+	}
+	var request propfindRequest
+	if len(bytes.TrimSpace(body)) != 0 {
+		if err := xml.Unmarshal(body, &request); err != nil {
+			writeError(w, r, badRequest("malformed PROPFIND body: %v", err))
+			return
+		}
+	}
+	if request.Prop == nil {
+		// allprop, calendar data is only returned when asked for
+		for i := range responses {
+			delete(responses[i].Props, propCalendarData)
+		}
+		writeMultistatus(w, responses)
+		return
+	}
+	names := []xml.Name{}
+	for _, name := range request.Prop.Names {
+		names = append(names, name.XMLName)
+	}
+	for i := range responses {
+		responses[i] = selectProps(responses[i], names)
+	}
+	writeMultistatus(w, responses)
+}
+
+func selectProps(response davResponse, names []xml.Name) davResponse {
+	selected := davProps{}
+	for _, name := range names {
+		if value, ok := response.Props[name]; ok {
+			selected[name] = value
+		} else {
+			response.Missing = append(response.Missing, name)
+		}
+	}
+	response.Props = selected
+	return response
+}
+
+func writeMultistatus(w http.ResponseWriter, responses []davResponse) {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	b.WriteString(`<D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav" xmlns:CS="http://calendarserver.org/ns/">`)
+	for _, response := range responses {
+		b.WriteString("<D:response>")
+		b.WriteString(hrefXml(response.Href))
+		if response.Status != 0 {
+			fmt.Fprintf(&b, "<D:status>HTTP/1.1 %d %s</D:status>", response.Status, http.StatusText(response.Status))
+		}
+		if len(response.Props) != 0 {
+			names := make([]xml.Name, 0, len(response.Props))
+			for name := range response.Props {
+				names = append(names, name)
+			}
+			sort.Slice(names, func(i, j int) bool {
+				return names[i].Space+names[i].Local < names[j].Space+names[j].Local
+			})
+			b.WriteString("<D:propstat><D:prop>")
+			for _, name := range names {
+				writeElement(&b, name, response.Props[name])
+			}
+			b.WriteString("</D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat>")
+		}
+		if len(response.Missing) != 0 {
+			b.WriteString("<D:propstat><D:prop>")
+			for _, name := range response.Missing {
+				writeElement(&b, name, "")
+			}
+			b.WriteString("</D:prop><D:status>HTTP/1.1 404 Not Found</D:status></D:propstat>")
+		}
+		b.WriteString("</D:response>")
+	}
+	b.WriteString("</D:multistatus>\n")
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	io.WriteString(w, b.String())
+}
+
+func writeElement(b *strings.Builder, name xml.Name, inner string) {
+	tag := ""
+	declaration := ""
+	if prefix, ok := davPrefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+	} else {
+		tag = "X:" + name.Local
+		declaration = ` xmlns:X="` + xmlEscape(name.Space) + `"`
+	}
+	if inner == "" {
+		fmt.Fprintf(b, "<%s%s/>", tag, declaration)
+		return
+	}
+	fmt.Fprintf(b, "<%s%s>%s</%s>", tag, declaration, inner, tag)
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"html"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDavObjectView(t *testing.T) {
+	s := &Service{}
+	meeting := &Meeting{
+		Id:         "640a4862377457548608f50a",
+		Owner:      "bob",
+		Invited:    []Invitation{{Invitee: "alice"}},
+		StartTime:  time.Date(2023, 3, 7, 16, 0, 0, 0, time.UTC),
+		EndTime:    time.Date(2023, 3, 7, 16, 30, 0, 0, time.UTC),
+		Title:      "Salary review",
+		Visibility: VisibilityPrivate,
+	}
+	alice := &policy{principal: &principal{login: "alice"}, shared: map[string]ShareLevel{}}
+	require.Contains(t, html.UnescapeString(s.objectProps(alice, meeting)[propCalendarData]), "SUMMARY:Salary review")
+
+	// users sharing free/busy only see that the time is taken
+	carol := &policy{principal: &principal{login: "carol"}, shared: map[string]ShareLevel{"bob": ShareFreeBusy}}
+	props := s.objectProps(carol, meeting)
+	data := html.UnescapeString(props[propCalendarData])
+	require.Contains(t, data, "SUMMARY:Busy")
+	require.NotContains(t, data, "Salary")
+	require.NotContains(t, data, "alice")
+	require.NotEqual(t, s.objectProps(alice, meeting)[propGetEtag], props[propGetEtag])
+}
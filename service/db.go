@@ -24,3 +24,96 @@ func ConnectDb() (*mongo.Client, error) {
 	log.Println("Pinged your deployment. You successfully connected to MongoDB!")
 	return client, nil
 }
+
+func EnsureIndexes(db *mongo.Database) error {
+	_, err := db.Collection("users").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{"login", 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("users").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys: bson.D{{"shares.grantee", 1}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("tokens").Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{"hash", 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{"login", 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("groups").Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{"name", 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{"groups", 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("meetings").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{"groups", 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("calendars").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys: bson.D{{"owner", 1}, {"name", 1}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("meetings").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{"uid", 1}},
+		Options: options.Index().SetUnique(true).SetSparse(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("meetings").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys: bson.D{{"version", 1}, {"_id", 1}},
+	})
+	if err != nil {
+		return err
+	}
+	// see SearchMeetings
+	_, err = db.Collection("meetings").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{"title", "text"}, {"description", "text"}, {"location", "text"}},
+		Options: options.Index().SetName("search").SetWeights(bson.D{{"title", 5}}),
+	})
+	if err != nil {
+		return err
+	}
+	// meetings stored before versions were introduced sync as version 0
+	_, err = db.Collection("meetings").UpdateMany(context.TODO(),
+		bson.D{{"version", bson.D{{"$exists", false}}}},
+		bson.D{{"$set", bson.D{{"version", int64(0)}, {"createdVersion", int64(0)}}}})
+	if err != nil {
+		return err
+	}
+	// reminders look for the meetings of everyone that start soon
+	_, err = db.Collection("meetings").Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{"startTime", 1}}},
+		{Keys: bson.D{{"endTime", 1}}},
+		{Keys: bson.D{{"reoccurance", 1}, {"startTime", 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	// claims of reminders are only needed while the reminder may still fire
+	_, err = db.Collection("reminders").Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{"startTime", 1}},
+		Options: options.Index().SetExpireAfterSeconds(2 * 24 * 60 * 60),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("deliveries").Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{"status", 1}, {"nextAttempt", 1}}},
+		{Keys: bson.D{{"webhookId", 1}, {"createdAt", -1}}},
+	})
+	return err
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ErrorCode string
+
+var (
+	CodeBadRequest         ErrorCode = "bad_request"
+	CodeUnauthorized       ErrorCode = "unauthorized"
+	CodeForbidden          ErrorCode = "forbidden"
+	CodeNotFound           ErrorCode = "not_found"
+	CodeMethodNotAllowed   ErrorCode = "method_not_allowed"
+	CodeConflict           ErrorCode = "conflict"
+	CodePreconditionFailed ErrorCode = "precondition_failed"
+	CodeValidationFailed   ErrorCode = "validation_failed"
+	CodeTooLarge           ErrorCode = "too_large"
+	CodeSyncTokenExpired   ErrorCode = "sync_token_expired"
+	CodeInternal           ErrorCode = "internal"
+)
+
+type ErrorDetail struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ApiError is the envelope every failed request is answered with.
+type ApiError struct {
+	Status    int           `json:"-"`
+	Code      ErrorCode     `json:"code"`
+	Message   string        `json:"message"`
+	Details   []ErrorDetail `json:"details,omitempty"`
+	RequestId string        `json:"requestId,omitempty"`
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func badRequest(format string, args ...any) *ApiError {
+	return &ApiError{Status: http.StatusBadRequest, Code: CodeBadRequest, Message: fmt.Sprintf(format, args...)}
+}
+
+func unauthorized(format string, args ...any) *ApiError {
+	return &ApiError{Status: http.StatusUnauthorized, Code: CodeUnauthorized, Message: fmt.Sprintf(format, args...)}
+}
+
+func forbidden(format string, args ...any) *ApiError {
+	return &ApiError{Status: http.StatusForbidden, Code: CodeForbidden, Message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...any) *ApiError {
+	return &ApiError{Status: http.StatusNotFound, Code: CodeNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func conflict(format string, args ...any) *ApiError {
+	return &ApiError{Status: http.StatusConflict, Code: CodeConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+func tooLarge(format string, args ...any) *ApiError {
+	return &ApiError{Status: http.StatusRequestEntityTooLarge, Code: CodeTooLarge, Message: fmt.Sprintf(format, args...)}
+}
+
+func validationFailed(message string, details ...ErrorDetail) *ApiError {
+	return &ApiError{Status: http.StatusUnprocessableEntity, Code: CodeValidationFailed, Message: message, Details: details}
+}
+
+// validationDetails returns the details of err when it is a failed
+// validation, so that they can be reported together with others.
+func validationDetails(err error) ([]ErrorDetail, bool) {
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != CodeValidationFailed {
+		return nil, false
+	}
+	if len(apiErr.Details) == 0 {
+		return []ErrorDetail{{Message: apiErr.Message}}, true
+	}
+	return apiErr.Details, true
+}
+
+// toApiError maps arbitrary errors onto the public envelope, so that driver
+// messages never reach the client.
+func toApiError(err error) *ApiError {
+	var apiErr *ApiError
+	switch {
+	case errors.As(err, &apiErr):
+		copied := *apiErr
+		return &copied
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return notFound("not found")
+	case mongo.IsDuplicateKeyError(err):
+		return conflict("already exists")
+	default:
+		return &ApiError{Status: http.StatusInternalServerError, Code: CodeInternal, Message: "internal error"}
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr := toApiError(err)
+	apiErr.RequestId = requestId(r.Context())
+	if apiErr.Status >= http.StatusInternalServerError {
+		log.Printf("request %s: %s %s: %v", apiErr.RequestId, r.Method, r.URL.Path, err)
+	}
+	writeJson(w, apiErr.Status, apiErr)
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+type requestIdKey struct{}
+
+func requestId(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey{}).(string)
+	return id
+}
+
+func withRequestId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-Id")
+		if id == "" {
+			id = primitive.NewObjectID().Hex()
+		}
+		w.Header().Set("X-Request-Id", id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIdKey{}, id)))
+	})
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type EventType string
+
+var (
+	MeetingCreated   EventType = "meeting.created"
+	MeetingUpdated   EventType = "meeting.updated"
+	MeetingDeleted   EventType = "meeting.deleted"
+	MeetingResponded EventType = "meeting.responded"
+	MeetingReminder  EventType = "meeting.reminder"
+	// MeetingRemoved tells a participant a change dropped them from the
+	// meeting, which they may not see anymore.
+	MeetingRemoved EventType = "meeting.removed"
+)
+
+var eventTypes = []EventType{MeetingCreated, MeetingUpdated, MeetingDeleted, MeetingResponded, MeetingReminder, MeetingRemoved}
+
+// Event describes a change of a meeting, it is what webhooks and streams
+// receive.
+type Event struct {
+	Id      string    `json:"id" bson:"id"`
+	Type    EventType `json:"type" bson:"type"`
+	Time    time.Time `json:"time" bson:"time"`
+	Meeting Meeting   `json:"meeting" bson:"meeting"`
+	Login   string    `json:"login,omitempty" bson:"login,omitempty"` // the invitee who responded, who is reminded, or who was removed
+}
+
+// emit publishes a change of a meeting, and its removal to the participants
+// the change dropped. It is called by the helpers every write path goes
+// through: storeMeeting, deleteMeeting, removeFromCalendar and respond.
+func (s *Service) emit(eventType EventType, meeting *Meeting, login string) {
+	events := []*Event{newEvent(eventType, meeting, login)}
+	if eventType == MeetingUpdated {
+		events = append(events, removals(meeting)...)
+	}
+	for _, event := range events {
+		if s.localEvents.Load() {
+			s.streams.publish(event)
+		}
+		if err := s.enqueueDeliveries(event); err != nil {
+			log.Printf("event %s %s of meeting %s: %v", event.Id, event.Type, meeting.Id, err)
+		}
+	}
+}
+
+func newEvent(eventType EventType, meeting *Meeting, login string) *Event {
+	return &Event{
+		Id:      primitive.NewObjectID().Hex(),
+		Type:    eventType,
+		Time:    time.Now().UTC(),
+		Meeting: *meeting,
+		Login:   login,
+	}
+}
+
+// removals are the meeting.removed events of the participants dropped by the
+// last change of the meeting, with the meeting as they see it now.
+func removals(meeting *Meeting) []*Event {
+	events := []*Event{}
+	for _, login := range meeting.Removed {
+		pol := &policy{principal: &principal{login: login}}
+		view := pol.view(meeting)
+		events = append(events, newEvent(MeetingRemoved, &view, login))
+	}
+	return events
+}
+
+// recipients are the users whose webhooks and streams receive the event.
+func (e *Event) recipients() []string {
+	if e.Type == MeetingReminder || e.Type == MeetingRemoved {
+		return []string{e.Login}
+	}
+	return e.Meeting.participants()
+}
+
+func (m *Meeting) participants() []string {
+	logins := []string{m.Owner}
+	for _, invitation := range m.Invited {
+		if invitation.Invitee != m.Owner {
+			logins = append(logins, invitation.Invitee)
+		}
+	}
+	return logins
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Files uploaded to meetings are stored in the GridFS bucket "attachments",
+// with the id of their meeting in the metadata, and are attachments of the
+// meeting with the id of the file. Only the attachment endpoints add and
+// remove them, the files go when their meeting is deleted. Whoever sees the
+// details of a meeting may download its files.
+
+const maxFileSize = 25 << 20
+
+func (s *Service) files() (*gridfs.Bucket, error) {
+	return gridfs.NewBucket(s.DbClient.Database("db"), options.GridFSBucket().SetName("attachments"))
+}
+
+func fileUrl(meetingId, fileId string) string {
+	return "/api/meetings/" + meetingId + "/attachments/" + fileId
+}
+
+// UploadAttachment stores a file, the "file" field of a form or the body
+// named by ?name=, and attaches it to the meeting.
+func (s *Service) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	meeting, err := s.findMeeting(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorize(actionEdit, meeting); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if len(meeting.Attachments) >= maxAttachments {
+		writeError(w, r, validationFailed("invalid attachment", ErrorDetail{"attachments", fmt.Sprintf("at most %d attachments", maxAttachments)}))
+		return
+	}
+	// room for the form around the file
+	r.Body = http.MaxBytesReader(w, r.Body, maxFileSize+1<<20)
+	name, declared, body, err := uploadedFile(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxAttachmentName {
+		writeError(w, r, validationFailed("invalid attachment", ErrorDetail{"name", fmt.Sprintf("is required, at most %d characters", maxAttachmentName)}))
+		return
+	}
+	buffered := bufio.NewReaderSize(body, 512)
+	head, _ := buffered.Peek(512)
+	attachment := Attachment{Name: name, MimeType: fileType(head, declared)}
+
+	bucket, err := s.files()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	file := &countingReader{r: io.LimitReader(buffered, maxFileSize+1)}
+	fileId, err := bucket.UploadFromStream(name, file, options.GridFSUpload().SetMetadata(bson.D{{"meetingId", meeting.Id}}))
+	var maxBytes *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytes):
+		writeError(w, r, tooLarge("files are at most %d MiB", maxFileSize>>20))
+		return
+	case err != nil:
+		writeError(w, r, err)
+		return
+	case file.n > maxFileSize:
+		bucket.Delete(fileId)
+		writeError(w, r, tooLarge("files are at most %d MiB", maxFileSize>>20))
+		return
+	}
+	attachment.Id, attachment.Size = fileId.Hex(), file.n
+	attachment.Url = fileUrl(meeting.Id, attachment.Id)
+
+	err = s.changeAttachments(pol, meeting.Id, func(meeting *Meeting) error {
+		if len(meeting.Attachments) >= maxAttachments {
+			return validationFailed("invalid attachment", ErrorDetail{"attachments", fmt.Sprintf("at most %d attachments", maxAttachments)})
+		}
+		meeting.Attachments = append(meeting.Attachments, attachment)
+		return nil
+	})
+	if err != nil {
+		bucket.Delete(fileId)
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, attachment)
+}
+
+// changeAttachments changes the attachments of the meeting and stores it.
+// Uploads take a while, when the meeting was changed meanwhile the change is
+// applied again to the meeting as it is now.
+func (s *Service) changeAttachments(pol *policy, meetingId string, change func(meeting *Meeting) error) error {
+	for attempt := 1; ; attempt++ {
+		meeting, err := s.findMeeting(meetingId)
+		if err != nil {
+			return err
+		}
+		if err := pol.authorize(actionEdit, meeting); err != nil {
+			return err
+		}
+		updated := *meeting
+		updated.Attachments = append([]Attachment{}, meeting.Attachments...)
+		if err := change(&updated); err != nil {
+			return err
+		}
+		updated.UpdatedBy = pol.principal.login
+		err = s.storeMeeting(&updated, meeting)
+		if err != errMeetingChanged || attempt == 5 {
+			return err
+		}
+	}
+}
+
+// uploadedFile returns the name, the declared type and the content of the
+// file of an upload.
+func uploadedFile(r *http.Request) (string, string, io.Reader, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return r.URL.Query().Get("name"), r.Header.Get("Content-Type"), r.Body, nil
+	}
+	form, err := r.MultipartReader()
+	if err != nil {
+		return "", "", nil, badRequest("malformed form: %v", err)
+	}
+	for {
+		part, err := form.NextPart()
+		if err == io.EOF {
+			return "", "", nil, badRequest("expected the file in the \"file\" form field")
+		}
+		if err != nil {
+			return "", "", nil, badRequest("malformed form: %v", err)
+		}
+		if part.FormName() == "file" {
+			return part.FileName(), part.Header.Get("Content-Type"), part, nil
+		}
+	}
+}
+
+// fileType is the type of a file starting with head, as its content tells.
+// The declared type is taken for content that isn't told apart, e.g. office
+// documents, which are zip archives.
+func fileType(head []byte, declared string) string {
+	sniffed := http.DetectContentType(head)
+	mediaType, params, err := mime.ParseMediaType(declared)
+	if err != nil || !strings.Contains(mediaType, "/") || mediaType == "application/octet-stream" {
+		return sniffed
+	}
+	switch sniffed {
+	case "application/octet-stream", "application/zip":
+		return mime.FormatMediaType(mediaType, params)
+	}
+	return sniffed
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// storedFile returns the attachment of the meeting that is the file fileId.
+func storedFile(meeting *Meeting, fileId string) (*Attachment, error) {
+	for i := range meeting.Attachments {
+		if fileId != "" && meeting.Attachments[i].Id == fileId {
+			return &meeting.Attachments[i], nil
+		}
+	}
+	return nil, notFound("attachment %q not found", fileId)
+}
+
+// DownloadAttachment sends a file of the meeting, always as a download, so
+// that browsers don't render it.
+func (s *Service) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	meeting, err := s.findMeeting(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorize(actionView, meeting); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	attachment, err := storedFile(meeting, mux.Vars(r)["attachmentId"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	fileId, err := primitive.ObjectIDFromHex(attachment.Id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	bucket, err := s.files()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	download, err := bucket.OpenDownloadStream(fileId)
+	if errors.Is(err, gridfs.ErrFileNotFound) {
+		writeError(w, r, notFound("attachment %q not found", attachment.Id))
+		return
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer download.Close()
+	mimeType := attachment.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", mimeType)
+	w.Header().Set("Content-Length", strconv.FormatInt(download.GetFile().Length, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := io.Copy(w, download); err != nil {
+		log.Printf("download of attachment %s of meeting %s: %v", attachment.Id, meeting.Id, err)
+	}
+}
+
+// DeleteAttachment removes a file from the meeting and deletes it.
+func (s *Service) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	meeting, err := s.findMeeting(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorize(actionEdit, meeting); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	attachment, err := storedFile(meeting, mux.Vars(r)["attachmentId"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	fileId, err := primitive.ObjectIDFromHex(attachment.Id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	err = s.changeAttachments(pol, meeting.Id, func(meeting *Meeting) error {
+		attachments := []Attachment{}
+		for _, other := range meeting.Attachments {
+			if other.Id != attachment.Id {
+				attachments = append(attachments, other)
+			}
+		}
+		meeting.Attachments = attachments
+		return nil
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// the meeting doesn't refer to the file anymore, at worst it is left over
+	if err := s.deleteFiles(bson.D{{"_id", fileId}}); err != nil {
+		log.Printf("deleting attachment %s of meeting %s: %v", attachment.Id, meeting.Id, err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteFiles deletes the stored files matching filter, e.g. the ones of a
+// meeting.
+func (s *Service) deleteFiles(filter bson.D) error {
+	bucket, err := s.files()
+	if err != nil {
+		return err
+	}
+	cursor, err := bucket.Find(filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(context.TODO())
+	for cursor.Next(context.TODO()) {
+		var file struct {
+			Id primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&file); err != nil {
+			return err
+		}
+		if err := bucket.Delete(file.Id); err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// keepFiles keeps the files of existing with a meeting replacing it, files
+// are added and removed by the attachment endpoints only. Attachments the
+// meeting claims to be files are dropped.
+func keepFiles(meeting, existing *Meeting) {
+	var attachments []Attachment
+	for _, attachment := range meeting.Attachments {
+		if attachment.Id == "" {
+			attachments = append(attachments, attachment)
+		}
+	}
+	if existing != nil {
+		for _, attachment := range existing.Attachments {
+			if attachment.Id != "" {
+				attachments = append(attachments, attachment)
+			}
+		}
+	}
+	meeting.Attachments = attachments
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileType(t *testing.T) {
+	pdf := []byte("%PDF-1.4\n")
+	require.Equal(t, "application/pdf", fileType(pdf, ""))
+	require.Equal(t, "application/pdf", fileType(pdf, "application/octet-stream"))
+	// the content wins over the declared type
+	require.Equal(t, "application/pdf", fileType(pdf, "text/html"))
+	require.Equal(t, "text/html; charset=utf-8", fileType([]byte("<html><script>"), "image/png"))
+
+	// content that isn't told apart
+	docx := "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	require.Equal(t, docx, fileType([]byte("PK\x03\x04"), docx))
+	require.Equal(t, "application/zip", fileType([]byte("PK\x03\x04"), "not a type"))
+	require.Equal(t, "application/x-custom", fileType([]byte{0, 1, 2}, "application/x-custom"))
+}
+
+func TestKeepFiles(t *testing.T) {
+	link := Attachment{Name: "notes", Url: "https://files.example.com/notes"}
+	stored := Attachment{Id: "640a4862377457548608f50b", Name: "agenda.pdf", Url: fileUrl("640a4862377457548608f50a", "640a4862377457548608f50b"), Size: 10}
+	existing := &Meeting{Id: "640a4862377457548608f50a", Attachments: []Attachment{link, stored}}
+
+	// files are neither dropped nor made up by updates
+	meeting := &Meeting{Attachments: []Attachment{{Id: "640a4862377457548608f50c", Name: "forged", Url: "/elsewhere"}}}
+	keepFiles(meeting, existing)
+	require.Equal(t, []Attachment{stored}, meeting.Attachments)
+	meeting = &Meeting{Attachments: []Attachment{link, stored}}
+	keepFiles(meeting, existing)
+	require.Equal(t, []Attachment{link, stored}, meeting.Attachments)
+	keepFiles(meeting, nil)
+	require.Equal(t, []Attachment{link}, meeting.Attachments)
+
+	found, err := storedFile(existing, stored.Id)
+	require.NoError(t, err)
+	require.Equal(t, stored, *found)
+	_, err = storedFile(existing, "")
+	require.Equal(t, CodeNotFound, toApiError(err).Code)
+}
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Groups are invited in two ways. "team:" followed by the name of a group
+// among the invitees stands for the members of the group when the meeting is
+// saved. A group in the Groups of a meeting keeps its members invited as they
+// change: they are invitations like any other, with their Group set, which
+// are refreshed whenever the group, or a group nested in it, changes.
+
+const groupPrefix = "team:"
+
+var groupNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+func (s *Service) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var group Group
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	group.Owner = actingLogin(r, group.Owner)
+	if err := s.validateGroup(&group); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	group.Id = ""
+	_, err := s.DbClient.Database("db").Collection("groups").InsertOne(context.TODO(), group)
+	if mongo.IsDuplicateKeyError(err) {
+		writeError(w, r, conflict("group %q already exists", group.Name))
+		return
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, group)
+}
+
+func (s *Service) ListGroups(w http.ResponseWriter, r *http.Request) {
+	opts := options.Find().SetSort(bson.D{{"name", 1}})
+	cursor, err := s.DbClient.Database("db").Collection("groups").Find(context.TODO(), bson.D{}, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	groups := []Group{}
+	if err = cursor.All(context.TODO(), &groups); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, groups)
+}
+
+func (s *Service) GetGroup(w http.ResponseWriter, r *http.Request) {
+	group, err := s.findGroup(mux.Vars(r)["name"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, group)
+}
+
+// UpdateGroup replaces the members and nested groups of a group, meetings
+// inviting it invite its new members.
+func (s *Service) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+	existing, err := s.findGroup(mux.Vars(r)["name"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := authorize(r, existing.Owner); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var group Group
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	group.Id, group.Name, group.Owner = existing.Id, existing.Name, existing.Owner
+	if err := s.validateGroup(&group); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	update := bson.D{{"$set", bson.D{{"members", group.Members}, {"groups", group.Groups}}}}
+	if _, err := s.DbClient.Database("db").Collection("groups").UpdateOne(context.TODO(), bson.D{{"name", group.Name}}, update); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := s.refreshGroup(group.Name, requestPrincipal(r).login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, group)
+}
+
+// DeleteGroup deletes a group that is not nested in another one, the members
+// it invited stay invited.
+func (s *Service) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	group, err := s.findGroup(mux.Vars(r)["name"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := authorize(r, group.Owner); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	database := s.DbClient.Database("db")
+	nesting, err := database.Collection("groups").Distinct(context.TODO(), "name", bson.D{{"groups", group.Name}})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if len(nesting) != 0 {
+		writeError(w, r, conflict("group %q is nested in %v", group.Name, nesting))
+		return
+	}
+	version, err := s.nextVersion()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+		bson.D{{"elem.group", group.Name}},
+	}})
+	_, err = database.Collection("meetings").UpdateMany(context.TODO(),
+		bson.D{{"groups", group.Name}},
+		bson.D{
+			{"$set", bson.D{{"version", version}, {"updatedAt", time.Now().UTC()}}},
+			{"$pull", bson.D{{"groups", group.Name}}},
+			{"$unset", bson.D{{"invited.$[elem].group", ""}}},
+		}, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := database.Collection("groups").DeleteOne(context.TODO(), bson.D{{"name", group.Name}}); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Service) validateGroup(group *Group) error {
+	if !groupNamePattern.MatchString(group.Name) {
+		return validationFailed("invalid group", ErrorDetail{"name", "letters, digits, '.', '_' and '-' only"})
+	}
+	if group.Owner == "" {
+		return validationFailed("missing owner", ErrorDetail{"owner", "is required"})
+	}
+	if group.Members == nil {
+		group.Members = []string{}
+	}
+	if err := s.checkUsersExist(append([]string{group.Owner}, group.Members...)); err != nil {
+		return err
+	}
+	for _, name := range group.Groups {
+		if name == group.Name {
+			return validationFailed("invalid group", ErrorDetail{"groups", "a group can't be nested in itself"})
+		}
+	}
+	// nested groups must not nest the group in turn
+	nested, err := s.nestedGroups(group.Groups)
+	if err != nil {
+		return validationFailed("invalid group", ErrorDetail{"groups", toApiError(err).Message})
+	}
+	for _, nested := range nested {
+		for _, name := range nested.Groups {
+			if name == group.Name {
+				return validationFailed("invalid group", ErrorDetail{"groups", fmt.Sprintf("%q nests %q", nested.Name, group.Name)})
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) findGroup(name string) (*Group, error) {
+	var group Group
+	err := s.DbClient.Database("db").Collection("groups").FindOne(context.TODO(), bson.D{{"name", name}}).Decode(&group)
+	if err == mongo.ErrNoDocuments {
+		return nil, notFound("group %q not found", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// nestedGroups returns the groups and the groups nested in them, however
+// deep.
+func (s *Service) nestedGroups(names []string) ([]Group, error) {
+	groups := []Group{}
+	seen := map[string]bool{}
+	for len(names) != 0 {
+		batch := []string{}
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				batch = append(batch, name)
+			}
+		}
+		if len(batch) == 0 {
+			break
+		}
+		cursor, err := s.DbClient.Database("db").Collection("groups").Find(context.TODO(), bson.D{{"name", bson.D{{"$in", batch}}}})
+		if err != nil {
+			return nil, err
+		}
+		found := []Group{}
+		if err = cursor.All(context.TODO(), &found); err != nil {
+			return nil, err
+		}
+		if len(found) != len(batch) {
+			known := map[string]bool{}
+			for _, group := range found {
+				known[group.Name] = true
+			}
+			for _, name := range batch {
+				if !known[name] {
+					return nil, notFound("group %q not found", name)
+				}
+			}
+		}
+		names = nil
+		for _, group := range found {
+			groups = append(groups, group)
+			names = append(names, group.Groups...)
+		}
+	}
+	return groups, nil
+}
+
+// groupMembers returns the members of the group and of the groups nested in
+// it.
+func (s *Service) groupMembers(name string) ([]string, error) {
+	groups, err := s.nestedGroups([]string{name})
+	if err != nil {
+		return nil, err
+	}
+	members := []string{}
+	seen := map[string]bool{}
+	for _, group := range groups {
+		for _, login := range group.Members {
+			if !seen[login] {
+				seen[login] = true
+				members = append(members, login)
+			}
+		}
+	}
+	return members, nil
+}
+
+// expandLogins replaces the groups among logins by their members.
+func (s *Service) expandLogins(logins []string) ([]string, error) {
+	expanded := []string{}
+	seen := map[string]bool{}
+	for _, login := range logins {
+		members := []string{login}
+		if name, ok := groupName(login); ok {
+			var err error
+			if members, err = s.groupMembers(name); err != nil {
+				return nil, validationFailed("unknown group", ErrorDetail{"logins", toApiError(err).Message})
+			}
+		}
+		for _, member := range members {
+			if !seen[member] {
+				seen[member] = true
+				expanded = append(expanded, member)
+			}
+		}
+	}
+	return expanded, nil
+}
+
+// inviteGroups replaces the groups among the invitees of the meeting by
+// their members, and invites the members of its Groups. Members already
+// invited by login, and the owner, are left out.
+func (s *Service) inviteGroups(meeting *Meeting) error {
+	invited := []Invitation{}
+	seen := map[string]bool{}
+	for _, invitation := range meeting.Invited {
+		if _, ok := groupName(invitation.Invitee); !ok && invitation.Group == "" {
+			seen[invitation.Invitee] = true
+			invited = append(invited, invitation)
+		}
+	}
+	seen[meeting.Owner] = true
+	invite := func(name, group string) error {
+		members, err := s.groupMembers(name)
+		if err != nil {
+			field := "groups"
+			if group == "" {
+				field = "invited"
+			}
+			return validationFailed("unknown group", ErrorDetail{field, toApiError(err).Message})
+		}
+		for _, login := range members {
+			if !seen[login] {
+				seen[login] = true
+				invited = append(invited, Invitation{Invitee: login, Group: group})
+			}
+		}
+		return nil
+	}
+	for _, invitation := range meeting.Invited {
+		if name, ok := groupName(invitation.Invitee); ok {
+			if err := invite(name, ""); err != nil {
+				return err
+			}
+		}
+	}
+	var groups []string
+	listed := map[string]bool{}
+	for _, name := range meeting.Groups {
+		if !listed[name] {
+			listed[name] = true
+			groups = append(groups, name)
+			if err := invite(name, name); err != nil {
+				return err
+			}
+		}
+	}
+	meeting.Invited, meeting.Groups = invited, groups
+	return nil
+}
+
+// refreshGroup updates the invitations of the meetings inviting the group,
+// or a group it is nested in, to their current members.
+func (s *Service) refreshGroup(name string, by string) error {
+	database := s.DbClient.Database("db")
+	affected := []string{name}
+	known := map[string]bool{name: true}
+	for names := affected; len(names) != 0; {
+		nesting, err := database.Collection("groups").Distinct(context.TODO(), "name", bson.D{{"groups", bson.D{{"$in", names}}}})
+		if err != nil {
+			return err
+		}
+		names = nil
+		for _, name := range nesting {
+			if name, ok := name.(string); ok && !known[name] {
+				known[name] = true
+				affected = append(affected, name)
+				names = append(names, name)
+			}
+		}
+	}
+	cursor, err := database.Collection("meetings").Find(context.TODO(), bson.D{{"groups", bson.D{{"$in", affected}}}, {"deleted", bson.D{{"$ne", true}}}})
+	if err != nil {
+		return err
+	}
+	meetings := []Meeting{}
+	if err = cursor.All(context.TODO(), &meetings); err != nil {
+		return err
+	}
+	for i := range meetings {
+		existing := &meetings[i]
+		meeting := *existing
+		if err := s.inviteGroups(&meeting); err != nil {
+			log.Printf("groups: refresh meeting %s: %v", existing.Id, err)
+			continue
+		}
+		answers := map[string]Invitation{}
+		for _, invitation := range existing.Invited {
+			answers[invitation.Invitee] = invitation
+		}
+		changed := len(meeting.Invited) != len(existing.Invited)
+		for j, invitation := range meeting.Invited {
+			answer, ok := answers[invitation.Invitee]
+			if !ok {
+				changed = true
+				continue
+			}
+			answer.Group = invitation.Group
+			meeting.Invited[j] = answer
+		}
+		if !changed {
+			continue
+		}
+		meeting.UpdatedBy = by
+		meeting.Sequence = existing.Sequence + 1
+		if err := s.storeMeeting(&meeting, existing); err != nil {
+			log.Printf("groups: refresh meeting %s: %v", existing.Id, err)
+			continue
+		}
+		s.notifyInvitees(existing, &meeting)
+	}
+	return nil
+}
+
+// keepGroups keeps the groups of existing inviting a meeting replacing it,
+// for the invitees it still invites.
+func keepGroups(meeting, existing *Meeting) {
+	meeting.Groups = existing.Groups
+	groups := map[string]string{}
+	for _, invitation := range existing.Invited {
+		groups[invitation.Invitee] = invitation.Group
+	}
+	for i := range meeting.Invited {
+		meeting.Invited[i].Group = groups[meeting.Invited[i].Invitee]
+	}
+}
+
+// groupName returns the name of the group login stands for, if any.
+func groupName(login string) (string, bool) {
+	if strings.HasPrefix(login, groupPrefix) {
+		return strings.TrimPrefix(login, groupPrefix), true
+	}
+	return "", false
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupName(t *testing.T) {
+	name, ok := groupName("team:backend")
+	require.True(t, ok)
+	require.Equal(t, "backend", name)
+	_, ok = groupName("bob")
+	require.False(t, ok)
+}
+
+func TestKeepGroups(t *testing.T) {
+	existing := &Meeting{
+		Groups:  []string{"backend"},
+		Invited: []Invitation{{Invitee: "alice", Group: "backend"}, {Invitee: "bob"}},
+	}
+	// calendar objects don't carry groups
+	updated := &Meeting{Invited: []Invitation{{Invitee: "bob"}, {Invitee: "alice"}, {Invitee: "carol"}}}
+	keepGroups(updated, existing)
+	require.Equal(t, []string{"backend"}, updated.Groups)
+	require.Equal(t, []Invitation{{Invitee: "bob"}, {Invitee: "alice", Group: "backend"}, {Invitee: "carol"}}, updated.Invited)
+}
@@ -4,153 +4,467 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"mime"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gorilla/mux"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 var dateLayout = "2006-01-02T15:04:05Z07:00"
 
+// limits of meetings, texts in characters
+const (
+	maxTitle          = 200
+	maxDescription    = 10000
+	maxLocation       = 500
+	maxUrl            = 2048
+	maxAttachments    = 20
+	maxAttachmentName = 255
+)
+
 func (s *Service) AddUser(w http.ResponseWriter, r *http.Request) {
+	if err := s.authorizeSignup(r); err != nil {
+		writeError(w, r, err)
+		return
+	}
 	coll := s.DbClient.Database("db").Collection("users")
 	var user User
 	err := json.NewDecoder(r.Body).Decode(&user)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	details := validateLogin(user.Login)
+	for _, err := range []error{s.validateReminders(user.Reminders, "reminders"), validateProfile(&user)} {
+		found, ok := validationDetails(err)
+		if err != nil && !ok {
+			writeError(w, r, err)
+			return
+		}
+		details = append(details, found...)
+	}
+	if len(details) != 0 {
+		writeError(w, r, validationFailed("invalid user", details...))
 		return
 	}
+	user.Shares = nil // only the user sets them, see SetShares
 	res, err := coll.InsertOne(context.TODO(), user)
+	if mongo.IsDuplicateKeyError(err) {
+		writeError(w, r, conflict("user %q already exists", user.Login))
+		return
+	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, r, err)
 		return
 	}
 	if oid, ok := res.InsertedID.(primitive.ObjectID); ok {
 		user.Id = oid.Hex()
 	}
-	json.NewEncoder(w).Encode(user)
-	w.WriteHeader(http.StatusOK)
+	token, err := s.createToken(user.Login, "default")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	user.Token = token.Token
+	writeJson(w, http.StatusOK, user)
 }
 
 func (s *Service) AddMeeting(w http.ResponseWriter, r *http.Request) {
 	var meeting Meeting
 	err := json.NewDecoder(r.Body).Decode(&meeting)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, badRequest("malformed request body: %v", err))
 		return
 	}
-	if !meeting.EndTime.After(meeting.StartTime) || meeting.EndTime.Sub(meeting.StartTime) > 24*time.Hour {
-		http.Error(w, "bad dates", http.StatusBadRequest)
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
 		return
 	}
-	if meeting.Reoccurance != 0 && meeting.Reoccurance != 1 && meeting.Reoccurance != 3 {
-		http.Error(w, "supported reoccurances: 0 - None, 1 - Daily", http.StatusBadRequest)
+	meeting.Owner = pol.owner(meeting.Owner)
+	keepFiles(&meeting, nil)
+	if err := s.validateMeeting(&meeting); err != nil {
+		writeError(w, r, err)
 		return
 	}
-	logins := []string{meeting.Owner}
-	for _, invite := range meeting.Invited {
-		logins = append(logins, invite.Invitee)
+	for i := range meeting.Invited {
+		meeting.Invited[i].RespondedBy = ""
+		meeting.Invited[i].CalendarId = ""
 	}
-	if !s.checkUsersExist(logins, w) {
+	meeting.UpdatedBy = pol.principal.login
+	meeting.Sequence = 0
+	// todo: check that there is no intersection
+	if err := s.storeMeeting(&meeting, nil); err != nil {
+		writeError(w, r, err)
 		return
 	}
-	meeting.StartTime = meeting.StartTime.Truncate(60 * time.Second)
-	// todo: check that there is no intersection
-	res, err := s.DbClient.Database("db").Collection("meetings").InsertOne(context.TODO(), meeting)
+	s.notifyInvitees(nil, &meeting)
+	writeJson(w, http.StatusOK, meeting)
+}
+
+// UpdateMeeting replaces a meeting. Answers of invitees are kept unless the
+// time of the meeting changes.
+func (s *Service) UpdateMeeting(w http.ResponseWriter, r *http.Request) {
+	existing, err := s.findMeeting(mux.Vars(r)["id"])
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, r, err)
 		return
 	}
-	if oid, ok := res.InsertedID.(primitive.ObjectID); ok {
-		meeting.Id = oid.Hex()
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorize(actionEdit, existing); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var meeting Meeting
+	if err := json.NewDecoder(r.Body).Decode(&meeting); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	if meeting.Owner == "" {
+		meeting.Owner = existing.Owner
+	} else {
+		meeting.Owner = pol.owner(meeting.Owner)
 	}
-	json.NewEncoder(w).Encode(meeting)
-	w.WriteHeader(http.StatusOK)
+	keepFiles(&meeting, existing)
+	if err := s.validateMeeting(&meeting); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	rescheduled := !meeting.StartTime.Equal(existing.StartTime) || !meeting.EndTime.Equal(existing.EndTime) || meeting.Reoccurance != existing.Reoccurance
+	answers := map[string]Invitation{}
+	for _, invitation := range existing.Invited {
+		answers[invitation.Invitee] = invitation
+	}
+	for i := range meeting.Invited {
+		invitee := meeting.Invited[i].Invitee
+		answer, ok := answers[invitee]
+		if !ok || rescheduled {
+			// invitees keep the meeting in the calendar they chose
+			answer = Invitation{Invitee: invitee, CalendarId: answer.CalendarId}
+		}
+		answer.Group = meeting.Invited[i].Group
+		meeting.Invited[i] = answer
+	}
+	meeting.UpdatedBy = pol.principal.login
+	meeting.Uid, meeting.ResourceName = existing.Uid, existing.ResourceName
+	meeting.Sequence = existing.Sequence + 1
+	if err := s.storeMeeting(&meeting, existing); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.notifyInvitees(existing, &meeting)
+	writeJson(w, http.StatusOK, meeting)
+}
+
+func (s *Service) DeleteMeeting(w http.ResponseWriter, r *http.Request) {
+	meeting, err := s.findMeeting(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorize(actionDelete, meeting); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	meeting.UpdatedBy = pol.principal.login
+	if err := s.deleteMeeting(meeting); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateMeeting checks a meeting and files it, reporting every problem
+// at once.
+func (s *Service) validateMeeting(meeting *Meeting) error {
+	meeting.StartTime = meeting.StartTime.Truncate(60 * time.Second)
+	details := checkMeeting(meeting)
+	collect := func(err error) error {
+		if found, ok := validationDetails(err); ok {
+			details = append(details, found...)
+			return nil
+		}
+		return err
+	}
+	if err := collect(s.inviteGroups(meeting)); err != nil {
+		return err
+	}
+	logins := []string{}
+	if meeting.Owner != "" {
+		logins = append(logins, meeting.Owner)
+	}
+	for _, invite := range meeting.Invited {
+		if _, ok := groupName(invite.Invitee); !ok && invite.Invitee != "" {
+			logins = append(logins, invite.Invitee)
+		}
+	}
+	if err := collect(s.checkUsersExist(logins)); err != nil {
+		return err
+	}
+	if meeting.Owner != "" {
+		if err := collect(s.fileMeeting(meeting)); err != nil {
+			return err
+		}
+	}
+	if err := collect(s.validateReminders(meeting.Reminders, "reminders")); err != nil {
+		return err
+	}
+	if len(details) != 0 {
+		return validationFailed("invalid meeting", details...)
+	}
+	return nil
+}
+
+// isHttpUrl reports whether value is an absolute http(s) url of at most
+// maxUrl characters.
+func isHttpUrl(value string) bool {
+	target, err := url.Parse(value)
+	return err == nil && len(value) <= maxUrl && (target.Scheme == "http" || target.Scheme == "https") && target.Host != ""
+}
+
+// checkMeeting returns the problems of a meeting which need no lookups.
+func checkMeeting(meeting *Meeting) []ErrorDetail {
+	details := []ErrorDetail{}
+	if meeting.Owner == "" {
+		details = append(details, ErrorDetail{"owner", "is required"})
+	}
+	if duration := meeting.EndTime.Sub(meeting.StartTime); duration < time.Minute || duration > 24*time.Hour {
+		details = append(details, ErrorDetail{"endTime", "must be at least a minute after startTime and at most 24h later"})
+	}
+	if utf8.RuneCountInString(meeting.Title) > maxTitle {
+		details = append(details, ErrorDetail{"title", fmt.Sprintf("at most %d characters", maxTitle)})
+	}
+	if strings.TrimSpace(meeting.Title) == "" && strings.TrimSpace(meeting.Description) == "" {
+		details = append(details, ErrorDetail{"description", "is required without a title"})
+	} else if utf8.RuneCountInString(meeting.Description) > maxDescription {
+		details = append(details, ErrorDetail{"description", fmt.Sprintf("at most %d characters", maxDescription)})
+	}
+	if utf8.RuneCountInString(meeting.Location) > maxLocation {
+		details = append(details, ErrorDetail{"location", fmt.Sprintf("at most %d characters", maxLocation)})
+	}
+	if meeting.ConferenceUrl != "" && !isHttpUrl(meeting.ConferenceUrl) {
+		details = append(details, ErrorDetail{"conferenceUrl", fmt.Sprintf("must be an absolute http(s) url of at most %d characters", maxUrl)})
+	}
+	if len(meeting.Attachments) > maxAttachments {
+		details = append(details, ErrorDetail{"attachments", fmt.Sprintf("at most %d attachments", maxAttachments)})
+	}
+	for i, attachment := range meeting.Attachments {
+		prefix := fmt.Sprintf("attachments[%d]", i)
+		if name := strings.TrimSpace(attachment.Name); name == "" {
+			details = append(details, ErrorDetail{prefix + ".name", "is required"})
+		} else if utf8.RuneCountInString(name) > maxAttachmentName {
+			details = append(details, ErrorDetail{prefix + ".name", fmt.Sprintf("at most %d characters", maxAttachmentName)})
+		}
+		if attachment.Id == "" && !isHttpUrl(attachment.Url) {
+			details = append(details, ErrorDetail{prefix + ".url", fmt.Sprintf("must be an absolute http(s) url of at most %d characters", maxUrl)})
+		}
+		if attachment.MimeType != "" {
+			if mediaType, _, err := mime.ParseMediaType(attachment.MimeType); err != nil || !strings.Contains(mediaType, "/") {
+				details = append(details, ErrorDetail{prefix + ".mimeType", "must be a MIME type, e.g. application/pdf"})
+			}
+		}
+	}
+	switch meeting.Visibility {
+	case "", VisibilityPublic, VisibilityPrivate, VisibilityConfidential:
+	default:
+		details = append(details, ErrorDetail{"visibility", "supported visibilities: public, private, confidential"})
+	}
+	if meeting.TimeZone != "" {
+		if _, err := time.LoadLocation(meeting.TimeZone); err != nil || meeting.TimeZone == "Local" {
+			details = append(details, ErrorDetail{"timeZone", "must be an IANA time zone, e.g. Europe/Berlin"})
+		}
+	}
+	if meeting.Reoccurance > Yearly {
+		details = append(details, ErrorDetail{"reoccurance", "supported reoccurances: 0 - None, 1 - Daily, 2 - Working days, 3 - Weekly, 4 - Monthly, 5 - Yearly"})
+	}
+	invited := map[string]bool{}
+	for i, invitation := range meeting.Invited {
+		if invitation.Group != "" {
+			continue // invited by one of Groups, which are invited again
+		}
+		field := fmt.Sprintf("invited[%d].invitee", i)
+		switch {
+		case invitation.Invitee == "":
+			details = append(details, ErrorDetail{field, "is required"})
+		case invitation.Invitee == meeting.Owner:
+			details = append(details, ErrorDetail{field, "the owner can't be invited"})
+		case invited[invitation.Invitee]:
+			details = append(details, ErrorDetail{field, fmt.Sprintf("%q is invited more than once", invitation.Invitee)})
+		}
+		invited[invitation.Invitee] = true
+	}
+	return details
 }
 
 func (s *Service) GetMeeting(w http.ResponseWriter, r *http.Request) {
-	meetingId := mux.Vars(r)["id"]
+	meeting, err := s.findMeeting(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, pol.view(meeting))
+}
+
+func (s *Service) findMeeting(meetingId string) (*Meeting, error) {
 	var meeting Meeting
 	objectId, err := primitive.ObjectIDFromHex(meetingId)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return nil, notFound("meeting %q not found", meetingId)
 	}
-	filter := bson.D{{"_id", objectId}}
+	filter := bson.D{{"_id", objectId}, {"deleted", bson.D{{"$ne", true}}}}
 	err = s.DbClient.Database("db").Collection("meetings").FindOne(context.TODO(), filter).Decode(&meeting)
+	if err == mongo.ErrNoDocuments {
+		return nil, notFound("meeting %q not found", meetingId)
+	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return nil, err
 	}
-	json.NewEncoder(w).Encode(meeting)
-	w.WriteHeader(http.StatusOK)
+	return &meeting, nil
 }
 
+// ListMeetings returns the meetings of a user who shares their calendar,
+// those the caller may not see only show when they are busy. Pages are
+// linked by the Link header, see parsePage.
 func (s *Service) ListMeetings(w http.ResponseWriter, r *http.Request) {
 	login := mux.Vars(r)["login"]
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorizeCalendar(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
 	startTime, err := time.Parse(dateLayout, mux.Vars(r)["startTime"])
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, badRequest("invalid startTime: %v", err))
 		return
 	}
 	endTime, err := time.Parse(dateLayout, mux.Vars(r)["endTime"])
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, badRequest("invalid endTime: %v", err))
 		return
 	}
 	startTime = startTime.Truncate(60 * time.Second)
 	endTime = endTime.Truncate(60 * time.Second)
+	calendars := parseCalendarFilter(r)
+	filter, err := parseMeetingFilter(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	limit, position, err := parsePage(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if position != nil && position.start.After(startTime) {
+		// meetings starting before the position are on previous pages
+		startTime = position.start
+	}
 
 	schedule, err := MakeSchedule(s.DbClient.Database("db").Collection("meetings"), []string{login}, &startTime, &endTime)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, r, err)
 		return
 	}
 	meetings := []Meeting{}
+	var last listPosition
 	for schedule.HasNext() {
 		meeting, err := schedule.Next()
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
-		meetings = append(meetings, *meeting)
+		if (position != nil && position.passed(meeting)) || !calendars.matches(meeting, []string{login}) {
+			continue
+		}
+		view := pol.view(meeting)
+		if !filter.matches(&view, login) {
+			continue
+		}
+		if len(meetings) == limit {
+			w.Header().Set("Link", nextPageLink(r, last))
+			break
+		}
+		meetings = append(meetings, view)
+		last = positionOf(meeting)
 	}
-	json.NewEncoder(w).Encode(meetings)
-	w.WriteHeader(http.StatusOK)
+	writeJson(w, http.StatusOK, meetings)
 }
 
 func (s *Service) FindSlot(w http.ResponseWriter, r *http.Request) {
 	duration, err := strconv.Atoi(mux.Vars(r)["durationMinutes"])
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, badRequest("invalid durationMinutes: %v", err))
+		return
+	}
+	logins, err := s.expandLogins(strings.Split(mux.Vars(r)["logins"], ","))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
 		return
 	}
-	logins := strings.Split(mux.Vars(r)["logins"], ",")
-	if !s.checkUsersExist(logins, w) {
+	// the slot tells when everyone is busy
+	for _, login := range logins {
+		if err := pol.authorizeCalendar(login); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+	if err := s.checkUsersExist(logins); err != nil {
+		writeError(w, r, err)
 		return
 	}
 	startTime, err := time.Parse(dateLayout, mux.Vars(r)["startTime"])
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, badRequest("invalid startTime: %v", err))
 		return
 	}
+	calendars := parseCalendarFilter(r)
 	schedule, err := MakeSchedule(s.DbClient.Database("db").Collection("meetings"), logins, &startTime, nil) // todo: can retrieve less data from db, use projection
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, r, err)
 		return
 	}
 	prevMeetingEnd := startTime
 	for schedule.HasNext() {
 		meeting, err := schedule.Next()
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
+		if !calendars.matches(meeting, logins) {
+			continue
+		}
 		if prevMeetingEnd.Before(meeting.StartTime) && meeting.StartTime.Sub(prevMeetingEnd) >= time.Duration(duration)*time.Minute {
 			break
 		}
@@ -158,53 +472,128 @@ func (s *Service) FindSlot(w http.ResponseWriter, r *http.Request) {
 			prevMeetingEnd = meeting.EndTime
 		}
 	}
-	json.NewEncoder(w).Encode(map[string]string{
+	writeJson(w, http.StatusOK, map[string]string{
 		"startTime": prevMeetingEnd.Format(dateLayout),
 	})
-	w.WriteHeader(http.StatusOK)
 }
 
 func (s *Service) AcceptMeeting(w http.ResponseWriter, r *http.Request) {
 	var reqest AcceptMeetingRequest
 	err := json.NewDecoder(r.Body).Decode(&reqest)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	if _, err := primitive.ObjectIDFromHex(reqest.MeetingId); err != nil {
+		writeError(w, r, validationFailed("invalid meeting id", ErrorDetail{"meetingId", err.Error()}))
+		return
+	}
+	meeting, err := s.findMeeting(reqest.MeetingId)
+	if err != nil {
+		writeError(w, r, err)
 		return
 	}
-	objectId, err := primitive.ObjectIDFromHex(reqest.MeetingId)
+	pol, err := s.policy(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, r, err)
+		return
+	}
+	login, err := pol.respondent(meeting, reqest.Login)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findCalendar(login, reqest.CalendarId); err != nil {
+		writeError(w, r, validationFailed("unknown calendar", ErrorDetail{"calendarId", toApiError(err).Message + " for " + login}))
 		return
 	}
 	choice := Accepted
 	if reqest.Decline {
 		choice = Declined
 	}
-	identifier := []interface{}{bson.D{{"elem.invitee", reqest.Login}}}
-	update := bson.D{{"$set", bson.D{{"invited.$[elem].accepted", choice}}}}
+	meeting, err = s.respond(reqest.MeetingId, login, choice, pol.principal.login, reqest.CalendarId)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, meeting)
+}
+
+// respond records the choice of an invitee, made by by, and returns the
+// updated meeting. The meeting moves to the calendar of the invitee with
+// calendarId, it stays where it is without one.
+func (s *Service) respond(meetingId string, login string, choice AcceptedChoice, by string, calendarId string) (*Meeting, error) {
+	objectId, err := primitive.ObjectIDFromHex(meetingId)
+	if err != nil {
+		return nil, notFound("meeting %q not found", meetingId)
+	}
+	version, err := s.nextVersion()
+	if err != nil {
+		return nil, err
+	}
+	if by == login {
+		by = ""
+	}
+	identifier := []interface{}{bson.D{{"elem.invitee", login}}}
+	fields := bson.D{{"invited.$[elem].accepted", choice}, {"invited.$[elem].respondedBy", by}, {"version", version}, {"updatedAt", time.Now().UTC()}}
+	switch calendarId {
+	case "":
+	case defaultCalendarId:
+		fields = append(fields, bson.E{"invited.$[elem].calendarId", ""})
+	default:
+		fields = append(fields, bson.E{"invited.$[elem].calendarId", calendarId})
+	}
+	update := bson.D{{"$set", fields}}
 	opts := options.FindOneAndUpdate().
 		SetArrayFilters(options.ArrayFilters{Filters: identifier}).
 		SetReturnDocument(options.After)
 	var meeting Meeting
-	err = s.DbClient.Database("db").Collection("meetings").FindOneAndUpdate(context.TODO(), bson.D{{"_id", objectId}}, update, opts).Decode(&meeting)
+	// only invitees may answer, meetings of others are not found
+	filter := bson.D{{"_id", objectId}, {"invited.invitee", login}, {"deleted", bson.D{{"$ne", true}}}}
+	err = s.DbClient.Database("db").Collection("meetings").FindOneAndUpdate(context.TODO(), filter, update, opts).Decode(&meeting)
+	if err == mongo.ErrNoDocuments {
+		return nil, notFound("meeting %q not found", meetingId)
+	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return nil, err
 	}
-	json.NewEncoder(w).Encode(meeting)
-	w.WriteHeader(http.StatusOK)
+	s.emit(MeetingResponded, &meeting, login)
+	return &meeting, nil
 }
 
-func (s *Service) checkUsersExist(logins []string, w http.ResponseWriter) bool {
+func (s *Service) findUser(login string) (*User, error) {
+	var user User
+	err := s.DbClient.Database("db").Collection("users").FindOne(context.TODO(), bson.D{{"login", login}}).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, notFound("user %q not found", login)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *Service) checkUsersExist(logins []string) error {
 	filter := bson.D{{"login", bson.D{{"$in", logins}}}}
-	count, err := s.DbClient.Database("db").Collection("users").CountDocuments(context.TODO(), filter)
+	found, err := s.DbClient.Database("db").Collection("users").Distinct(context.TODO(), "login", filter)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return false
+		return err
+	}
+	existing := map[string]bool{}
+	for _, login := range found {
+		if login, ok := login.(string); ok {
+			existing[login] = true
+		}
+	}
+	details := []ErrorDetail{}
+	for _, login := range logins {
+		if !existing[login] {
+			details = append(details, ErrorDetail{Field: "login", Message: fmt.Sprintf("unknown user %q", login)})
+			existing[login] = true // reported once
+		}
 	}
-	if count != int64(len(logins)) {
-		http.Error(w, fmt.Sprintf("invalid owner or invitees %v/%v", count, len(logins)), http.StatusBadRequest)
-		return false
+	if len(details) != 0 {
+		return validationFailed("invalid owner or invitees", details...)
 	}
-	return true
+	return nil
 }
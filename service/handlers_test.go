@@ -0,0 +1,82 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckMeeting(t *testing.T) {
+	start := time.Date(2023, 3, 7, 16, 20, 0, 0, time.UTC)
+	meeting := &Meeting{
+		Owner:       "bob",
+		Invited:     []Invitation{{Invitee: "alice"}, {Invitee: "carol", Group: "engineering"}},
+		StartTime:   start,
+		EndTime:     start.Add(time.Minute),
+		Description: "standup",
+	}
+	require.Empty(t, checkMeeting(meeting))
+
+	// invitations of groups are checked when they are invited again
+	meeting.Invited = append(meeting.Invited, Invitation{Invitee: "carol", Group: "engineering"})
+	require.Empty(t, checkMeeting(meeting))
+
+	meeting = &Meeting{
+		Owner:       "bob",
+		Invited:     []Invitation{{Invitee: "bob"}, {Invitee: "alice"}, {Invitee: ""}, {Invitee: "alice"}},
+		StartTime:   start,
+		EndTime:     start.Add(59 * time.Second),
+		Description: "\t\n",
+		Visibility:  "secret",
+	}
+	require.Equal(t, []ErrorDetail{
+		{"endTime", "must be at least a minute after startTime and at most 24h later"},
+		{"description", "is required without a title"},
+		{"visibility", "supported visibilities: public, private, confidential"},
+		{"invited[0].invitee", "the owner can't be invited"},
+		{"invited[2].invitee", "is required"},
+		{"invited[3].invitee", `"alice" is invited more than once`},
+	}, checkMeeting(meeting))
+
+	meeting = &Meeting{StartTime: start, EndTime: start.Add(25 * time.Hour), Description: strings.Repeat("ä", maxDescription+1)}
+	require.Equal(t, []ErrorDetail{
+		{"owner", "is required"},
+		{"endTime", "must be at least a minute after startTime and at most 24h later"},
+		{"description", "at most 10000 characters"},
+	}, checkMeeting(meeting))
+	meeting.Description = strings.Repeat("ä", maxDescription)
+	require.Len(t, checkMeeting(meeting), 2)
+
+	// a title is enough
+	meeting = &Meeting{
+		Owner:         "bob",
+		StartTime:     start,
+		EndTime:       start.Add(time.Hour),
+		Title:         "Design review",
+		Location:      "Room 4",
+		ConferenceUrl: "https://meet.example.com/design",
+		Attachments:   []Attachment{{Name: "agenda.pdf", Url: "https://files.example.com/agenda.pdf", MimeType: "application/pdf"}},
+	}
+	require.Empty(t, checkMeeting(meeting))
+
+	meeting.Title = strings.Repeat("a", maxTitle+1)
+	meeting.Location = strings.Repeat("a", maxLocation+1)
+	meeting.ConferenceUrl = "meet.example.com/design"
+	meeting.Attachments = []Attachment{
+		{Name: " ", Url: "ftp://files.example.com/agenda.pdf", MimeType: "pdf"},
+		{Name: "notes.txt", Url: "https://files.example.com/" + strings.Repeat("a", maxUrl), MimeType: "text/plain; charset=utf-8"},
+	}
+	require.Equal(t, []ErrorDetail{
+		{"title", "at most 200 characters"},
+		{"location", "at most 500 characters"},
+		{"conferenceUrl", "must be an absolute http(s) url of at most 2048 characters"},
+		{"attachments[0].name", "is required"},
+		{"attachments[0].url", "must be an absolute http(s) url of at most 2048 characters"},
+		{"attachments[0].mimeType", "must be a MIME type, e.g. application/pdf"},
+		{"attachments[1].url", "must be an absolute http(s) url of at most 2048 characters"},
+	}, checkMeeting(meeting))
+	meeting = &Meeting{Owner: "bob", StartTime: start, EndTime: start.Add(time.Hour), Title: "review", Attachments: make([]Attachment, maxAttachments+1)}
+	require.Equal(t, ErrorDetail{"attachments", "at most 20 attachments"}, checkMeeting(meeting)[0])
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const icalDateLayout = "20060102T150405Z"
+
+var partStats = map[AcceptedChoice]string{
+	NotReviewed: "NEEDS-ACTION",
+	Accepted:    "ACCEPTED",
+	Declined:    "DECLINED",
+}
+
+var visibilityClasses = map[Visibility]string{
+	VisibilityPublic:       "PUBLIC",
+	VisibilityPrivate:      "PRIVATE",
+	VisibilityConfidential: "CONFIDENTIAL",
+}
+
+var recurrenceRules = map[ReoccureanceChoice]string{
+	Daily:       "FREQ=DAILY",
+	WorkingDays: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+	Weekly:      "FREQ=WEEKLY",
+	Monthly:     "FREQ=MONTHLY",
+	Yearly:      "FREQ=YEARLY",
+}
+
+// icalWriter produces RFC 5545 content lines: CRLF terminated and folded
+// at 75 octets.
+type icalWriter struct {
+	b strings.Builder
+}
+
+func (w *icalWriter) line(name string, value string) {
+	line := name + ":" + value
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// never split a multi-byte utf-8 sequence
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.b.WriteString(line[:cut])
+		w.b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space
+		limit = 74
+	}
+	w.b.WriteString(line)
+	w.b.WriteString("\r\n")
+}
+
+func (w *icalWriter) String() string {
+	return w.b.String()
+}
+
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
+
+func quoteParam(value string) string {
+	if strings.ContainsAny(value, `:;,"`) {
+		return `"` + strings.ReplaceAll(value, `"`, "") + `"`
+	}
+	return value
+}
+
+func formatIcalTime(t time.Time) string {
+	return t.UTC().Format(icalDateLayout)
+}
+
+func (s *Service) mailDomain() string {
+	if s.MailDomain == "" {
+		return "calendar.local"
+	}
+	return s.MailDomain
+}
+
+func (s *Service) calendarAddress(login string) string {
+	return "mailto:" + login + "@" + s.mailDomain()
+}
+
+// addressLogin returns the login the calendar address of a user at domain
+// names, or an empty string for addresses elsewhere.
+func addressLogin(address, domain string) string {
+	address = strings.TrimSpace(address)
+	if len(address) >= 7 && strings.EqualFold(address[:7], "mailto:") {
+		address = address[7:]
+	}
+	login, addressDomain, _ := strings.Cut(address, "@")
+	if !strings.EqualFold(addressDomain, domain) {
+		return ""
+	}
+	return login
+}
+
+func (s *Service) meetingUid(meeting *Meeting) string {
+	if meeting.Uid != "" {
+		return meeting.Uid
+	}
+	return meeting.Id + "@calendar"
+}
+
+func summary(meeting *Meeting) string {
+	if meeting.Busy {
+		return "Busy"
+	}
+	if title := strings.TrimSpace(meeting.Title); title != "" {
+		return title
+	}
+	title, _, _ := strings.Cut(strings.TrimSpace(meeting.Description), "\n")
+	if title == "" {
+		return "Meeting with " + meeting.Owner
+	}
+	return title
+}
+
+// writeEvent renders the meeting as a single VEVENT, recurring meetings are
+// described with an RRULE rather than expanded. Busy placeholders have no
+// participants.
+func (s *Service) writeEvent(w *icalWriter, meeting *Meeting, stamp time.Time) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", escapeText(s.meetingUid(meeting)))
+	w.line("DTSTAMP", formatIcalTime(stamp))
+	if meeting.localTimes() {
+		// clients repeat the meeting in its time zone, see writeTimeZones
+		zone := meeting.location()
+		w.line("DTSTART;TZID="+quoteParam(meeting.TimeZone), meeting.StartTime.In(zone).Format("20060102T150405"))
+		w.line("DTEND;TZID="+quoteParam(meeting.TimeZone), meeting.EndTime.In(zone).Format("20060102T150405"))
+	} else {
+		w.line("DTSTART", formatIcalTime(meeting.StartTime))
+		w.line("DTEND", formatIcalTime(meeting.EndTime))
+	}
+	w.line("SEQUENCE", fmt.Sprint(meeting.Sequence))
+	if rule, ok := recurrenceRules[meeting.Reoccurance]; ok {
+		if meeting.ReoccurUntil != nil {
+			rule += ";UNTIL=" + formatIcalTime(*meeting.ReoccurUntil)
+		}
+		w.line("RRULE", rule)
+		for _, exDate := range meeting.ExDates {
+			w.line("EXDATE", formatIcalTime(exDate))
+		}
+	}
+	w.line("SUMMARY", escapeText(summary(meeting)))
+	if class, ok := visibilityClasses[meeting.visibility()]; ok {
+		w.line("CLASS", class)
+	}
+	if meeting.Busy {
+		w.line("TRANSP", "OPAQUE")
+		w.line("END", "VEVENT")
+		return
+	}
+	if meeting.Description != "" {
+		w.line("DESCRIPTION", escapeText(meeting.Description))
+	}
+	if meeting.Location != "" {
+		w.line("LOCATION", escapeText(meeting.Location))
+	}
+	if meeting.ConferenceUrl != "" {
+		w.line("CONFERENCE;VALUE=URI;FEATURE=VIDEO", meeting.ConferenceUrl)
+	}
+	for _, dialIn := range meeting.DialIns {
+		uri := "tel:" + strings.ReplaceAll(dialIn.Number, " ", "")
+		if dialIn.Pin != "" {
+			// commas pause before the PIN is dialed
+			uri += ",," + dialIn.Pin
+		}
+		w.line("CONFERENCE;VALUE=URI;FEATURE=PHONE", uri)
+	}
+	for _, attachment := range meeting.Attachments {
+		if attachment.Id != "" {
+			// stored files are only downloaded with a token
+			continue
+		}
+		params := "ATTACH;FILENAME=" + quoteParam(attachment.Name)
+		if attachment.MimeType != "" {
+			params += ";FMTTYPE=" + quoteParam(attachment.MimeType)
+		}
+		w.line(params, attachment.Url)
+	}
+	w.line("ORGANIZER;CN="+quoteParam(meeting.Owner), s.calendarAddress(meeting.Owner))
+	for _, invitation := range meeting.Invited {
+		w.line(fmt.Sprintf("ATTENDEE;CN=%s;ROLE=REQ-PARTICIPANT;PARTSTAT=%s", quoteParam(invitation.Invitee), partStats[invitation.Accepted]),
+			s.calendarAddress(invitation.Invitee))
+	}
+	w.line("END", "VEVENT")
+}
+
+func (w *icalWriter) beginCalendar() {
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", "-//vladem//calendar//EN")
+	w.line("CALSCALE", "GREGORIAN")
+}
+
+func (s *Service) encodeCalendar(name string, meetings []Meeting, stamp time.Time) string {
+	w := &icalWriter{}
+	w.beginCalendar()
+	w.line("METHOD", "PUBLISH")
+	w.line("X-WR-CALNAME", escapeText(name))
+	exported := make([]*Meeting, len(meetings))
+	for i := range meetings {
+		exported[i] = &meetings[i]
+	}
+	writeTimeZones(w, stamp, exported...)
+	for _, meeting := range exported {
+		s.writeEvent(w, meeting, stamp)
+	}
+	w.line("END", "VCALENDAR")
+	return w.String()
+}
+
+// ExportCalendar returns the meetings of a user as an iCalendar feed, with
+// placeholders for those the caller may not see.
+func (s *Service) ExportCalendar(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := pol.authorizeCalendar(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	opts := options.Find().SetSort(bson.D{{"startTime", 1}})
+	cursor, err := s.DbClient.Database("db").Collection("meetings").Find(context.TODO(), participantFilter([]string{login}), opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	meetings := []Meeting{}
+	if err = cursor.All(context.TODO(), &meetings); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	for i := range meetings {
+		meetings[i] = pol.view(&meetings[i])
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", login+".ics"))
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(s.encodeCalendar(login, meetings, time.Now())))
+}
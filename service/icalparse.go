@@ -0,0 +1,508 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // TZIDs of imported events are resolved even without system zoneinfo
+)
+
+type icalProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+type icalComponent struct {
+	Name       string
+	Properties []icalProperty
+	Children   []*icalComponent
+}
+
+func (c *icalComponent) property(name string) *icalProperty {
+	for i := range c.Properties {
+		if c.Properties[i].Name == name {
+			return &c.Properties[i]
+		}
+	}
+	return nil
+}
+
+func (c *icalComponent) value(name string) string {
+	if property := c.property(name); property != nil {
+		return property.Value
+	}
+	return ""
+}
+
+func (c *icalComponent) all(name string) []icalProperty {
+	res := []icalProperty{}
+	for _, property := range c.Properties {
+		if property.Name == name {
+			res = append(res, property)
+		}
+	}
+	return res
+}
+
+func (c *icalComponent) children(name string) []*icalComponent {
+	res := []*icalComponent{}
+	for _, child := range c.Children {
+		if child.Name == name {
+			res = append(res, child)
+		}
+	}
+	return res
+}
+
+// parseCalendar reads an RFC 5545 stream and returns its first VCALENDAR.
+func parseCalendar(r io.Reader) (*icalComponent, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+	stack := []*icalComponent{}
+	var root *icalComponent
+	for number, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		property, err := parseContentLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number+1, err)
+		}
+		switch property.Name {
+		case "BEGIN":
+			component := &icalComponent{Name: strings.ToUpper(property.Value)}
+			if len(stack) != 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, component)
+			} else if root == nil {
+				root = component
+			}
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(property.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", number+1, property.Value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property outside of a component", number+1)
+			}
+			current := stack[len(stack)-1]
+			current.Properties = append(current.Properties, *property)
+		}
+	}
+	if root == nil || root.Name != "VCALENDAR" {
+		return nil, fmt.Errorf("no VCALENDAR found")
+	}
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("unterminated %s", stack[len(stack)-1].Name)
+	}
+	return root, nil
+}
+
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lines := []string{}
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(lines) != 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func parseContentLine(line string) (*icalProperty, error) {
+	property := &icalProperty{Params: map[string]string{}}
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return nil, fmt.Errorf("malformed content line %q", line)
+	}
+	property.Name = strings.ToUpper(line[:i])
+	for line[i] == ';' {
+		line = line[i+1:]
+		eq := strings.IndexByte(line, '=')
+		if eq <= 0 {
+			return nil, fmt.Errorf("malformed parameter in %q", line)
+		}
+		name := strings.ToUpper(line[:eq])
+		line = line[eq+1:]
+		var value string
+		if strings.HasPrefix(line, `"`) {
+			end := strings.IndexByte(line[1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted parameter %s", name)
+			}
+			value = line[1 : end+1]
+			line = line[end+2:]
+			i = 0
+			if len(line) == 0 {
+				return nil, fmt.Errorf("missing value after parameter %s", name)
+			}
+		} else {
+			i = strings.IndexAny(line, ";:")
+			if i < 0 {
+				return nil, fmt.Errorf("missing value after parameter %s", name)
+			}
+			value = line[:i]
+		}
+		property.Params[name] = value
+		if line[i] != ';' && line[i] != ':' {
+			return nil, fmt.Errorf("malformed parameter %s", name)
+		}
+	}
+	property.Value = line[i+1:]
+	return property, nil
+}
+
+func unescapeText(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			b.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
+
+// timeZones resolves TZID parameters, preferring the IANA database and
+// falling back to the offsets declared in the calendar's VTIMEZONEs.
+type timeZones map[string]*time.Location
+
+func makeTimeZones(calendar *icalComponent) timeZones {
+	zones := timeZones{}
+	for _, vtimezone := range calendar.children("VTIMEZONE") {
+		tzid := vtimezone.value("TZID")
+		if location, err := time.LoadLocation(tzid); err == nil {
+			zones[tzid] = location
+			continue
+		}
+		if location, err := vtimezoneLocation(tzid, vtimezone); err == nil {
+			zones[tzid] = location
+		}
+	}
+	return zones
+}
+
+// vtimezoneLocation builds the zone a VTIMEZONE describes from its latest
+// observances. Daylight saving time is observed when they repeat yearly on
+// a weekday of a month, e.g. the last Sunday of March, other zones keep the
+// standard offset.
+func vtimezoneLocation(tzid string, vtimezone *icalComponent) (*time.Location, error) {
+	latest := func(name string) *icalComponent {
+		var found *icalComponent
+		for _, observance := range vtimezone.children(name) {
+			if found == nil || observance.value("DTSTART") > found.value("DTSTART") {
+				found = observance
+			}
+		}
+		return found
+	}
+	standard, daylight := latest("STANDARD"), latest("DAYLIGHT")
+	if standard == nil {
+		standard, daylight = daylight, nil
+	}
+	if standard == nil {
+		return nil, fmt.Errorf("time zone %q has no observances", tzid)
+	}
+	offset, err := parseUtcOffset(standard.value("TZOFFSETTO"))
+	if err != nil {
+		return nil, err
+	}
+	if daylight == nil {
+		return time.FixedZone(tzid, offset), nil
+	}
+	dstOffset, err := parseUtcOffset(daylight.value("TZOFFSETTO"))
+	if err != nil {
+		return nil, err
+	}
+	dstStart, err := posixRule(daylight)
+	if err != nil {
+		return time.FixedZone(tzid, offset), nil
+	}
+	dstEnd, err := posixRule(standard)
+	if err != nil {
+		return time.FixedZone(tzid, offset), nil
+	}
+	// a TZ string, like "CET-1CEST,M3.5.0/2,M10.5.0/3", is the rule of a
+	// zoneinfo file without transitions
+	rule := posixName(standard, "STD") + posixOffset(offset) + posixName(daylight, "DST") + posixOffset(dstOffset) + "," + dstStart + "," + dstEnd
+	return time.LoadLocationFromTZData(tzid, tzifData(posixName(standard, "STD"), offset, rule))
+}
+
+// posixRule is the day and time an observance begins on, as the "Mm.w.d/time"
+// of a TZ string.
+func posixRule(observance *icalComponent) (string, error) {
+	parts := map[string]string{}
+	for _, part := range strings.Split(observance.value("RRULE"), ";") {
+		name, value, _ := strings.Cut(part, "=")
+		parts[strings.ToUpper(name)] = strings.ToUpper(value)
+	}
+	month, err := strconv.Atoi(parts["BYMONTH"])
+	if parts["FREQ"] != "YEARLY" || err != nil || month < 1 || month > 12 || len(parts["BYDAY"]) < 3 {
+		return "", fmt.Errorf("unsupported observance RRULE %q", observance.value("RRULE"))
+	}
+	byDay := parts["BYDAY"]
+	week, err := strconv.Atoi(byDay[:len(byDay)-2])
+	day := strings.Index("SUMOTUWETHFRSA", byDay[len(byDay)-2:])
+	if err != nil || week == 0 || week < -1 || week > 5 || day < 0 || day%2 != 0 {
+		return "", fmt.Errorf("unsupported observance RRULE %q", observance.value("RRULE"))
+	}
+	if week == -1 {
+		week = 5 // the last one of the month
+	}
+	start, err := time.Parse("20060102T150405", observance.value("DTSTART"))
+	if err != nil {
+		return "", fmt.Errorf("invalid observance DTSTART: %w", err)
+	}
+	return fmt.Sprintf("M%d.%d.%d/%d:%02d:%02d", month, week, day/2, start.Hour(), start.Minute(), start.Second()), nil
+}
+
+// posixOffset is the offset of a TZ string, the one to add to get UTC.
+func posixOffset(offset int) string {
+	sign := ""
+	if offset > 0 {
+		sign = "-"
+	} else {
+		offset = -offset
+	}
+	return fmt.Sprintf("%s%d:%02d:%02d", sign, offset/3600, offset/60%60, offset%60)
+}
+
+// posixName is the abbreviation of an observance, quoted for TZ strings.
+func posixName(observance *icalComponent, fallback string) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '+' || r == '-' {
+			return r
+		}
+		return -1
+	}, observance.value("TZNAME"))
+	if len(name) < 3 {
+		name = fallback
+	}
+	return "<" + name + ">"
+}
+
+// tzifData is a zoneinfo file (RFC 8536, version 2) without transitions, in
+// which the rule alone gives the offsets.
+func tzifData(name string, offset int, rule string) []byte {
+	abbreviation := strings.Trim(name, "<>") + "\x00"
+	var b bytes.Buffer
+	block := func() {
+		b.WriteString("TZif2")
+		b.Write(make([]byte, 15))
+		// counts of UT/local and standard/wall indicators, leap seconds,
+		// transitions, local time types and abbreviation characters
+		for _, n := range []int{0, 0, 0, 0, 1, len(abbreviation)} {
+			binary.Write(&b, binary.BigEndian, uint32(n))
+		}
+		binary.Write(&b, binary.BigEndian, int32(offset))
+		b.WriteByte(0) // not daylight saving time
+		b.WriteByte(0) // the first abbreviation
+		b.WriteString(abbreviation)
+	}
+	block() // 32-bit data
+	block() // 64-bit data, alike without transitions
+	b.WriteString("\n" + rule + "\n")
+	return b.Bytes()
+}
+
+func (z timeZones) location(tzid string) (*time.Location, error) {
+	if tzid == "" {
+		return time.UTC, nil
+	}
+	if location, ok := z[tzid]; ok {
+		return location, nil
+	}
+	location, err := time.LoadLocation(tzid)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", tzid)
+	}
+	z[tzid] = location
+	return location, nil
+}
+
+func (z timeZones) parseTime(value string, params map[string]string) (time.Time, error) {
+	location, err := z.location(params["TZID"])
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch {
+	case params["VALUE"] == "DATE" || len(value) == 8:
+		return time.ParseInLocation("20060102", value, location)
+	case strings.HasSuffix(value, "Z"):
+		return time.Parse(icalDateLayout, value)
+	default:
+		return time.ParseInLocation("20060102T150405", value, location)
+	}
+}
+
+func (z timeZones) parseTimes(property icalProperty) ([]time.Time, error) {
+	res := []time.Time{}
+	for _, value := range strings.Split(property.Value, ",") {
+		t, err := z.parseTime(value, property.Params)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func parseUtcOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 || (value[0] != '+' && value[0] != '-') {
+		return 0, fmt.Errorf("malformed utc offset %q", value)
+	}
+	hours, err := strconv.Atoi(value[1:3])
+	if err != nil {
+		return 0, err
+	}
+	minutes, err := strconv.Atoi(value[3:5])
+	if err != nil {
+		return 0, err
+	}
+	offset := hours*3600 + minutes*60
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return offset, nil
+}
+
+// parseIcalDuration supports the subset of RFC 5545 durations used for
+// events: [+-]P[nW][nD][T[nH][nM][nS]].
+func parseIcalDuration(value string) (time.Duration, error) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+	}
+	rest := strings.TrimLeft(value, "+-")
+	if !strings.HasPrefix(rest, "P") || len(rest) == 1 {
+		return 0, fmt.Errorf("malformed duration %q", value)
+	}
+	rest = rest[1:]
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+	var total time.Duration
+	number := ""
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case c >= '0' && c <= '9':
+			number += string(c)
+		case c == 'T':
+			units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+		default:
+			unit, ok := units[c]
+			n, err := strconv.Atoi(number)
+			if !ok || err != nil {
+				return 0, fmt.Errorf("malformed duration %q", value)
+			}
+			total += time.Duration(n) * unit
+			number = ""
+		}
+	}
+	if number != "" {
+		return 0, fmt.Errorf("malformed duration %q", value)
+	}
+	return sign * total, nil
+}
+
+type recurrenceRule struct {
+	Reoccurance ReoccureanceChoice
+	Until       *time.Time
+	Count       int
+}
+
+// parseRecurrenceRule maps an RRULE onto the recurrences meetings support.
+func (z timeZones) parseRecurrenceRule(value string, start time.Time) (*recurrenceRule, error) {
+	parts := map[string]string{}
+	for _, part := range strings.Split(value, ";") {
+		name, value, _ := strings.Cut(part, "=")
+		parts[strings.ToUpper(name)] = strings.ToUpper(value)
+	}
+	if interval := parts["INTERVAL"]; interval != "" && interval != "1" {
+		return nil, fmt.Errorf("unsupported RRULE interval %s", interval)
+	}
+	rule := &recurrenceRule{}
+	byDay := parts["BYDAY"]
+	delete(parts, "BYDAY")
+	switch parts["FREQ"] {
+	case "DAILY":
+		rule.Reoccurance = Daily
+		if byDay == "MO,TU,WE,TH,FR" {
+			rule.Reoccurance = WorkingDays
+			byDay = ""
+		}
+	case "WEEKLY":
+		rule.Reoccurance = Weekly
+		switch byDay {
+		case "", weekdayCodes[start.Weekday()]:
+		case "MO,TU,WE,TH,FR":
+			rule.Reoccurance = WorkingDays
+		default:
+			return nil, fmt.Errorf("unsupported RRULE BYDAY=%s", byDay)
+		}
+		byDay = ""
+	case "MONTHLY":
+		rule.Reoccurance = Monthly
+		if monthDay := parts["BYMONTHDAY"]; monthDay != "" && monthDay != strconv.Itoa(start.Day()) {
+			return nil, fmt.Errorf("unsupported RRULE BYMONTHDAY=%s", monthDay)
+		}
+		delete(parts, "BYMONTHDAY")
+	case "YEARLY":
+		rule.Reoccurance = Yearly
+	default:
+		return nil, fmt.Errorf("unsupported RRULE frequency %q", parts["FREQ"])
+	}
+	if byDay != "" {
+		return nil, fmt.Errorf("unsupported RRULE BYDAY=%s", byDay)
+	}
+	for name, value := range parts {
+		switch name {
+		case "FREQ", "INTERVAL", "WKST":
+		case "UNTIL":
+			until, err := z.parseTime(value, map[string]string{})
+			if err != nil {
+				return nil, fmt.Errorf("malformed RRULE UNTIL: %w", err)
+			}
+			rule.Until = &until
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("malformed RRULE COUNT=%s", value)
+			}
+			rule.Count = count
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %s", name)
+		}
+	}
+	return rule, nil
+}
+
+var weekdayCodes = map[time.Weekday]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVtimezoneDaylightSavingTime(t *testing.T) {
+	calendar, err := parseCalendar(strings.NewReader(strings.ReplaceAll(`BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:W. Europe Standard Time
+BEGIN:STANDARD
+DTSTART:16010101T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+TZNAME:CET
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:16010101T020000
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+TZNAME:CEST
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VTIMEZONE
+TZID:Fixed
+BEGIN:STANDARD
+DTSTART:16010101T000000
+TZOFFSETFROM:+0530
+TZOFFSETTO:+0530
+END:STANDARD
+END:VTIMEZONE
+END:VCALENDAR
+`, "\n", "\r\n")))
+	require.NoError(t, err)
+	zones := makeTimeZones(calendar)
+	params := map[string]string{"TZID": "W. Europe Standard Time"}
+	for value, expected := range map[string]time.Time{
+		"20230115T090000": time.Date(2023, 1, 15, 8, 0, 0, 0, time.UTC),
+		"20230325T090000": time.Date(2023, 3, 25, 8, 0, 0, 0, time.UTC),
+		"20230326T090000": time.Date(2023, 3, 26, 7, 0, 0, 0, time.UTC),
+		"20230701T090000": time.Date(2023, 7, 1, 7, 0, 0, 0, time.UTC),
+		"20231029T090000": time.Date(2023, 10, 29, 8, 0, 0, 0, time.UTC),
+	} {
+		parsed, err := zones.parseTime(value, params)
+		require.NoError(t, err)
+		require.Equal(t, expected, parsed.UTC(), value)
+	}
+	parsed, err := zones.parseTime("20230701T090000", map[string]string{"TZID": "Fixed"})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 7, 1, 3, 30, 0, 0, time.UTC), parsed.UTC())
+}
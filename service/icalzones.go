@@ -0,0 +1,190 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// The time zones of exported meetings are described by VTIMEZONE components,
+// which clients need to resolve the TZID of local times. Their observances
+// are derived from the transitions of the zone around the meetings.
+
+// zoneYears is how many years past the meetings transitions are looked up,
+// the latest rules are taken to go on.
+const zoneYears = 5
+
+var icalWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// localTimes reports whether the times of the meeting are exported as local
+// times of its time zone: recurring meetings keep their local time.
+func (m *Meeting) localTimes() bool {
+	_, ok := recurrenceRules[m.Reoccurance]
+	return ok && m.TimeZone != ""
+}
+
+// zoneTransition is a change of the offset of a time zone.
+type zoneTransition struct {
+	at       time.Time // in UTC
+	from, to int       // offsets, in seconds east of UTC
+	name     string    // abbreviation from the change on
+	dst      bool
+}
+
+// local is the wall-clock time of the transition before it, which
+// observances start at.
+func (t zoneTransition) local() time.Time {
+	return t.at.Add(time.Duration(t.from) * time.Second)
+}
+
+// rule is the yearly repetition of the transition, on the same weekday of
+// the month, counted from the end of the month when it is the last one.
+func (t zoneTransition) rule() string {
+	local := t.local()
+	week := (local.Day()-1)/7 + 1
+	if local.Day()+7 > time.Date(local.Year(), local.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		week = -1
+	}
+	return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s", local.Month(), week, icalWeekdays[local.Weekday()])
+}
+
+// zoneTransitions returns the changes of the offset of location in the range,
+// to the second.
+func zoneTransitions(location *time.Location, from, to time.Time) []zoneTransition {
+	offsetAt := func(unix int64) int {
+		_, offset := time.Unix(unix, 0).In(location).Zone()
+		return offset
+	}
+	transitions := []zoneTransition{}
+	const day = 24 * 60 * 60
+	offset := offsetAt(from.Unix())
+	for start := from.Unix(); start < to.Unix(); start += day {
+		next := offsetAt(start + day)
+		if next == offset {
+			continue
+		}
+		low, high := start, start+day
+		for high-low > 1 {
+			middle := (low + high) / 2
+			if offsetAt(middle) == offset {
+				low = middle
+			} else {
+				high = middle
+			}
+		}
+		at := time.Unix(high, 0).In(location)
+		name, _ := at.Zone()
+		transitions = append(transitions, zoneTransition{at: at.UTC(), from: offset, to: next, name: name, dst: at.IsDST()})
+		offset = next
+	}
+	return transitions
+}
+
+// observance is a series of transitions a yearly rule repeats.
+type observance struct {
+	first, last zoneTransition
+	rule        string
+	count       int
+}
+
+// writeTimeZone describes location as a VTIMEZONE. Transitions of the same
+// kind in consecutive years are one observance with an RRULE, the ones still
+// going on at the end of the range without an end.
+func writeTimeZone(w *icalWriter, tzid string, location *time.Location, from, to time.Time) {
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", escapeText(tzid))
+	transitions := zoneTransitions(location, from, to)
+	if len(transitions) == 0 {
+		name, offset := from.In(location).Zone()
+		writeObservance(w, "STANDARD", time.Unix(0, 0).UTC(), offset, offset, name, "")
+		w.line("END", "VTIMEZONE")
+		return
+	}
+	observances := []*observance{}
+	series := map[string]*observance{}
+	for _, transition := range transitions {
+		rule := transition.rule()
+		key := fmt.Sprint(rule, transition.local().Format("150405"), transition.from, transition.to, transition.name, transition.dst)
+		if current, ok := series[key]; ok && current.last.at.Year() == transition.at.Year()-1 {
+			current.last = transition
+			current.count++
+			continue
+		}
+		series[key] = &observance{first: transition, last: transition, rule: rule, count: 1}
+		observances = append(observances, series[key])
+	}
+	sort.SliceStable(observances, func(i, j int) bool { return observances[i].first.at.Before(observances[j].first.at) })
+	// rules still applied in the last year go on
+	lastYear := to.Add(-time.Second).Year()
+	for _, o := range observances {
+		rule := ""
+		switch {
+		case o.count > 1 && o.last.at.Year() == lastYear:
+			rule = o.rule
+		case o.count > 1:
+			rule = o.rule + ";UNTIL=" + formatIcalTime(o.last.at)
+		}
+		kind := "STANDARD"
+		if o.first.dst {
+			kind = "DAYLIGHT"
+		}
+		writeObservance(w, kind, o.first.local(), o.first.from, o.first.to, o.first.name, rule)
+	}
+	w.line("END", "VTIMEZONE")
+}
+
+func writeObservance(w *icalWriter, kind string, start time.Time, from, to int, name, rule string) {
+	w.line("BEGIN", kind)
+	w.line("DTSTART", start.Format("20060102T150405"))
+	w.line("TZOFFSETFROM", formatUtcOffset(from))
+	w.line("TZOFFSETTO", formatUtcOffset(to))
+	if name != "" {
+		w.line("TZNAME", escapeText(name))
+	}
+	if rule != "" {
+		w.line("RRULE", rule)
+	}
+	w.line("END", kind)
+}
+
+func formatUtcOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+}
+
+// writeTimeZones writes a VTIMEZONE for every time zone the meetings are
+// exported in, covering them from the year before the first one.
+func writeTimeZones(w *icalWriter, stamp time.Time, meetings ...*Meeting) {
+	zones := map[string]*time.Location{}
+	first, last := map[string]time.Time{}, map[string]time.Time{}
+	tzids := []string{}
+	for _, meeting := range meetings {
+		if !meeting.localTimes() {
+			continue
+		}
+		tzid := meeting.TimeZone
+		if _, ok := zones[tzid]; !ok {
+			zones[tzid] = meeting.location()
+			first[tzid], last[tzid] = meeting.StartTime, stamp
+			tzids = append(tzids, tzid)
+		}
+		if meeting.StartTime.Before(first[tzid]) {
+			first[tzid] = meeting.StartTime
+		}
+		if meeting.StartTime.After(last[tzid]) {
+			last[tzid] = meeting.StartTime
+		}
+	}
+	sort.Strings(tzids)
+	for _, tzid := range tzids {
+		from := time.Date(first[tzid].Year()-1, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(last[tzid].Year()+zoneYears, 1, 1, 0, 0, 0, 0, time.UTC)
+		writeTimeZone(w, tzid, zones[tzid], from, to)
+	}
+}
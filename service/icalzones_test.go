@@ -0,0 +1,66 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestZoneTransitions(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	transitions := zoneTransitions(berlin, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.Len(t, transitions, 2)
+	require.Equal(t, time.Date(2023, 3, 26, 1, 0, 0, 0, time.UTC), transitions[0].at)
+	require.Equal(t, "FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU", transitions[0].rule())
+	require.True(t, transitions[0].dst)
+	require.Equal(t, time.Date(2023, 10, 29, 1, 0, 0, 0, time.UTC), transitions[1].at)
+	require.Equal(t, time.Date(2023, 10, 29, 3, 0, 0, 0, time.UTC), transitions[1].local())
+	require.Equal(t, 3600, transitions[1].to)
+}
+
+func TestExportedTimeZones(t *testing.T) {
+	s := &Service{}
+	meeting := func(id, zone string, reoccurance ReoccureanceChoice) Meeting {
+		location, err := time.LoadLocation(zone)
+		require.NoError(t, err)
+		start := time.Date(2023, 3, 7, 9, 0, 0, 0, location)
+		return Meeting{Id: id, Owner: "bob", StartTime: start, EndTime: start.Add(time.Hour), Reoccurance: reoccurance, TimeZone: zone}
+	}
+	meetings := []Meeting{
+		meeting("640a4862377457548608f501", "Europe/Berlin", Weekly),
+		meeting("640a4862377457548608f502", "America/New_York", Daily),
+		meeting("640a4862377457548608f503", "Asia/Kolkata", Daily),
+		meeting("640a4862377457548608f504", "Europe/Berlin", Monthly),
+		// times of single meetings are exported in UTC
+		meeting("640a4862377457548608f505", "Australia/Sydney", NoReoccurence),
+	}
+	calendar, err := parseCalendar(strings.NewReader(s.encodeCalendar("bob", meetings, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC))))
+	require.NoError(t, err)
+
+	tzids := []string{}
+	for _, vtimezone := range calendar.children("VTIMEZONE") {
+		tzid := vtimezone.value("TZID")
+		tzids = append(tzids, tzid)
+		// read the way other clients do, without looking up the TZID
+		exported, err := vtimezoneLocation(tzid, vtimezone)
+		require.NoError(t, err)
+		location, err := time.LoadLocation(tzid)
+		require.NoError(t, err)
+		for day := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC); day.Year() < 2030; day = day.AddDate(0, 0, 7) {
+			_, offset := day.In(location).Zone()
+			_, exportedOffset := day.In(exported).Zone()
+			require.Equal(t, offset, exportedOffset, "%s on %s", tzid, day)
+		}
+	}
+	require.Equal(t, []string{"America/New_York", "Asia/Kolkata", "Europe/Berlin"}, tzids)
+	for _, event := range calendar.children("VEVENT") {
+		if event.value("UID") == "640a4862377457548608f505@calendar" {
+			require.Equal(t, "20230306T220000Z", event.value("DTSTART"))
+			continue
+		}
+		require.Contains(t, tzids, event.property("DTSTART").Params["TZID"])
+	}
+}
@@ -0,0 +1,434 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const maxImportSize = 10 << 20
+
+type ImportStatus string
+
+var (
+	ImportCreated ImportStatus = "created"
+	ImportUpdated ImportStatus = "updated"
+	ImportSkipped ImportStatus = "skipped"
+)
+
+type ImportedEvent struct {
+	Uid       string       `json:"uid"`
+	Status    ImportStatus `json:"status"`
+	MeetingId string       `json:"meetingId,omitempty"`
+	Reason    string       `json:"reason,omitempty"`
+	Warnings  []string     `json:"warnings,omitempty"`
+}
+
+type ImportReport struct {
+	Created int             `json:"created"`
+	Updated int             `json:"updated"`
+	Skipped int             `json:"skipped"`
+	Events  []ImportedEvent `json:"events"`
+}
+
+func (s *Service) ImportCalendar(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+	var body io.Reader = r.Body
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			writeError(w, r, badRequest("expected the calendar in the \"file\" form field: %v", err))
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+	calendar, err := parseCalendar(body)
+	if err != nil {
+		writeError(w, r, badRequest("malformed iCalendar: %v", err))
+		return
+	}
+	zones := makeTimeZones(calendar)
+	report := ImportReport{Events: []ImportedEvent{}}
+	for _, event := range calendar.children("VEVENT") {
+		result := s.importEvent(pol, login, event, zones)
+		switch result.Status {
+		case ImportCreated:
+			report.Created++
+		case ImportUpdated:
+			report.Updated++
+		case ImportSkipped:
+			report.Skipped++
+		}
+		report.Events = append(report.Events, result)
+	}
+	writeJson(w, http.StatusOK, report)
+}
+
+// importEvent stores the event in the calendar of login. Events organized by
+// others are only imported for principals managing the organizer's calendar.
+func (s *Service) importEvent(pol *policy, login string, event *icalComponent, zones timeZones) ImportedEvent {
+	result := ImportedEvent{Uid: event.value("UID")}
+	skip := func(format string, args ...any) ImportedEvent {
+		result.Status = ImportSkipped
+		result.Reason = fmt.Sprintf(format, args...)
+		return result
+	}
+	if result.Uid == "" {
+		return skip("missing UID")
+	}
+	if event.property("RECURRENCE-ID") != nil {
+		return skip("modified occurrences of recurring events are not supported")
+	}
+	if strings.EqualFold(event.value("STATUS"), "CANCELLED") {
+		return skip("event is cancelled")
+	}
+	meeting, warnings, err := s.meetingFromEvent(login, event, zones)
+	result.Warnings = warnings
+	if err != nil {
+		return skip("%v", err)
+	}
+	if meeting.Owner != login && !pol.actsFor(meeting.Owner, ShareEdit) {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("organizer %s has not shared their calendar for editing", meeting.Owner))
+		return skip("can not import meetings organized by %q", meeting.Owner)
+	}
+
+	existing, err := s.findMeetingByUid(meeting.Uid)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return skip("failed to look up: %s", toApiError(err).Message)
+	}
+	if existing != nil && existing.Owner != meeting.Owner {
+		return skip("already imported with organizer %q", existing.Owner)
+	}
+	if existing != nil {
+		meeting.Reminders = existing.Reminders
+		keepCalendars(meeting, existing)
+		keepGroups(meeting, existing)
+		keepFiles(meeting, existing)
+	}
+	if err := s.validateMeeting(meeting); err != nil {
+		details, ok := validationDetails(err)
+		if !ok {
+			return skip("failed to validate: %s", toApiError(err).Message)
+		}
+		problems := []string{}
+		for _, detail := range details {
+			problems = append(problems, strings.TrimSpace(detail.Field+" "+detail.Message))
+		}
+		return skip("invalid meeting: %s", strings.Join(problems, "; "))
+	}
+	meeting.UpdatedBy = login
+	if err = s.storeMeeting(meeting, existing); err != nil {
+		return skip("failed to store: %s", toApiError(err).Message)
+	}
+	result.MeetingId = meeting.Id
+	result.Status = ImportCreated
+	if existing != nil {
+		result.Status = ImportUpdated
+	}
+	return result
+}
+
+func (s *Service) findMeetingByUid(uid string) (*Meeting, error) {
+	var meeting Meeting
+	err := s.DbClient.Database("db").Collection("meetings").FindOne(context.TODO(), bson.D{{"uid", uid}}).Decode(&meeting)
+	if err != nil {
+		return nil, err
+	}
+	return &meeting, nil
+}
+
+// errMeetingChanged is returned by storeMeeting when the meeting was changed
+// since existing was read.
+var errMeetingChanged = conflict("the meeting was changed meanwhile, try again")
+
+// storeMeeting inserts the meeting, or replaces existing with it, and fills
+// in its id, version and call. Every write of a meeting goes through it, or
+// deleteMeeting. Existing is only replaced while it is the stored version.
+func (s *Service) storeMeeting(meeting *Meeting, existing *Meeting) error {
+	if err := s.setConference(meeting, existing); err != nil {
+		return err
+	}
+	coll := s.DbClient.Database("db").Collection("meetings")
+	version, err := s.nextVersion()
+	if err != nil {
+		return err
+	}
+	meeting.Version = version
+	meeting.UpdatedAt = time.Now().UTC()
+	meeting.Deleted = false
+	if existing == nil {
+		meeting.CreatedVersion = version
+		meeting.Left = nil
+		meeting.Removed = nil
+		res, err := coll.InsertOne(context.TODO(), meeting)
+		if err != nil {
+			return err
+		}
+		if oid, ok := res.InsertedID.(primitive.ObjectID); ok {
+			meeting.Id = oid.Hex()
+		}
+		s.emit(MeetingCreated, meeting, "")
+		return nil
+	}
+	objectId, err := primitive.ObjectIDFromHex(existing.Id)
+	if err != nil {
+		return err
+	}
+	meeting.CreatedVersion = existing.CreatedVersion
+	meeting.Left = leftParticipants(existing, meeting)
+	meeting.Removed = removedParticipants(existing, meeting)
+	meeting.Id = ""
+	res, err := coll.ReplaceOne(context.TODO(), bson.D{{"_id", objectId}, {"version", existing.Version}}, meeting)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errMeetingChanged
+	}
+	meeting.Id = existing.Id
+	s.emit(MeetingUpdated, meeting, "")
+	return nil
+}
+
+// deleteMeeting turns the meeting into a tombstone, deleted by its
+// UpdatedBy, lets its invitees know and deletes its files. The UID is
+// released, so that the event can be imported again.
+func (s *Service) deleteMeeting(meeting *Meeting) error {
+	objectId, err := primitive.ObjectIDFromHex(meeting.Id)
+	if err != nil {
+		return err
+	}
+	version, err := s.nextVersion()
+	if err != nil {
+		return err
+	}
+	meeting.Version = version
+	meeting.UpdatedAt = time.Now().UTC()
+	meeting.Deleted = true
+	update := bson.D{
+		{"$set", bson.D{{"deleted", true}, {"version", version}, {"updatedAt", meeting.UpdatedAt}, {"updatedBy", meeting.UpdatedBy}}},
+		{"$unset", bson.D{{"uid", ""}, {"resourceName", ""}}},
+	}
+	if _, err := s.DbClient.Database("db").Collection("meetings").UpdateOne(context.TODO(), bson.D{{"_id", objectId}}, update); err != nil {
+		return err
+	}
+	s.emit(MeetingDeleted, meeting, "")
+	s.notifyInvitees(meeting, nil)
+	if err := s.deleteFiles(bson.D{{"metadata.meetingId", meeting.Id}}); err != nil {
+		log.Printf("deleting attachments of meeting %s: %v", meeting.Id, err)
+	}
+	return nil
+}
+
+// meetingFromEvent converts a VEVENT into a meeting in the calendar of login.
+// Attendees which are not users of the service are dropped with a warning.
+func (s *Service) meetingFromEvent(login string, event *icalComponent, zones timeZones) (*Meeting, []string, error) {
+	warnings := []string{}
+	meeting := &Meeting{Uid: event.value("UID"), Invited: []Invitation{}}
+	meeting.Sequence, _ = strconv.Atoi(event.value("SEQUENCE"))
+
+	dtstart := event.property("DTSTART")
+	if dtstart == nil {
+		return nil, warnings, fmt.Errorf("missing DTSTART")
+	}
+	start, err := zones.parseTime(dtstart.Value, dtstart.Params)
+	if err != nil {
+		return nil, warnings, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	allDay := dtstart.Params["VALUE"] == "DATE" || len(dtstart.Value) == 8
+	end := start
+	switch dtend, duration := event.property("DTEND"), event.property("DURATION"); {
+	case dtend != nil:
+		if end, err = zones.parseTime(dtend.Value, dtend.Params); err != nil {
+			return nil, warnings, fmt.Errorf("invalid DTEND: %w", err)
+		}
+	case duration != nil:
+		d, err := parseIcalDuration(duration.Value)
+		if err != nil {
+			return nil, warnings, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		end = start.Add(d)
+	case allDay:
+		end = start.AddDate(0, 0, 1)
+	}
+	meeting.StartTime = start.UTC().Truncate(60 * time.Second)
+	meeting.EndTime = end.UTC()
+	if tzid := dtstart.Params["TZID"]; tzid != "" && tzid != "Local" {
+		// recurring meetings repeat in the time zone of the event, when it's
+		// one of the IANA database
+		if _, err := time.LoadLocation(tzid); err == nil {
+			meeting.TimeZone = tzid
+		}
+	}
+	if !meeting.EndTime.After(meeting.StartTime) || meeting.EndTime.Sub(meeting.StartTime) > 24*time.Hour {
+		return nil, warnings, fmt.Errorf("meetings have to end after they start and last at most 24h")
+	}
+
+	if rrule := event.value("RRULE"); rrule != "" {
+		rule, err := zones.parseRecurrenceRule(rrule, start)
+		if err != nil {
+			return nil, warnings, err
+		}
+		meeting.Reoccurance = rule.Reoccurance
+		meeting.ReoccurUntil = rule.Until
+		if rule.Count != 0 {
+			last := meeting
+			for i := 1; i < rule.Count && last != nil; i++ {
+				last = last.NextOccurence(nil)
+			}
+			if last != nil {
+				meeting.ReoccurUntil = &last.StartTime
+			}
+		}
+	}
+	for _, exdate := range event.all("EXDATE") {
+		times, err := zones.parseTimes(exdate)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("ignored EXDATE: %v", err))
+			continue
+		}
+		for _, t := range times {
+			if !allDay && (exdate.Params["VALUE"] == "DATE" || len(strings.Split(exdate.Value, ",")[0]) == 8) {
+				// a whole day is excluded, the occurrence starts at the usual time
+				t = time.Date(t.Year(), t.Month(), t.Day(), start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+			}
+			meeting.ExDates = append(meeting.ExDates, t.UTC().Truncate(60*time.Second))
+		}
+	}
+
+	meeting.Title = strings.TrimSpace(unescapeText(event.value("SUMMARY")))
+	meeting.Description = strings.TrimSpace(unescapeText(event.value("DESCRIPTION")))
+	meeting.Location = strings.TrimSpace(unescapeText(event.value("LOCATION")))
+	for _, conference := range event.all("CONFERENCE") {
+		switch {
+		case strings.HasPrefix(strings.ToLower(conference.Value), "tel:"):
+			// dial-ins are the ones of the conference provider
+		case !isHttpUrl(conference.Value):
+			warnings = append(warnings, fmt.Sprintf("ignored CONFERENCE %q: not an http(s) url", conference.Value))
+		case meeting.ConferenceUrl == "":
+			meeting.ConferenceUrl = conference.Value
+		}
+	}
+	for _, attach := range event.all("ATTACH") {
+		if attach.Params["VALUE"] == "BINARY" || !isHttpUrl(attach.Value) {
+			// only references are kept, not the files themselves
+			warnings = append(warnings, "ignored ATTACH: not an http(s) url")
+			continue
+		}
+		meeting.Attachments = append(meeting.Attachments, Attachment{
+			Name:     attachmentName(attach),
+			Url:      attach.Value,
+			MimeType: attach.Params["FMTTYPE"],
+		})
+	}
+
+	if class := strings.ToUpper(event.value("CLASS")); class != "" {
+		// unknown classes are to be treated as private
+		meeting.Visibility = VisibilityPrivate
+		for visibility, name := range visibilityClasses {
+			if name == class {
+				meeting.Visibility = visibility
+			}
+		}
+	}
+
+	meeting.Owner = login
+	if organizer := event.property("ORGANIZER"); organizer != nil {
+		if owner := s.resolveAddress(organizer.Value); owner != "" {
+			meeting.Owner = owner
+		} else {
+			warnings = append(warnings, fmt.Sprintf("organizer %s is not a user, imported as organized by %s", organizer.Value, login))
+		}
+	}
+	participants := map[string]bool{meeting.Owner: true}
+	for _, attendee := range event.all("ATTENDEE") {
+		invitee := s.resolveAddress(attendee.Value)
+		if invitee == "" {
+			warnings = append(warnings, fmt.Sprintf("attendee %s is not a user", attendee.Value))
+			continue
+		}
+		if participants[invitee] {
+			continue
+		}
+		participants[invitee] = true
+		invitation := Invitation{Invitee: invitee}
+		switch strings.ToUpper(attendee.Params["PARTSTAT"]) {
+		case "ACCEPTED":
+			invitation.Accepted = Accepted
+		case "DECLINED":
+			invitation.Accepted = Declined
+		}
+		meeting.Invited = append(meeting.Invited, invitation)
+	}
+	if !participants[login] {
+		meeting.Invited = append(meeting.Invited, Invitation{Invitee: login})
+	}
+	return meeting, warnings, nil
+}
+
+// resolveAddress maps a calendar address (mailto: URI) onto the login of an
+// existing user, by their email or their address at the mail domain, or
+// returns an empty string. Addresses elsewhere are of people outside.
+func (s *Service) resolveAddress(address string) string {
+	email := strings.TrimSpace(address)
+	if len(email) >= 7 && strings.EqualFold(email[:7], "mailto:") {
+		email = email[7:]
+	}
+	var user User
+	err := s.DbClient.Database("db").Collection("users").FindOne(context.TODO(), bson.D{{"email", email}}).Decode(&user)
+	if err == nil {
+		return user.Login
+	}
+	login := addressLogin(address, s.mailDomain())
+	if login == "" {
+		return ""
+	}
+	if _, err := s.findUser(login); err != nil {
+		return ""
+	}
+	return login
+}
+
+// attachmentName is the file name of an ATTACH property, or the last segment
+// of its url.
+func attachmentName(attach icalProperty) string {
+	for _, param := range []string{"FILENAME", "X-FILENAME"} {
+		if name := strings.TrimSpace(attach.Params[param]); name != "" {
+			return name
+		}
+	}
+	if target, err := url.Parse(attach.Value); err == nil {
+		if name := path.Base(target.Path); name != "." && name != "/" {
+			return name
+		}
+	}
+	return attach.Value
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Scheduling messages (iTIP, RFC 5546) are exchanged with invitees by email
+// (iMIP, RFC 6047): organizers' changes go out as REQUEST and CANCEL,
+// invitees' answers come back as REPLY.
+
+// notifyInvitees mails the invitees of a meeting which was created (previous
+// is nil), changed, or cancelled (meeting is nil). Invitees dropped from a
+// changed meeting receive a cancellation.
+func (s *Service) notifyInvitees(previous, meeting *Meeting) {
+	if s.Mail == nil {
+		return
+	}
+	current := map[string]bool{}
+	if meeting != nil {
+		for _, invitation := range meeting.Invited {
+			if invitation.Invitee != meeting.Owner {
+				current[invitation.Invitee] = true
+				s.sendItip("REQUEST", meeting, invitation.Invitee)
+			}
+		}
+	}
+	if previous == nil {
+		return
+	}
+	cancelled := *previous
+	cancelled.Sequence++
+	if meeting != nil {
+		cancelled.Sequence = meeting.Sequence
+	}
+	for _, invitation := range previous.Invited {
+		if invitation.Invitee != previous.Owner && !current[invitation.Invitee] {
+			s.sendItip("CANCEL", &cancelled, invitation.Invitee)
+		}
+	}
+}
+
+func (s *Service) sendItip(method string, meeting *Meeting, invitee string) {
+	to, err := s.mailAddress(invitee)
+	if err != nil {
+		log.Printf("itip %s of meeting %s: %v", method, meeting.Id, err)
+		return
+	}
+	message := &MailMessage{
+		From:     s.mailFrom(),
+		To:       to,
+		Method:   method,
+		Calendar: s.encodeItip(method, meeting, time.Now()),
+	}
+	when := meeting.StartTime.UTC().Format("Mon Jan 2, 2006 15:04") + " - " + meeting.EndTime.UTC().Format("15:04 MST")
+	switch {
+	case method == "CANCEL":
+		message.Subject = "Cancelled: " + summary(meeting)
+		message.Body = fmt.Sprintf("%s has cancelled %q (%s).\n", meeting.Owner, summary(meeting), when)
+	case meeting.Sequence > 0:
+		message.Subject = "Updated invitation: " + summary(meeting)
+		message.Body = fmt.Sprintf("%s has changed %q, it now takes place %s.\n", meeting.Owner, summary(meeting), when)
+	default:
+		message.Subject = "Invitation: " + summary(meeting)
+		message.Body = fmt.Sprintf("%s invites you to %q, %s.\n", meeting.Owner, summary(meeting), when)
+	}
+	if meeting.Description != "" && method != "CANCEL" {
+		message.Body += "\n" + meeting.Description + "\n"
+	}
+	if meeting.ConferenceUrl != "" && method != "CANCEL" {
+		message.Body += "\nJoin: " + meeting.ConferenceUrl + "\n"
+		for _, dialIn := range meeting.DialIns {
+			message.Body += "Dial in: " + dialIn.Number
+			if dialIn.Pin != "" {
+				message.Body += ", PIN " + dialIn.Pin
+			}
+			message.Body += "\n"
+		}
+	}
+	s.sendMail(message, fmt.Sprintf("itip %s of meeting %s", method, meeting.Id))
+}
+
+// mailAddress is where mail for the user is delivered, their own email or
+// their calendar address.
+func (s *Service) mailAddress(login string) (string, error) {
+	user, err := s.findUser(login)
+	if err != nil {
+		return "", err
+	}
+	if user.Email != "" {
+		return user.Email, nil
+	}
+	return strings.TrimPrefix(s.calendarAddress(login), "mailto:"), nil
+}
+
+func (s *Service) mailFrom() string {
+	if s.MailFrom != "" {
+		return s.MailFrom
+	}
+	return strings.Replace(s.calendarAddress("calendar"), "mailto:", "", 1)
+}
+
+func (s *Service) encodeItip(method string, meeting *Meeting, stamp time.Time) string {
+	w := &icalWriter{}
+	w.beginCalendar()
+	w.line("METHOD", method)
+	if method != "CANCEL" {
+		writeTimeZones(w, stamp, meeting)
+		s.writeEvent(w, meeting, stamp)
+		w.line("END", "VCALENDAR")
+		return w.String()
+	}
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", escapeText(s.meetingUid(meeting)))
+	w.line("DTSTAMP", formatIcalTime(stamp))
+	w.line("DTSTART", formatIcalTime(meeting.StartTime))
+	w.line("SEQUENCE", fmt.Sprint(meeting.Sequence))
+	w.line("STATUS", "CANCELLED")
+	w.line("SUMMARY", escapeText(summary(meeting)))
+	w.line("ORGANIZER;CN="+quoteParam(meeting.Owner), s.calendarAddress(meeting.Owner))
+	for _, invitation := range meeting.Invited {
+		w.line("ATTENDEE;CN="+quoteParam(invitation.Invitee), s.calendarAddress(invitation.Invitee))
+	}
+	w.line("END", "VEVENT")
+	w.line("END", "VCALENDAR")
+	return w.String()
+}
+
+// ReceiveItip applies REPLY messages of invitees. The body is either the
+// calendar object or the whole email, so it can be fed from a mail hook.
+func (s *Service) ReceiveItip(w http.ResponseWriter, r *http.Request) {
+	body, err := itipCalendar(http.MaxBytesReader(w, r.Body, maxImportSize), r.Header.Get("Content-Type"))
+	if err != nil {
+		writeError(w, r, badRequest("%v", err))
+		return
+	}
+	calendar, err := parseCalendar(body)
+	if err != nil {
+		writeError(w, r, badRequest("malformed iCalendar: %v", err))
+		return
+	}
+	if method := strings.ToUpper(calendar.value("METHOD")); method != "REPLY" {
+		writeError(w, r, validationFailed("unsupported iTIP method", ErrorDetail{"METHOD", fmt.Sprintf("expected REPLY, got %q", method)}))
+		return
+	}
+	// every reply is checked before any is applied, so that a rejected
+	// attendee doesn't leave the others answered
+	type itipReply struct {
+		login  string
+		choice AcceptedChoice
+	}
+	meetings := []*Meeting{}
+	replies := [][]itipReply{}
+	for _, event := range calendar.children("VEVENT") {
+		meeting, err := s.findMeetingByItipUid(event.value("UID"))
+		if err == mongo.ErrNoDocuments {
+			writeError(w, r, notFound("meeting %q not found", event.value("UID")))
+			return
+		}
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		eventReplies := []itipReply{}
+		for _, attendee := range event.all("ATTENDEE") {
+			login := s.resolveAddress(attendee.Value)
+			if login == "" || !meeting.isInvited(login) {
+				writeError(w, r, validationFailed("unknown attendee", ErrorDetail{"ATTENDEE", fmt.Sprintf("%s is not invited", attendee.Value)}))
+				return
+			}
+			// users reply for themselves, a mail server piping replies in uses
+			// the admin token
+			if err := authorize(r, login); err != nil {
+				writeError(w, r, err)
+				return
+			}
+			choice := NotReviewed
+			switch strings.ToUpper(attendee.Params["PARTSTAT"]) {
+			case "ACCEPTED":
+				choice = Accepted
+			case "DECLINED":
+				choice = Declined
+			}
+			eventReplies = append(eventReplies, itipReply{login, choice})
+		}
+		meetings = append(meetings, meeting)
+		replies = append(replies, eventReplies)
+	}
+	updated := []Meeting{}
+	for i, meeting := range meetings {
+		for _, reply := range replies[i] {
+			if meeting, err = s.respond(meeting.Id, reply.login, reply.choice, reply.login, ""); err != nil {
+				writeError(w, r, err)
+				return
+			}
+		}
+		updated = append(updated, *meeting)
+	}
+	writeJson(w, http.StatusOK, updated)
+}
+
+func (s *Service) findMeetingByItipUid(uid string) (*Meeting, error) {
+	id := strings.TrimSuffix(uid, "@calendar")
+	if _, err := primitive.ObjectIDFromHex(id); err == nil && id != uid {
+		return s.findMeeting(id)
+	}
+	return s.findMeetingByUid(uid)
+}
+
+func (m *Meeting) isInvited(login string) bool {
+	for _, invitation := range m.Invited {
+		if invitation.Invitee == login {
+			return true
+		}
+	}
+	return false
+}
+
+// itipCalendar extracts the text/calendar part of a message.
+func itipCalendar(body io.Reader, contentType string) (io.Reader, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil && contentType != "" {
+		return nil, fmt.Errorf("invalid content type: %w", err)
+	}
+	switch {
+	case mediaType == "message/rfc822":
+		message, err := mail.ReadMessage(body)
+		if err != nil {
+			return nil, fmt.Errorf("malformed message: %w", err)
+		}
+		return itipPart(message.Body, message.Header.Get("Content-Type"), message.Header.Get("Content-Transfer-Encoding"))
+	case strings.HasPrefix(mediaType, "multipart/"):
+		return itipMultipart(body, params["boundary"])
+	default:
+		return body, nil
+	}
+}
+
+func itipPart(body io.Reader, contentType, encoding string) (io.Reader, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type: %w", err)
+	}
+	switch strings.ToLower(encoding) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+	switch {
+	case mediaType == "text/calendar" || mediaType == "application/ics":
+		return body, nil
+	case strings.HasPrefix(mediaType, "multipart/"):
+		return itipMultipart(body, params["boundary"])
+	default:
+		return nil, fmt.Errorf("no calendar in the message")
+	}
+}
+
+func itipMultipart(body io.Reader, boundary string) (io.Reader, error) {
+	reader := multipart.NewReader(body, boundary)
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no calendar in the message")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed message: %w", err)
+		}
+		calendar, err := itipPart(part, part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"))
+		if err == nil {
+			// the part is only valid until the next one is read
+			content, err := io.ReadAll(calendar)
+			if err != nil {
+				return nil, fmt.Errorf("malformed message: %w", err)
+			}
+			return bytes.NewReader(content), nil
+		}
+	}
+}
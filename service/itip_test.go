@@ -0,0 +1,240 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestItipRoundTripsThroughMail(t *testing.T) {
+	s := &Service{MailDomain: "example.com"}
+	meeting := &Meeting{
+		Id:          "640a4862377457548608f50a",
+		Owner:       "bob",
+		Invited:     []Invitation{{Invitee: "alice"}},
+		StartTime:   time.Date(2023, 3, 7, 16, 0, 0, 0, time.UTC),
+		EndTime:     time.Date(2023, 3, 7, 16, 30, 0, 0, time.UTC),
+		Description: "Planning",
+		Sequence:    2,
+	}
+	sender := &MemorySender{}
+	message := &MailMessage{
+		From:     "calendar@example.com",
+		To:       "alice@example.com",
+		Subject:  "Updated invitation: Planning",
+		Body:     "bob has changed \"Planning\"",
+		Method:   "REQUEST",
+		Calendar: s.encodeItip("REQUEST", meeting, meeting.StartTime),
+	}
+	require.NoError(t, sender.Send(context.Background(), message))
+	require.Equal(t, []MailMessage{*message}, sender.Messages())
+
+	encoded := encodeMail(message, meeting.StartTime)
+	require.Contains(t, string(encoded), "Content-Type: text/calendar; charset=utf-8; method=REQUEST")
+	calendar, err := itipCalendar(bytes.NewReader(encoded), "message/rfc822")
+	require.NoError(t, err)
+	content, err := io.ReadAll(calendar)
+	require.NoError(t, err)
+	require.Equal(t, message.Calendar, string(content))
+
+	parsed, err := parseCalendar(strings.NewReader(string(content)))
+	require.NoError(t, err)
+	require.Equal(t, "REQUEST", parsed.value("METHOD"))
+	event := parsed.children("VEVENT")[0]
+	require.Equal(t, "640a4862377457548608f50a@calendar", event.value("UID"))
+	require.Equal(t, "2", event.value("SEQUENCE"))
+	require.Equal(t, "mailto:alice@example.com", event.value("ATTENDEE"))
+}
+
+func TestAddressLogin(t *testing.T) {
+	require.Equal(t, "bob", addressLogin("mailto:bob@example.com", "example.com"))
+	require.Equal(t, "bob", addressLogin(" MAILTO:bob@Example.COM", "example.com"))
+	// people elsewhere aren't users, whatever their names
+	require.Empty(t, addressLogin("mailto:bob@gmail.com", "example.com"))
+	require.Empty(t, addressLogin("mailto:bob@example.com.evil.org", "example.com"))
+	require.Empty(t, addressLogin("mailto:bob", "example.com"))
+	require.Equal(t, "bob", addressLogin("mailto:bob@calendar.local", (&Service{}).mailDomain()))
+}
+
+func TestItipCancel(t *testing.T) {
+	s := &Service{}
+	meeting := &Meeting{
+		Uid:       "standup@example.com",
+		Owner:     "bob",
+		Invited:   []Invitation{{Invitee: "alice", Accepted: Accepted}},
+		StartTime: time.Date(2023, 3, 7, 9, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2023, 3, 7, 9, 15, 0, 0, time.UTC),
+		Sequence:  1,
+	}
+	parsed, err := parseCalendar(strings.NewReader(s.encodeItip("CANCEL", meeting, meeting.StartTime)))
+	require.NoError(t, err)
+	require.Equal(t, "CANCEL", parsed.value("METHOD"))
+	event := parsed.children("VEVENT")[0]
+	require.Equal(t, "standup@example.com", event.value("UID"))
+	require.Equal(t, "CANCELLED", event.value("STATUS"))
+	require.Equal(t, "1", event.value("SEQUENCE"))
+	require.Equal(t, "mailto:alice@calendar.local", event.value("ATTENDEE"))
+}
+
+func TestItipCalendarFromQuotedPrintableReply(t *testing.T) {
+	message := strings.Join([]string{
+		"From: alice@example.com",
+		"To: calendar@example.com",
+		"Subject: Accepted: Planning",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/alternative; boundary="b1"`,
+		"",
+		"--b1",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		"alice has accepted",
+		"--b1",
+		"Content-Type: text/calendar; charset=utf-8; method=REPLY",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"BEGIN:VCALENDAR",
+		"METHOD:REPLY",
+		"BEGIN:VEVENT",
+		"UID:640a4862377457548608f50a@calendar",
+		"ATTENDEE;PARTSTAT=3DACCEPTED:mailto:alice@example.com",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"--b1--",
+		"",
+	}, "\r\n")
+	calendar, err := itipCalendar(strings.NewReader(message), "message/rfc822")
+	require.NoError(t, err)
+	parsed, err := parseCalendar(calendar)
+	require.NoError(t, err)
+	attendee := parsed.children("VEVENT")[0].property("ATTENDEE")
+	require.Equal(t, "ACCEPTED", attendee.Params["PARTSTAT"])
+
+	_, err = itipCalendar(strings.NewReader("From: a@example.com\r\nContent-Type: text/plain\r\n\r\nhello\r\n"), "message/rfc822")
+	require.Error(t, err)
+}
+
+func TestEventDetails(t *testing.T) {
+	s := &Service{}
+	meeting := &Meeting{
+		Id:            "640a4862377457548608f50a",
+		Owner:         "bob",
+		StartTime:     time.Date(2023, 3, 7, 16, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2023, 3, 7, 16, 30, 0, 0, time.UTC),
+		Title:         "Design review",
+		Description:   "Agenda:\n- storage, sync",
+		Location:      "Room 4; 2nd floor",
+		ConferenceUrl: "https://meet.example.com/design",
+		DialIns:       []DialIn{{Number: "+49 30 1234567", Pin: "123456789"}},
+		Attachments: []Attachment{
+			{Name: "agenda.pdf", Url: "https://files.example.com/1", MimeType: "application/pdf"},
+			{Name: "notes", Url: "https://files.example.com/notes.txt"},
+		},
+	}
+	// stored files are downloaded with a token, relative urls don't resolve
+	// in other calendars
+	links := meeting.Attachments
+	meeting.Attachments = append(links, Attachment{Id: "640f1c2e9b1e8a3d4c5b6a79", Name: "slides.pdf", Url: fileUrl("1", "640f1c2e9b1e8a3d4c5b6a79")})
+	parsed, err := parseCalendar(strings.NewReader(s.encodeItip("REQUEST", meeting, meeting.StartTime)))
+	require.NoError(t, err)
+	event := parsed.children("VEVENT")[0]
+	require.Equal(t, "Design review", unescapeText(event.value("SUMMARY")))
+	require.Equal(t, "Agenda:\n- storage, sync", unescapeText(event.value("DESCRIPTION")))
+	require.Equal(t, "Room 4; 2nd floor", unescapeText(event.value("LOCATION")))
+	conferences := event.all("CONFERENCE")
+	require.Len(t, conferences, 2)
+	require.Equal(t, "https://meet.example.com/design", conferences[0].Value)
+	require.Equal(t, "tel:+49301234567,,123456789", conferences[1].Value)
+	require.Equal(t, "PHONE", conferences[1].Params["FEATURE"])
+	attachments := []Attachment{}
+	for _, attach := range event.all("ATTACH") {
+		attachments = append(attachments, Attachment{Name: attachmentName(attach), Url: attach.Value, MimeType: attach.Params["FMTTYPE"]})
+	}
+	require.Equal(t, links, attachments)
+
+	require.Equal(t, "notes.txt", attachmentName(icalProperty{Value: "https://files.example.com/notes.txt"}))
+	require.Equal(t, "https://files.example.com/", attachmentName(icalProperty{Value: "https://files.example.com/"}))
+
+	// busy placeholders give nothing away
+	meeting.Busy = true
+	content := s.encodeItip("REQUEST", meeting, meeting.StartTime)
+	for _, name := range []string{"Design", "LOCATION", "CONFERENCE", "ATTACH"} {
+		require.NotContains(t, content, name)
+	}
+}
+
+func TestSmtpSenderRefusesAuthWithoutTls(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	commands := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		text := textproto.NewConn(conn)
+		text.PrintfLine("220 relay")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				close(commands)
+				return
+			}
+			commands <- line
+			switch {
+			case strings.HasPrefix(line, "EHLO"):
+				text.PrintfLine("250-relay\r\n250 AUTH PLAIN")
+			case strings.HasPrefix(line, "QUIT"):
+				text.PrintfLine("221 bye")
+			default:
+				text.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+	sender := &SmtpSender{Addr: listener.Addr().String(), Auth: smtp.PlainAuth("", "calendar", "secret", "relay")}
+	err = sender.Send(context.Background(), &MailMessage{From: "calendar@example.com", To: "alice@example.com"})
+	require.ErrorContains(t, err, "refusing to authenticate")
+	for command := range commands {
+		require.False(t, strings.HasPrefix(command, "AUTH"), command)
+	}
+}
+
+func TestSendMailAfterStop(t *testing.T) {
+	mail := &MemorySender{}
+	s := &Service{Mail: mail}
+	s.sendMail(&MailMessage{To: "alice@example.com"}, "test")
+	s.stopMails()
+	require.Len(t, mail.Messages(), 1)
+	s.sendMail(&MailMessage{To: "carl@example.com"}, "test")
+	s.stopMails()
+	require.Len(t, mail.Messages(), 1)
+}
+
+func TestSmtpSenderDeadline(t *testing.T) {
+	// the relay accepts the connection but never greets
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(5 * time.Second)
+		}
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	err = (&SmtpSender{Addr: listener.Addr().String()}).Send(ctx, &MailMessage{From: "calendar@example.com", To: "alice@example.com"})
+	require.Error(t, err)
+	require.Less(t, time.Since(started), 2*time.Second)
+}
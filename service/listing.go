@@ -0,0 +1,146 @@
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Listings page through the schedule of a user, which is ordered by start
+// and then by the id of the meeting an occurrence belongs to. The cursor of a
+// page is the position of its last meeting, the next page continues the
+// schedule after it, so that pages neither repeat nor skip meetings.
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// listPosition is the last meeting of a page a client has seen.
+type listPosition struct {
+	start time.Time
+	id    string // of the meeting, or of the one the occurrence belongs to
+}
+
+func positionOf(meeting *Meeting) listPosition {
+	return listPosition{start: meeting.StartTime, id: meeting.meetingId()}
+}
+
+func (p listPosition) cursor() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("v1:%d:%s", p.start.UnixNano(), p.id)))
+}
+
+func parseCursor(cursor string) (listPosition, error) {
+	position := listPosition{}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return position, fmt.Errorf("invalid cursor")
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || parts[0] != "v1" {
+		return position, fmt.Errorf("invalid cursor")
+	}
+	nanos, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return position, fmt.Errorf("invalid cursor")
+	}
+	if _, err := primitive.ObjectIDFromHex(parts[2]); err != nil {
+		return position, fmt.Errorf("invalid cursor")
+	}
+	position.start, position.id = time.Unix(0, nanos).UTC(), parts[2]
+	return position, nil
+}
+
+// passed reports whether the meeting is at or before the position.
+func (p listPosition) passed(meeting *Meeting) bool {
+	return !scheduledBefore(&Meeting{StartTime: p.start, Id: p.id}, meeting)
+}
+
+// parsePage returns the size of a page, and the position it starts after,
+// nil for the first page.
+func parsePage(r *http.Request) (int, *listPosition, error) {
+	limit := defaultPageSize
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 || limit > maxPageSize {
+			return 0, nil, badRequest("invalid limit %q", value)
+		}
+	}
+	cursor := r.URL.Query().Get("cursor")
+	if cursor == "" {
+		return limit, nil, nil
+	}
+	position, err := parseCursor(cursor)
+	if err != nil {
+		return 0, nil, badRequest("%v", err)
+	}
+	return limit, &position, nil
+}
+
+// nextPageLink links the page following the one of the request, which ends
+// at position.
+func nextPageLink(r *http.Request, position listPosition) string {
+	query := r.URL.Query()
+	query.Set("cursor", position.cursor())
+	next := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	return fmt.Sprintf("<%s>; rel=\"next\"", next.String())
+}
+
+var statuses = map[string]AcceptedChoice{"notReviewed": NotReviewed, "accepted": Accepted, "declined": Declined}
+
+// meetingFilter selects meetings of a listing by the part the user has in
+// them, as far as the caller may see it, and by their title, description or
+// location.
+type meetingFilter struct {
+	role   string          // "owner" or "invitee", any when empty
+	status *AcceptedChoice // answer of the user, owners have accepted
+	text   string          // lower case, searched in titles, descriptions and locations
+}
+
+func parseMeetingFilter(r *http.Request) (meetingFilter, error) {
+	query := r.URL.Query()
+	filter := meetingFilter{role: query.Get("role"), text: strings.ToLower(strings.TrimSpace(query.Get("q")))}
+	switch filter.role {
+	case "", "owner", "invitee":
+	default:
+		return filter, badRequest("invalid role %q", filter.role)
+	}
+	if value := query.Get("status"); value != "" {
+		status, ok := statuses[value]
+		if !ok {
+			return filter, badRequest("invalid status %q", value)
+		}
+		filter.status = &status
+	}
+	return filter, nil
+}
+
+func (f meetingFilter) matches(meeting *Meeting, login string) bool {
+	owner, invited, answer := meeting.Owner == login, false, Accepted
+	for _, invitation := range meeting.Invited {
+		if invitation.Invitee == login && !owner {
+			invited, answer = true, invitation.Accepted
+		}
+	}
+	switch {
+	case f.role == "owner" && !owner, f.role == "invitee" && !invited:
+		return false
+	case f.status != nil && (!(owner || invited) || answer != *f.status):
+		return false
+	}
+	if f.text == "" {
+		return true
+	}
+	for _, value := range []string{meeting.Title, meeting.Description, meeting.Location} {
+		if strings.Contains(strings.ToLower(value), f.text) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	start := time.Date(2023, 3, 7, 16, 20, 0, 0, time.UTC)
+	position := listPosition{start: start, id: "640a4862377457548608f50a"}
+	parsed, err := parseCursor(position.cursor())
+	require.NoError(t, err)
+	require.Equal(t, position, parsed)
+	for _, cursor := range []string{"", "djE6MTI", position.cursor() + "x", "djI6MTI6NjQwYTQ4NjIzNzc0NTc1NDg2MDhmNTBh"} {
+		_, err := parseCursor(cursor)
+		require.Error(t, err, cursor)
+	}
+
+	// meetings starting together are ordered by id
+	occurrence := &Meeting{StartTime: start, seriesId: "640a4862377457548608f50b"}
+	require.True(t, position.passed(&Meeting{StartTime: start, Id: position.id}))
+	require.True(t, position.passed(&Meeting{StartTime: start, Id: "640a4862377457548608f509"}))
+	require.False(t, position.passed(occurrence))
+	require.False(t, position.passed(&Meeting{StartTime: start.Add(time.Minute), Id: "640a4862377457548608f509"}))
+	require.True(t, scheduledBefore(&Meeting{StartTime: start, Id: position.id}, occurrence))
+}
+
+func TestParsePage(t *testing.T) {
+	limit, position, err := parsePage(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	require.Equal(t, defaultPageSize, limit)
+	require.Nil(t, position)
+	for _, query := range []string{"limit=0", "limit=1001", "limit=ten", "cursor=invalid"} {
+		_, _, err := parsePage(httptest.NewRequest("GET", "/?"+query, nil))
+		require.Equal(t, CodeBadRequest, toApiError(err).Code, query)
+	}
+
+	r := httptest.NewRequest("GET", "/api/users/bob/meetings?startTime=2023-03-07T00:00:00Z&limit=10", nil)
+	link := nextPageLink(r, listPosition{start: time.Date(2023, 3, 7, 16, 20, 0, 0, time.UTC), id: "640a4862377457548608f50a"})
+	require.Regexp(t, `^</api/users/bob/meetings\?cursor=[\w-]+&limit=10&startTime=2023-03-07T00%3A00%3A00Z>; rel="next"$`, link)
+}
+
+func TestMeetingFilter(t *testing.T) {
+	meeting := &Meeting{
+		Owner:       "bob",
+		Invited:     []Invitation{{Invitee: "alice", Accepted: Declined}, {Invitee: "carol"}},
+		Description: "Weekly Planning",
+	}
+	parse := func(query string) meetingFilter {
+		filter, err := parseMeetingFilter(httptest.NewRequest("GET", "/?"+query, nil))
+		require.NoError(t, err)
+		return filter
+	}
+	require.True(t, parse("").matches(meeting, "dave"))
+	require.True(t, parse("role=owner&status=accepted").matches(meeting, "bob"))
+	require.False(t, parse("role=invitee").matches(meeting, "bob"))
+	require.True(t, parse("role=invitee&status=declined").matches(meeting, "alice"))
+	require.False(t, parse("status=accepted").matches(meeting, "alice"))
+	require.True(t, parse("status=notReviewed").matches(meeting, "carol"))
+	require.False(t, parse("status=notReviewed").matches(meeting, "dave"))
+	require.True(t, parse("q=+planning").matches(meeting, "carol"))
+	require.False(t, parse("q=review").matches(meeting, "carol"))
+
+	// busy meetings show neither participants nor descriptions
+	busy := &Meeting{Invited: []Invitation{}, Busy: true}
+	require.True(t, parse("").matches(busy, "alice"))
+	require.False(t, parse("role=invitee").matches(busy, "alice"))
+	require.False(t, parse("q=planning").matches(busy, "alice"))
+
+	for _, query := range []string{"role=organizer", "status=maybe"} {
+		_, err := parseMeetingFilter(httptest.NewRequest("GET", "/?"+query, nil))
+		require.Error(t, err, query)
+	}
+}
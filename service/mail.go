@@ -0,0 +1,200 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// mails are given up when the relay takes longer
+const mailTimeout = 30 * time.Second
+
+// MailMessage is an iMIP (RFC 6047) message: a plain text body with the
+// iTIP calendar object attached.
+type MailMessage struct {
+	From     string
+	To       string
+	Subject  string
+	Body     string
+	Method   string // iTIP method of the calendar, e.g. REQUEST
+	Calendar string
+}
+
+type MailSender interface {
+	Send(ctx context.Context, message *MailMessage) error
+}
+
+// SmtpSender delivers messages through a relay, using STARTTLS when the
+// relay offers it. The deadline of the context bounds the whole exchange.
+type SmtpSender struct {
+	Addr string // host:port
+	Auth smtp.Auth
+	// InsecureAuth authenticates to relays without STARTTLS too, sending the
+	// credentials in the clear, e.g. to a relay on the same host.
+	InsecureAuth bool
+}
+
+func (s *SmtpSender) Send(ctx context.Context, message *MailMessage) error {
+	from, err := mail.ParseAddress(message.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender: %w", err)
+	}
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	host, _, _ := net.SplitHostPort(s.Addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.Auth != nil {
+		if _, secure := client.TLSConnectionState(); !secure && !s.InsecureAuth {
+			return fmt.Errorf("relay %s doesn't offer STARTTLS, refusing to authenticate", s.Addr)
+		}
+		if err := client.Auth(s.Auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return err
+	}
+	data, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := data.Write(encodeMail(message, time.Now())); err != nil {
+		return err
+	}
+	if err := data.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// sendMail sends the message in the background, handlers and workers don't
+// wait for the relay. StopServing waits for the mails in flight, the ones
+// sent after it started are dropped.
+func (s *Service) sendMail(message *MailMessage, what string) {
+	s.mailsMu.Lock()
+	if s.mailsStopped {
+		s.mailsMu.Unlock()
+		log.Printf("%s to %s: dropped, the service is stopping", what, message.To)
+		return
+	}
+	s.mails.Add(1)
+	s.mailsMu.Unlock()
+	go func() {
+		defer s.mails.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), mailTimeout)
+		defer cancel()
+		if err := s.Mail.Send(ctx, message); err != nil {
+			log.Printf("%s to %s: %v", what, message.To, err)
+		}
+	}()
+}
+
+// MemorySender keeps messages instead of sending them.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []MailMessage
+}
+
+func (s *MemorySender) Send(ctx context.Context, message *MailMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, *message)
+	return nil
+}
+
+// Messages returns the messages sent so far.
+func (s *MemorySender) Messages() []MailMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]MailMessage{}, s.messages...)
+}
+
+// encodeMail renders the message as multipart/mixed with the calendar, if
+// any, both inline, for clients showing accept/decline buttons, and as an
+// attachment.
+func encodeMail(message *MailMessage, date time.Time) []byte {
+	var b bytes.Buffer
+	body := multipart.NewWriter(&b)
+	headers := []struct{ name, value string }{
+		{"From", message.From},
+		{"To", message.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"Message-Id", "<" + primitive.NewObjectID().Hex() + "@calendar>"},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": body.Boundary()})},
+	}
+	var header bytes.Buffer
+	for _, h := range headers {
+		fmt.Fprintf(&header, "%s: %s\r\n", h.name, h.value)
+	}
+	header.WriteString("\r\n")
+
+	part, _ := body.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	writeBase64(part, message.Body)
+	if message.Calendar == "" {
+		body.Close()
+		return append(header.Bytes(), b.Bytes()...)
+	}
+	part, _ = body.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {mime.FormatMediaType("text/calendar", map[string]string{"charset": "utf-8", "method": message.Method})},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	writeBase64(part, message.Calendar)
+	part, _ = body.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {mime.FormatMediaType("application/ics", map[string]string{"name": "invite.ics"})},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": "invite.ics"})},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	writeBase64(part, message.Calendar)
+	body.Close()
+	return append(header.Bytes(), b.Bytes()...)
+}
+
+func writeBase64(w io.Writer, content string) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(content))
+	for len(encoded) > 76 {
+		w.Write([]byte(encoded[:76] + "\r\n"))
+		encoded = encoded[76:]
+	}
+	w.Write([]byte(encoded + "\r\n"))
+}
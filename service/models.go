@@ -21,28 +21,157 @@ var (
 	Yearly        ReoccureanceChoice = 5
 )
 
+type ReminderChannel string
+
+var (
+	ChannelLog     ReminderChannel = "log"
+	ChannelEmail   ReminderChannel = "email"
+	ChannelWebhook ReminderChannel = "webhook"
+)
+
+// Visibility is who sees the details of a meeting, others only see when it
+// is busy.
+type Visibility string
+
+var (
+	VisibilityPublic       Visibility = "public"       // every user
+	VisibilityPrivate      Visibility = "private"      // participants and users they share details with
+	VisibilityConfidential Visibility = "confidential" // participants only
+)
+
+// ShareLevel is what a user shares of their calendar, every level includes
+// the ones before it.
+type ShareLevel string
+
+var (
+	ShareFreeBusy ShareLevel = "freeBusy" // when the user is busy
+	ShareDetails  ShareLevel = "details"  // the details of private meetings
+	ShareEdit     ShareLevel = "edit"     // create, edit and delete meetings the user owns
+	ShareRespond  ShareLevel = "respond"  // answer invitations for the user
+)
+
+var shareLevels = []ShareLevel{ShareFreeBusy, ShareDetails, ShareEdit, ShareRespond}
+
+// Share grants access to the calendar of a user.
+type Share struct {
+	Grantee string     `json:"grantee" bson:"grantee"`
+	Level   ShareLevel `json:"level" bson:"level"`
+}
+
+type Reminder struct {
+	OffsetMinutes int             `json:"offsetMinutes" bson:"offsetMinutes"` // before the start of every occurrence
+	Channel       ReminderChannel `json:"channel" bson:"channel"`
+}
+
 type Invitation struct {
 	Invitee  string         `json:"invitee" bson:"invitee"` // todo: index
 	Accepted AcceptedChoice `json:"accepted" bson:"accepted"`
+	// RespondedBy is who answered for the invitee, empty when they did.
+	RespondedBy string `json:"respondedBy,omitempty" bson:"respondedBy,omitempty"`
+	CalendarId  string `json:"calendarId,omitempty" bson:"calendarId,omitempty"` // of the invitee, the default calendar when empty
+	Group       string `json:"group,omitempty" bson:"group,omitempty"`           // of Groups the invitee is a member of, empty when invited by login
+}
+
+// Group is a team of users, and of the members of nested groups. It is
+// invited as "team:" followed by its name.
+type Group struct {
+	Id      string   `json:"id,omitempty" bson:"_id,omitempty"`
+	Name    string   `json:"name" bson:"name"`
+	Owner   string   `json:"owner" bson:"owner"`
+	Members []string `json:"members" bson:"members"`
+	Groups  []string `json:"groups,omitempty" bson:"groups,omitempty"` // names of nested groups
+}
+
+// Calendar groups meetings of a user. Every user also has a default
+// calendar, with the id "default", which is not stored.
+type Calendar struct {
+	Id         string     `json:"id,omitempty" bson:"_id,omitempty"`
+	Owner      string     `json:"owner" bson:"owner"`
+	Name       string     `json:"name" bson:"name"`
+	Color      string     `json:"color,omitempty" bson:"color,omitempty"`           // #rrggbb
+	Visibility Visibility `json:"visibility,omitempty" bson:"visibility,omitempty"` // of its meetings without one
+}
+
+// Attachment references a file of a meeting, which is stored elsewhere, or
+// with the meeting, see UploadAttachment.
+type Attachment struct {
+	Id       string `json:"id,omitempty" bson:"id,omitempty"` // of the file, when it is stored with the meeting
+	Name     string `json:"name" bson:"name"`
+	Url      string `json:"url" bson:"url"`
+	MimeType string `json:"mimeType,omitempty" bson:"mimeType,omitempty"`
+	Size     int64  `json:"size,omitempty" bson:"size,omitempty"` // in bytes, of stored files
+}
+
+// DialIn joins the call of a meeting by phone.
+type DialIn struct {
+	Number string `json:"number" bson:"number"`
+	Pin    string `json:"pin,omitempty" bson:"pin,omitempty"`
 }
 
 type Meeting struct {
-	Id          string             `json:"id,omitempty" bson:"_id,omitempty"`
-	Owner       string             `json:"owner" bson:"owner"`
-	Invited     []Invitation       `json:"invited" bson:"invited"`
-	StartTime   time.Time          `json:"startTime" bson:"startTime"`
-	EndTime     time.Time          `json:"endTime" bson:"endTime"`
-	Reoccurance ReoccureanceChoice `json:"reoccurance" bson:"reoccurance"`
-	Description string             `json:"description" bson:"description"`
+	Id            string             `json:"id,omitempty" bson:"_id,omitempty"`
+	Uid           string             `json:"uid,omitempty" bson:"uid,omitempty"` // iCalendar UID of imported meetings
+	ResourceName  string             `json:"-" bson:"resourceName,omitempty"`    // CalDAV resource name, when it differs from the UID
+	Owner         string             `json:"owner,omitempty" bson:"owner"`
+	Invited       []Invitation       `json:"invited" bson:"invited"`
+	Groups        []string           `json:"groups,omitempty" bson:"groups,omitempty"` // whose members are invited while they are members
+	StartTime     time.Time          `json:"startTime" bson:"startTime"`
+	EndTime       time.Time          `json:"endTime" bson:"endTime"`
+	Reoccurance   ReoccureanceChoice `json:"reoccurance" bson:"reoccurance"`
+	ReoccurUntil  *time.Time         `json:"reoccurUntil,omitempty" bson:"reoccurUntil,omitempty"`
+	ExDates       []time.Time        `json:"exDates,omitempty" bson:"exDates,omitempty"`   // starts of cancelled occurrences
+	TimeZone      string             `json:"timeZone,omitempty" bson:"timeZone,omitempty"` // IANA name, occurrences keep their local time in it, UTC when empty
+	Title         string             `json:"title,omitempty" bson:"title,omitempty"`
+	Description   string             `json:"description" bson:"description"`
+	Location      string             `json:"location,omitempty" bson:"location,omitempty"`
+	ConferenceUrl string             `json:"conferenceUrl,omitempty" bson:"conferenceUrl,omitempty"` // joins the video call of the meeting
+	DialIns       []DialIn           `json:"dialIns,omitempty" bson:"dialIns,omitempty"`             // of calls of the conference provider
+	Attachments   []Attachment       `json:"attachments,omitempty" bson:"attachments,omitempty"`
+	Visibility    Visibility         `json:"visibility,omitempty" bson:"visibility,omitempty"` // the one of the calendar when empty
+	CalendarId    string             `json:"calendarId,omitempty" bson:"calendarId,omitempty"` // of the owner, the default calendar when empty
+	Sequence      int                `json:"sequence" bson:"sequence"`                         // iTIP revision, incremented by every change of the organizer
+	// Version orders all changes of meetings, see SyncMeetings.
+	Version        int64     `json:"version" bson:"version"`
+	CreatedVersion int64     `json:"-" bson:"createdVersion"`
+	UpdatedAt      time.Time `json:"updatedAt" bson:"updatedAt"`
+	UpdatedBy      string    `json:"updatedBy,omitempty" bson:"updatedBy,omitempty"` // who made the last change, the owner or an editor
+	Deleted        bool      `json:"deleted,omitempty" bson:"deleted,omitempty"`     // tombstones are kept for sync
+	Left           []string  `json:"-" bson:"left,omitempty"`                        // former participants, they sync a tombstone
+	Removed        []string  `json:"-" bson:"removed,omitempty"`                     // participants the last change dropped
+	// CalendarVisibility is the visibility of the calendar of the meeting.
+	CalendarVisibility Visibility `json:"-" bson:"calendarVisibility,omitempty"`
+	// Reminders apply to every participant, instead of their own defaults.
+	Reminders []Reminder `json:"reminders,omitempty" bson:"reminders,omitempty"`
+	// Busy is set when only the time of the meeting is shown, to users who
+	// don't take part in it.
+	Busy bool `json:"busy,omitempty" bson:"-"`
+
+	seriesId string // of the meeting an occurrence was generated from
 }
 
 type User struct {
-	Id    string `json:"id,omitempty" bson:"_id,omitempty"`
-	Login string `json:"login" bson:"login"` // todo: unique index
+	Id          string `json:"id,omitempty" bson:"_id,omitempty"`
+	Login       string `json:"login" bson:"login"`
+	DisplayName string `json:"displayName,omitempty" bson:"displayName,omitempty"`
+	Email       string `json:"email,omitempty" bson:"email,omitempty"`       // invitations are mailed here, defaults to the calendar address
+	TimeZone    string `json:"timeZone,omitempty" bson:"timeZone,omitempty"` // IANA name, mails show times in it, UTC when empty
+	// Reminders are the defaults for meetings without reminders of their own.
+	Reminders []Reminder `json:"reminders,omitempty" bson:"reminders,omitempty"`
+	Shares    []Share    `json:"shares,omitempty" bson:"shares,omitempty"`
+	Token     string     `json:"token,omitempty" bson:"-"` // first api token, only returned on creation
+}
+
+// UserUpdate changes the profile of a user, fields which are nil are kept
+// and empty ones cleared.
+type UserUpdate struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	TimeZone    *string `json:"timeZone,omitempty"`
 }
 
 type AcceptMeetingRequest struct {
-	MeetingId string `json:"meetingId"`
-	Login     string `json:"login"`
-	Decline   bool   `json:"decline"`
+	MeetingId  string `json:"meetingId"`
+	Login      string `json:"login,omitempty"`
+	Decline    bool   `json:"decline"`
+	CalendarId string `json:"calendarId,omitempty"` // calendar of the invitee to put the meeting in
 }
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	openapi "github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/gorilla/mux"
+)
+
+//go:generate go run github.com/go-swagger/go-swagger/cmd/swagger generate client -f openapi.json -t ../openapi -A calendar
+
+//go:embed openapi.json
+var openapiDocument []byte
+
+var spec = loadSpec(openapiDocument)
+
+// openapiSpec is openapi.json, with the binders validating the requests of
+// each operation.
+type openapiSpec struct {
+	document   *loads.Document
+	operations map[string]map[string]*openapiOperation // by path and method
+}
+
+type openapiOperation struct {
+	*openapi.Operation
+	params map[string]openapi.Parameter
+	// jsonBody is set when the body is validated, bodies of other types are
+	// read by the handlers
+	jsonBody bool
+}
+
+// public reports whether the operation takes requests without a token, its
+// security is only set, and then empty, on public operations.
+func (o *openapiOperation) public() bool {
+	return o.Security != nil && len(o.Security) == 0
+}
+
+// queryToken reports whether the operation takes the token as the
+// access_token query parameter, for clients that can't set headers, like
+// EventSource in browsers.
+func (o *openapiOperation) queryToken() bool {
+	for _, param := range o.params {
+		if param.In == "query" && param.Name == "access_token" {
+			return true
+		}
+	}
+	return false
+}
+
+func loadSpec(raw []byte) *openapiSpec {
+	document, err := loads.Analyzed(json.RawMessage(raw), "")
+	if err != nil {
+		log.Fatalf("invalid openapi.json: %v", err)
+	}
+	if err := validate.Spec(document, strfmt.Default); err != nil {
+		log.Fatalf("invalid openapi.json: %v", err)
+	}
+	res := &openapiSpec{document: document, operations: map[string]map[string]*openapiOperation{}}
+	for method, paths := range document.Analyzer.Operations() {
+		for path, operation := range paths {
+			params := document.Analyzer.ParamsFor(method, path)
+			jsonBody := false
+			for name, param := range params {
+				if param.In != "body" {
+					continue
+				}
+				if jsonBody = consumesJson(document.Analyzer.ConsumesFor(operation)); !jsonBody {
+					delete(params, name)
+				}
+			}
+			if res.operations[path] == nil {
+				res.operations[path] = map[string]*openapiOperation{}
+			}
+			res.operations[path][strings.ToLower(method)] = &openapiOperation{
+				Operation: operation,
+				params:    params,
+				jsonBody:  jsonBody,
+			}
+		}
+	}
+	return res
+}
+
+func consumesJson(mediaTypes []string) bool {
+	for _, mediaType := range mediaTypes {
+		if mediaType == "application/json" {
+			return true
+		}
+	}
+	return len(mediaTypes) == 0
+}
+
+func (s *Service) GetSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(openapiDocument)
+}
+
+// validateRequest checks parameters and bodies of matched routes against
+// openapi.json before they reach the handlers. Bodies may only have the
+// properties of their schema.
+func validateRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation := spec.operation(r)
+		if operation == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		var body []byte
+		if operation.jsonBody {
+			var err error
+			if body, err = io.ReadAll(r.Body); err != nil {
+				writeError(w, r, badRequest("failed to read request body: %v", err))
+				return
+			}
+			if len(body) != 0 && !json.Valid(body) {
+				writeError(w, r, badRequest("malformed request body: %v", json.Unmarshal(body, new(any))))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		routeParams := middleware.RouteParams{}
+		for name, value := range mux.Vars(r) {
+			routeParams = append(routeParams, middleware.RouteParam{Name: name, Value: value})
+		}
+		err := operation.binder(r).Bind(r, routeParams, runtime.JSONConsumer(), &map[string]any{})
+		if operation.jsonBody {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		if err != nil {
+			details := specDetails(err, nil)
+			sort.SliceStable(details, func(i, j int) bool { return details[i].Field < details[j].Field })
+			writeError(w, r, validationFailed("request does not match the api specification", details...))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// binder binds and validates the parameters of the request. Optional query
+// parameters are only validated when they are given, the validators would
+// take missing ones for empty strings.
+func (o *openapiOperation) binder(r *http.Request) *middleware.UntypedRequestBinder {
+	params := map[string]openapi.Parameter{}
+	for key, param := range o.params {
+		if param.In != "query" || param.Required || r.URL.Query().Has(param.Name) {
+			params[key] = param
+		}
+	}
+	return middleware.NewUntypedRequestBinder(params, spec.document.Spec(), strfmt.Default)
+}
+
+func (s *openapiSpec) operation(r *http.Request) *openapiOperation {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return nil
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return nil
+	}
+	return s.operations[template][strings.ToLower(r.Method)]
+}
+
+var itemIndex = regexp.MustCompile(`\.([0-9]+)(\.|$)`)
+
+// specDetails flattens the errors of the validator into details, with the
+// fields of bodies named like the ones of the handlers, e.g. invited[0].role.
+func specDetails(err error, details []ErrorDetail) []ErrorDetail {
+	switch err := err.(type) {
+	case *errors.CompositeError:
+		for _, nested := range err.Errors {
+			details = specDetails(nested, details)
+		}
+	case *errors.Validation:
+		field := err.Name
+		if key, ok := err.Value.(string); ok && err.Code() == errors.UnallowedPropertyCode {
+			field += "." + key
+		}
+		message := strings.TrimPrefix(err.Error(), field+" in "+err.In+" ")
+		if err.In == "body" {
+			// the name of the body parameter comes first
+			_, field, _ = strings.Cut(field, ".")
+		}
+		for itemIndex.MatchString(field) {
+			field = itemIndex.ReplaceAllString(field, "[$1]$2")
+		}
+		details = append(details, ErrorDetail{field, message})
+	default:
+		details = append(details, ErrorDetail{Message: err.Error()})
+	}
+	return details
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRequest(t *testing.T) {
+	r := mux.NewRouter()
+	r.Use(validateRequest)
+	r.HandleFunc("/api/meetings", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("POST")
+	r.HandleFunc("/api/users/{login}/meetings", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("GET")
+	serve := func(method, target, body string) (int, []ErrorDetail) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, target, strings.NewReader(body)))
+		apiErr := ApiError{}
+		if w.Code != http.StatusNoContent {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+		}
+		return w.Code, apiErr.Details
+	}
+
+	status, details := serve("POST", "/api/meetings", `{"startTime": "2023-03-07T16:20:00Z", "endTime": "2023-03-07T16:40:00Z",
+		"invited": [{"invitee": "alice", "role": "chair"}], "room": "4.01", "reoccurance": 9}`)
+	require.Equal(t, http.StatusUnprocessableEntity, status)
+	require.Equal(t, []ErrorDetail{
+		{"invited.role", "is a forbidden property"},
+		{"reoccurance", "should be one of [0 1 2 3 4 5]"},
+		{"room", "is a forbidden property"},
+	}, details)
+	status, _ = serve("POST", "/api/meetings", `{"startTime": "2023-03-07T16:20:00Z", "endTime": "2023-03-07T16:40:00Z", "description": "1:1"}`)
+	require.Equal(t, http.StatusNoContent, status)
+	status, _ = serve("POST", "/api/meetings", `{"startTime": `)
+	require.Equal(t, http.StatusBadRequest, status)
+
+	status, details = serve("GET", "/api/users/bob/meetings?startTime=tomorrow&endTime=2023-03-08T00:00:00Z&limit=0", "")
+	require.Equal(t, http.StatusUnprocessableEntity, status)
+	require.Len(t, details, 2)
+	require.Equal(t, "limit", details[0].Field)
+	require.Equal(t, "startTime", details[1].Field)
+}
+
+// TestBodiesMatchSpec makes sure that requests of the client don't send
+// fields the spec doesn't know.
+func TestBodiesMatchSpec(t *testing.T) {
+	for name, body := range map[string]any{
+		"AcceptMeetingRequest": AcceptMeetingRequest{},
+		"ApiToken":             ApiToken{},
+		"Attachment":           Attachment{},
+		"Calendar":             Calendar{},
+		"DialIn":               DialIn{},
+		"Group":                Group{},
+		"Invitation":           Invitation{},
+		"Meeting":              Meeting{},
+		"Reminder":             Reminder{},
+		"Share":                Share{},
+		"User":                 User{},
+		"UserUpdate":           UserUpdate{},
+		"Webhook":              Webhook{},
+	} {
+		schema, ok := spec.document.Spec().Definitions[name]
+		require.True(t, ok, name)
+		fields := reflect.TypeOf(body)
+		for i := 0; i < fields.NumField(); i++ {
+			tag := strings.Split(fields.Field(i).Tag.Get("json"), ",")[0]
+			if tag == "" || tag == "-" {
+				continue
+			}
+			require.Contains(t, schema.Properties, tag, name)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// What a user may do with a meeting depends on their roles in it: the owner
+// and the editors of the owner (shared edit) manage it, participants and
+// the users a participant shares details with see it, everyone else only sees
+// when it is busy, unless the meeting is public. Shares don't apply to
+// confidential meetings. The admin may do anything.
+
+type role uint8
+
+const (
+	roleUser   role = 1 << iota // any authenticated user
+	roleViewer                  // shared details by a participant
+	roleInvitee
+	roleEditor // shared edit by the owner
+	roleOwner
+	roleAdmin
+)
+
+type meetingAction string
+
+var (
+	actionView   meetingAction = "view"
+	actionEdit   meetingAction = "edit"
+	actionDelete meetingAction = "delete"
+)
+
+// meetingPolicy lists the roles allowed to take each action.
+var meetingPolicy = map[meetingAction]role{
+	actionView:   roleViewer | roleInvitee | roleEditor | roleOwner | roleAdmin,
+	actionEdit:   roleEditor | roleOwner | roleAdmin,
+	actionDelete: roleEditor | roleOwner | roleAdmin,
+}
+
+// policy decides what the principal of a request may do.
+type policy struct {
+	principal *principal
+	shared    map[string]ShareLevel // by the users sharing their calendar with the principal
+}
+
+func (s *Service) policy(r *http.Request) (*policy, error) {
+	p := requestPrincipal(r)
+	if p == nil {
+		return nil, unauthorized("authentication required")
+	}
+	return s.principalPolicy(p)
+}
+
+// principalPolicy loads the shares the policy of the principal depends on.
+func (s *Service) principalPolicy(p *principal) (*policy, error) {
+	pol := &policy{principal: p, shared: map[string]ShareLevel{}}
+	if p.admin {
+		return pol, nil
+	}
+	opts := options.Find().SetProjection(bson.D{{"login", 1}, {"shares", 1}})
+	cursor, err := s.DbClient.Database("db").Collection("users").Find(context.TODO(), bson.D{{"shares.grantee", p.login}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	users := []User{}
+	if err = cursor.All(context.TODO(), &users); err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		for _, share := range user.Shares {
+			if share.Grantee == p.login {
+				pol.shared[user.Login] = share.Level
+			}
+		}
+	}
+	return pol, nil
+}
+
+// includes reports whether the level grants at least other.
+func (l ShareLevel) includes(other ShareLevel) bool {
+	return shareRank(l) >= shareRank(other) && shareRank(other) > 0
+}
+
+func shareRank(level ShareLevel) int {
+	for i, known := range shareLevels {
+		if known == level {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// actsFor reports whether the principal is login, or shares level of the
+// calendar of login.
+func (pol *policy) actsFor(login string, level ShareLevel) bool {
+	return pol.principal.admin || pol.principal.login == login || pol.shared[login].includes(level)
+}
+
+func (pol *policy) roles(meeting *Meeting) role {
+	roles := roleUser
+	if pol.principal.admin {
+		roles |= roleAdmin
+	}
+	if pol.principal.login == meeting.Owner {
+		roles |= roleOwner
+	}
+	if meeting.isInvited(pol.principal.login) {
+		roles |= roleInvitee
+	}
+	if meeting.visibility() == VisibilityConfidential {
+		return roles
+	}
+	if pol.shared[meeting.Owner].includes(ShareEdit) {
+		roles |= roleEditor
+	}
+	for _, login := range meeting.participants() {
+		if pol.shared[login].includes(ShareDetails) {
+			roles |= roleViewer
+		}
+	}
+	return roles
+}
+
+func (pol *policy) can(action meetingAction, meeting *Meeting) bool {
+	allowed := meetingPolicy[action]
+	if action == actionView && meeting.visibility() == VisibilityPublic {
+		allowed |= roleUser
+	}
+	return pol.roles(meeting)&allowed != 0
+}
+
+func (pol *policy) authorize(action meetingAction, meeting *Meeting) error {
+	if !pol.can(action, meeting) {
+		return forbidden("may not %s meeting %q", action, meeting.Id)
+	}
+	return nil
+}
+
+// authorizeCalendar checks that the principal may see the calendar of login,
+// at least when they are busy.
+func (pol *policy) authorizeCalendar(login string) error {
+	if !pol.actsFor(login, ShareFreeBusy) {
+		return forbidden("%q doesn't share their calendar", login)
+	}
+	return nil
+}
+
+// owner is who meetings declared to be organized by declared are organized
+// by: declared, when the principal may edit their meetings, or the principal.
+func (pol *policy) owner(declared string) string {
+	if declared != "" && pol.actsFor(declared, ShareEdit) {
+		return declared
+	}
+	return pol.principal.login
+}
+
+// respondent is who answers an invitation to the meeting: declared, when the
+// principal may respond for them, or the principal.
+func (pol *policy) respondent(meeting *Meeting, declared string) (string, error) {
+	login := pol.principal.login
+	if declared != "" && declared != login {
+		if !pol.actsFor(declared, ShareRespond) || (meeting.visibility() == VisibilityConfidential && !pol.principal.admin) {
+			return "", forbidden("may not respond for %q", declared)
+		}
+		login = declared
+	}
+	if login == "" {
+		return "", validationFailed("missing login", ErrorDetail{"login", "is required"})
+	}
+	if !meeting.isInvited(login) {
+		return "", forbidden("%q is not invited to meeting %q", login, meeting.Id)
+	}
+	return login, nil
+}
+
+// view returns the meeting as the principal may see it: the meeting, or a
+// placeholder with its times.
+func (pol *policy) view(meeting *Meeting) Meeting {
+	if pol.can(actionView, meeting) {
+		return *meeting
+	}
+	return Meeting{
+		Id:           meeting.Id,
+		Invited:      []Invitation{},
+		StartTime:    meeting.StartTime,
+		EndTime:      meeting.EndTime,
+		Reoccurance:  meeting.Reoccurance,
+		ReoccurUntil: meeting.ReoccurUntil,
+		ExDates:      meeting.ExDates,
+		TimeZone:     meeting.TimeZone,
+		Visibility:   meeting.visibility(),
+		Busy:         true,
+	}
+}
+
+// SetShares replaces the users the user shares their calendar with.
+func (s *Service) SetShares(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	shares := []Share{}
+	if err := json.NewDecoder(r.Body).Decode(&shares); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	grantees := []string{}
+	seen := map[string]bool{}
+	for i, share := range shares {
+		prefix := fmt.Sprintf("[%d]", i)
+		switch {
+		case share.Grantee == login:
+			writeError(w, r, validationFailed("invalid shares", ErrorDetail{prefix + ".grantee", "users can't share with themselves"}))
+			return
+		case seen[share.Grantee]:
+			writeError(w, r, validationFailed("invalid shares", ErrorDetail{prefix + ".grantee", fmt.Sprintf("%q is listed twice", share.Grantee)}))
+			return
+		case shareRank(share.Level) == 0:
+			writeError(w, r, validationFailed("invalid shares", ErrorDetail{prefix + ".level", fmt.Sprintf("unknown level %q", share.Level)}))
+			return
+		}
+		seen[share.Grantee] = true
+		grantees = append(grantees, share.Grantee)
+	}
+	if err := s.checkUsersExist(grantees); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var user User
+	update := bson.D{{"$set", bson.D{{"shares", shares}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := s.DbClient.Database("db").Collection("users").FindOneAndUpdate(context.TODO(), bson.D{{"login", login}}, update, opts).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		writeError(w, r, notFound("user %q not found", login))
+		return
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, user)
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeetingPolicy(t *testing.T) {
+	meeting := &Meeting{
+		Id:          "640a4862377457548608f50a",
+		Owner:       "bob",
+		Invited:     []Invitation{{Invitee: "alice"}},
+		StartTime:   time.Date(2023, 3, 7, 16, 20, 0, 0, time.UTC),
+		EndTime:     time.Date(2023, 3, 7, 16, 40, 0, 0, time.UTC),
+		Description: "1:1",
+	}
+	policies := map[string]*policy{
+		"owner":    {principal: &principal{login: "bob"}},
+		"editor":   {principal: &principal{login: "carol"}, shared: map[string]ShareLevel{"bob": ShareEdit}},
+		"viewer":   {principal: &principal{login: "erin"}, shared: map[string]ShareLevel{"alice": ShareDetails}},
+		"invitee":  {principal: &principal{login: "alice"}},
+		"freeBusy": {principal: &principal{login: "frank"}, shared: map[string]ShareLevel{"bob": ShareFreeBusy}},
+		"other":    {principal: &principal{login: "dave"}, shared: map[string]ShareLevel{"carol": ShareRespond}},
+		"admin":    {principal: &principal{admin: true}},
+	}
+	allowed := map[string][]meetingAction{
+		"owner":    {actionView, actionEdit, actionDelete},
+		"editor":   {actionView, actionEdit, actionDelete},
+		"viewer":   {actionView},
+		"invitee":  {actionView},
+		"freeBusy": {},
+		"other":    {},
+		"admin":    {actionView, actionEdit, actionDelete},
+	}
+	for name, pol := range policies {
+		for _, action := range []meetingAction{actionView, actionEdit, actionDelete} {
+			require.Equal(t, contains(allowed[name], action), pol.can(action, meeting), "%s may %s", name, action)
+		}
+	}
+
+	require.Equal(t, *meeting, policies["invitee"].view(meeting))
+	busy := policies["other"].view(meeting)
+	require.True(t, busy.Busy)
+	require.Equal(t, meeting.StartTime, busy.StartTime)
+	require.Equal(t, meeting.EndTime, busy.EndTime)
+	require.Empty(t, busy.Owner)
+	require.Empty(t, busy.Invited)
+	require.Empty(t, busy.Description)
+
+	require.Equal(t, CodeForbidden, toApiError(policies["invitee"].authorize(actionEdit, meeting)).Code)
+	require.NoError(t, policies["editor"].authorize(actionEdit, meeting))
+}
+
+func TestMeetingVisibility(t *testing.T) {
+	meeting := &Meeting{Owner: "bob", Invited: []Invitation{{Invitee: "alice"}}, Description: "dentist"}
+	assistant := &policy{principal: &principal{login: "carol"}, shared: map[string]ShareLevel{"bob": ShareRespond}}
+	invitee := &policy{principal: &principal{login: "alice"}}
+	other := &policy{principal: &principal{login: "dave"}}
+
+	meeting.Visibility = VisibilityPublic
+	require.True(t, other.can(actionView, meeting))
+	require.False(t, other.can(actionEdit, meeting))
+	require.Equal(t, "dentist", other.view(meeting).Description)
+
+	meeting.Visibility = VisibilityConfidential
+	require.True(t, invitee.can(actionView, meeting))
+	for _, action := range []meetingAction{actionView, actionEdit, actionDelete} {
+		require.False(t, assistant.can(action, meeting), "assistant may %s", action)
+	}
+	placeholder := assistant.view(meeting)
+	require.True(t, placeholder.Busy)
+	require.Equal(t, VisibilityConfidential, placeholder.Visibility)
+	require.Equal(t, "Busy", summary(&placeholder))
+}
+
+func TestShares(t *testing.T) {
+	assistant := &policy{principal: &principal{login: "carol"}, shared: map[string]ShareLevel{"bob": ShareRespond, "alice": ShareDetails}}
+	require.Equal(t, "bob", assistant.owner("bob"))
+	require.Equal(t, "carol", assistant.owner("alice"))
+	require.Equal(t, "carol", assistant.owner(""))
+	require.NoError(t, assistant.authorizeCalendar("alice"))
+	require.Equal(t, CodeForbidden, toApiError(assistant.authorizeCalendar("dave")).Code)
+	admin := &policy{principal: &principal{admin: true}}
+	require.Equal(t, "alice", admin.owner("alice"))
+
+	meeting := &Meeting{Owner: "alice", Invited: []Invitation{{Invitee: "bob"}, {Invitee: "carol"}}}
+	login, err := assistant.respondent(meeting, "bob")
+	require.NoError(t, err)
+	require.Equal(t, "bob", login)
+	login, err = assistant.respondent(meeting, "")
+	require.NoError(t, err)
+	require.Equal(t, "carol", login)
+	_, err = assistant.respondent(meeting, "alice")
+	require.Equal(t, CodeForbidden, toApiError(err).Code)
+	meeting.Visibility = VisibilityConfidential
+	_, err = assistant.respondent(meeting, "bob")
+	require.Equal(t, CodeForbidden, toApiError(err).Code)
+	_, err = admin.respondent(meeting, "")
+	require.Equal(t, CodeValidationFailed, toApiError(err).Code)
+
+	require.True(t, ShareRespond.includes(ShareFreeBusy))
+	require.False(t, ShareDetails.includes(ShareEdit))
+	require.False(t, ShareLevel("").includes(ShareFreeBusy))
+	require.False(t, ShareRespond.includes(ShareLevel("owner")))
+}
+
+func contains(actions []meetingAction, action meetingAction) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Reminders are fired by every replica walking the upcoming occurrences. A
+// reminder is claimed by inserting its key into the reminders collection
+// before it is sent, so that it is sent once however many replicas run.
+// A replica dying between claim and send leaves the claim to expire after
+// reminderLease, another replica sends it then.
+
+const (
+	reminderPollInterval = 30 * time.Second
+	// reminders missed while no replica was running are still sent this late
+	reminderGrace     = 5 * time.Minute
+	reminderLease     = time.Minute
+	maxReminderOffset = 24 * 60
+	maxReminders      = 5
+)
+
+var reminderChannels = []ReminderChannel{ChannelLog, ChannelEmail, ChannelWebhook}
+
+// Notification is a reminder due for one participant of an occurrence.
+type Notification struct {
+	Login    string
+	Meeting  Meeting // the occurrence, with the id of its meeting
+	Reminder Reminder
+}
+
+// Notifier delivers reminders of one channel.
+type Notifier interface {
+	Notify(ctx context.Context, notification *Notification) error
+}
+
+type NotifierFunc func(ctx context.Context, notification *Notification) error
+
+func (f NotifierFunc) Notify(ctx context.Context, notification *Notification) error {
+	return f(ctx, notification)
+}
+
+type ReminderStatus string
+
+var (
+	ReminderPending ReminderStatus = "pending"
+	ReminderSent    ReminderStatus = "sent"
+	ReminderFailed  ReminderStatus = "failed"
+)
+
+// firedReminder is the claim of a reminder, its id is the key of the
+// reminder.
+type firedReminder struct {
+	Id         string         `bson:"_id"`
+	MeetingId  string         `bson:"meetingId"`
+	StartTime  time.Time      `bson:"startTime"`
+	Login      string         `bson:"login"`
+	Reminder   Reminder       `bson:"reminder"`
+	Status     ReminderStatus `bson:"status"`
+	LeaseUntil time.Time      `bson:"leaseUntil"`
+	LastError  string         `bson:"lastError,omitempty"`
+}
+
+func (s *Service) SetReminders(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	reminders := []Reminder{}
+	if err := json.NewDecoder(r.Body).Decode(&reminders); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	if err := s.validateReminders(reminders, ""); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var user User
+	update := bson.D{{"$set", bson.D{{"reminders", reminders}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := s.DbClient.Database("db").Collection("users").FindOneAndUpdate(context.TODO(), bson.D{{"login", login}}, update, opts).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		writeError(w, r, notFound("user %q not found", login))
+		return
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, user)
+}
+
+func (s *Service) validateReminders(reminders []Reminder, field string) error {
+	if len(reminders) > maxReminders {
+		return validationFailed("invalid reminders", ErrorDetail{field, fmt.Sprintf("at most %d reminders", maxReminders)})
+	}
+	details := []ErrorDetail{}
+	for i, reminder := range reminders {
+		prefix := fmt.Sprintf("%s[%d]", field, i)
+		if reminder.OffsetMinutes < 0 || reminder.OffsetMinutes > maxReminderOffset {
+			details = append(details, ErrorDetail{prefix + ".offsetMinutes", fmt.Sprintf("must be between 0 and %d", maxReminderOffset)})
+		}
+		if s.notifier(reminder.Channel) == nil {
+			details = append(details, ErrorDetail{prefix + ".channel", fmt.Sprintf("unknown channel %q", reminder.Channel)})
+		}
+	}
+	if len(details) != 0 {
+		return validationFailed("invalid reminders", details...)
+	}
+	return nil
+}
+
+// notifier returns the notifier of the channel, Notifiers take precedence
+// over the built-in channels.
+func (s *Service) notifier(channel ReminderChannel) Notifier {
+	if notifier, ok := s.Notifiers[channel]; ok {
+		return notifier
+	}
+	switch channel {
+	case ChannelLog:
+		return NotifierFunc(logReminder)
+	case ChannelEmail:
+		return NotifierFunc(s.mailReminder)
+	case ChannelWebhook:
+		return NotifierFunc(s.webhookReminder)
+	}
+	return nil
+}
+
+// scheduleReminders fires due reminders until ctx is done.
+func (s *Service) scheduleReminders(ctx context.Context) {
+	ticker := time.NewTicker(reminderPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.fireReminders(ctx, time.Now().UTC()); err != nil {
+			log.Printf("reminders: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fireReminders sends the reminders due between now-reminderGrace and now.
+// Only the meetings starting within the longest reminder offset are looked
+// at, and the default reminders of their participants.
+func (s *Service) fireReminders(ctx context.Context, now time.Time) error {
+	database := s.DbClient.Database("db")
+	from := now.Add(-reminderGrace)
+	until := now.Add(maxReminderOffset * time.Minute)
+	schedule, err := makeSchedule(database.Collection("meetings"), bson.D{{"deleted", bson.D{{"$ne", true}}}}, &from, &until)
+	if err != nil {
+		return err
+	}
+	occurrences := []*Meeting{}
+	logins := map[string]bool{}
+	for schedule.HasNext() && ctx.Err() == nil {
+		occurrence, err := schedule.Next()
+		if err != nil {
+			return err
+		}
+		occurrences = append(occurrences, occurrence)
+		if len(occurrence.Reminders) == 0 {
+			for _, login := range occurrence.participants() {
+				logins[login] = true
+			}
+		}
+	}
+	defaults, err := s.defaultReminders(logins)
+	if err != nil {
+		return err
+	}
+	for _, occurrence := range occurrences {
+		for _, login := range occurrence.participants() {
+			if occurrence.hasDeclined(login) {
+				continue
+			}
+			reminders := occurrence.Reminders
+			if len(reminders) == 0 {
+				reminders = defaults[login]
+			}
+			for _, reminder := range reminders {
+				fireAt := occurrence.StartTime.Add(-time.Duration(reminder.OffsetMinutes) * time.Minute)
+				if fireAt.Before(from) || fireAt.After(now) || ctx.Err() != nil {
+					continue
+				}
+				s.fireReminder(ctx, occurrence, login, reminder)
+			}
+		}
+	}
+	return nil
+}
+
+// defaultReminders returns the reminders of the users, by login.
+func (s *Service) defaultReminders(logins map[string]bool) (map[string][]Reminder, error) {
+	defaults := map[string][]Reminder{}
+	if len(logins) == 0 {
+		return defaults, nil
+	}
+	in := bson.A{}
+	for login := range logins {
+		in = append(in, login)
+	}
+	filter := bson.D{{"login", bson.D{{"$in", in}}}, {"reminders.0", bson.D{{"$exists", true}}}}
+	opts := options.Find().SetProjection(bson.D{{"login", 1}, {"reminders", 1}})
+	cursor, err := s.DbClient.Database("db").Collection("users").Find(context.TODO(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	users := []User{}
+	if err = cursor.All(context.TODO(), &users); err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		defaults[user.Login] = user.Reminders
+	}
+	return defaults, nil
+}
+
+func (s *Service) fireReminder(ctx context.Context, occurrence *Meeting, login string, reminder Reminder) {
+	meeting := *occurrence
+	meeting.Id = occurrence.meetingId()
+	key := fmt.Sprintf("%s/%s/%s/%d/%s", meeting.Id, meeting.StartTime.Format(time.RFC3339), login, reminder.OffsetMinutes, reminder.Channel)
+	claimed, err := s.claimReminder(&firedReminder{
+		Id:         key,
+		MeetingId:  meeting.Id,
+		StartTime:  meeting.StartTime,
+		Login:      login,
+		Reminder:   reminder,
+		Status:     ReminderPending,
+		LeaseUntil: time.Now().Add(reminderLease),
+	})
+	if err != nil {
+		log.Printf("reminders: claim %s: %v", key, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+	status := bson.D{{"status", ReminderSent}}
+	notifier := s.notifier(reminder.Channel)
+	if notifier == nil {
+		err = fmt.Errorf("unknown channel %q", reminder.Channel)
+	} else {
+		err = notifier.Notify(ctx, &Notification{Login: login, Meeting: meeting, Reminder: reminder})
+	}
+	if err != nil {
+		log.Printf("reminders: send %s: %v", key, err)
+		status = bson.D{{"status", ReminderFailed}, {"lastError", err.Error()}}
+	}
+	if _, err := s.DbClient.Database("db").Collection("reminders").UpdateOne(context.TODO(), bson.D{{"_id", key}}, bson.D{{"$set", status}}); err != nil {
+		log.Printf("reminders: record %s: %v", key, err)
+	}
+}
+
+// claimReminder reports whether this replica is to send the reminder: it is
+// the first to claim it, or the claim of another one expired.
+func (s *Service) claimReminder(fired *firedReminder) (bool, error) {
+	coll := s.DbClient.Database("db").Collection("reminders")
+	_, err := coll.InsertOne(context.TODO(), fired)
+	if err == nil {
+		return true, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return false, err
+	}
+	filter := bson.D{{"_id", fired.Id}, {"status", ReminderPending}, {"leaseUntil", bson.D{{"$lt", time.Now()}}}}
+	update := bson.D{{"$set", bson.D{{"leaseUntil", fired.LeaseUntil}}}}
+	res, err := coll.UpdateOne(context.TODO(), filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// meetingId is the id of the meeting, or of the meeting an occurrence was
+// generated from.
+func (m *Meeting) meetingId() string {
+	if m.Id != "" {
+		return m.Id
+	}
+	return m.seriesId
+}
+
+func (m *Meeting) hasDeclined(login string) bool {
+	for _, invitation := range m.Invited {
+		if invitation.Invitee == login {
+			return invitation.Accepted == Declined
+		}
+	}
+	return false
+}
+
+func logReminder(ctx context.Context, notification *Notification) error {
+	log.Printf("reminder for %s: %q starts at %s", notification.Login, summary(&notification.Meeting), notification.Meeting.StartTime.Format(time.RFC3339))
+	return nil
+}
+
+func (s *Service) mailReminder(ctx context.Context, notification *Notification) error {
+	if s.Mail == nil {
+		return fmt.Errorf("mail is not configured")
+	}
+	to, err := s.mailAddress(notification.Login)
+	if err != nil {
+		return err
+	}
+	user, err := s.findUser(notification.Login)
+	if err != nil {
+		return err
+	}
+	meeting := &notification.Meeting
+	zone := user.location()
+	when := meeting.StartTime.In(zone).Format("Mon Jan 2, 2006 15:04") + " - " + meeting.EndTime.In(zone).Format("15:04 MST")
+	ctx, cancel := context.WithTimeout(ctx, mailTimeout)
+	defer cancel()
+	return s.Mail.Send(ctx, &MailMessage{
+		From:    s.mailFrom(),
+		To:      to,
+		Subject: "Reminder: " + summary(meeting),
+		Body:    fmt.Sprintf("%q, organized by %s, takes place %s.\n", summary(meeting), meeting.Owner, when),
+	})
+}
+
+// webhookReminder queues a meeting.reminder event for the webhooks of the
+// participant.
+func (s *Service) webhookReminder(ctx context.Context, notification *Notification) error {
+	return s.enqueueDeliveries(&Event{
+		Id:      primitive.NewObjectID().Hex(),
+		Type:    MeetingReminder,
+		Time:    time.Now().UTC(),
+		Meeting: notification.Meeting,
+		Login:   notification.Login,
+	})
+}
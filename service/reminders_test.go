@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateReminders(t *testing.T) {
+	s := &Service{}
+	require.NoError(t, s.validateReminders([]Reminder{{OffsetMinutes: 10, Channel: ChannelEmail}, {Channel: ChannelLog}}, "reminders"))
+
+	err := s.validateReminders([]Reminder{{OffsetMinutes: -1, Channel: ChannelLog}, {OffsetMinutes: 10, Channel: "sms"}}, "reminders")
+	require.Error(t, err)
+	apiErr := toApiError(err)
+	require.Equal(t, http.StatusUnprocessableEntity, apiErr.Status)
+	require.Equal(t, []ErrorDetail{
+		{"reminders[0].offsetMinutes", "must be between 0 and 1440"},
+		{"reminders[1].channel", `unknown channel "sms"`},
+	}, apiErr.Details)
+
+	// channels can be added
+	sms := []*Notification{}
+	s.Notifiers = map[ReminderChannel]Notifier{"sms": NotifierFunc(func(ctx context.Context, notification *Notification) error {
+		sms = append(sms, notification)
+		return nil
+	})}
+	require.NoError(t, s.validateReminders([]Reminder{{OffsetMinutes: 10, Channel: "sms"}}, "reminders"))
+	require.NoError(t, s.notifier("sms").Notify(context.Background(), &Notification{Login: "alice"}))
+	require.Equal(t, 1, len(sms))
+}
+
+func TestOccurrencesKeepMeetingId(t *testing.T) {
+	meeting := &Meeting{
+		Id:          "640a4862377457548608f50a",
+		StartTime:   time.Date(2023, 3, 7, 16, 20, 0, 0, time.UTC),
+		EndTime:     time.Date(2023, 3, 7, 16, 40, 0, 0, time.UTC),
+		Reoccurance: Daily,
+	}
+	occurrence := meeting.NextOccurence(nil).NextOccurence(nil)
+	require.Equal(t, "", occurrence.Id)
+	require.Equal(t, meeting.Id, occurrence.meetingId())
+	require.Equal(t, meeting.Id, meeting.meetingId())
+}
+
+func TestReminderMail(t *testing.T) {
+	mail := encodeMail(&MailMessage{From: "calendar@example.com", To: "alice@example.com", Subject: "Reminder: stand-up", Body: "soon"}, time.Now())
+	require.True(t, strings.Contains(string(mail), "text/plain"))
+	require.False(t, strings.Contains(string(mail), "text/calendar"))
+}
@@ -4,6 +4,7 @@ import (
 	"container/heap"
 	"context"
 	"log"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -19,19 +20,40 @@ type Schedule struct {
 	err           error
 }
 
+// participantFilter matches the live meetings the users take part in.
+func participantFilter(logins []string) bson.D {
+	return bson.D{
+		{"$or", bson.A{
+			bson.D{{"owner", bson.D{{"$in", logins}}}},
+			bson.D{{"invited.invitee", bson.D{{"$in", logins}}}},
+		}},
+		{"deleted", bson.D{{"$ne", true}}},
+	}
+}
+
 func MakeSchedule(coll *mongo.Collection, logins []string, startTime, endTime *time.Time) (*Schedule, error) {
-	participantFilter := bson.D{{"$or", bson.A{
-		bson.D{{"owner", bson.D{{"$in", logins}}}},
-		bson.D{{"invited.invitee", bson.D{{"$in", logins}}}},
-	}}}
+	return makeSchedule(coll, participantFilter(logins), startTime, endTime)
+}
+
+// makeSchedule lists the occurrences of the meetings matching filter, e.g.
+// of all live meetings.
+func makeSchedule(coll *mongo.Collection, participantFilter bson.D, startTime, endTime *time.Time) (*Schedule, error) {
 	cursor, err := coll.Find(context.TODO(), bson.D{
 		{"$and",
 			bson.A{
 				participantFilter,
 				bson.D{{"startTime", bson.D{{"$lt", startTime}}}},
 				bson.D{{"reoccurance", bson.D{{"$ne", NoReoccurence}}}},
+				// series that ended can't have occurrences under way
+				bson.D{{"$or", bson.A{
+					bson.D{{"reoccurUntil", nil}},
+					bson.D{{"reoccurUntil", bson.D{{"$gte", startTime.Add(-24 * time.Hour)}}}},
+				}}},
 			}},
 	})
+	if err != nil {
+		return nil, err
+	}
 	reoccuringMeetings := []*Meeting{}
 	if err = cursor.All(context.TODO(), &reoccuringMeetings); err != nil {
 		return nil, err
@@ -39,12 +61,12 @@ func MakeSchedule(coll *mongo.Collection, logins []string, startTime, endTime *t
 	meetingsQueue := PriorityQueue{}
 	for _, meeting := range reoccuringMeetings {
 		reoccurance := meeting.NextOccurence(startTime)
-		if endTime == nil || reoccurance.StartTime.Before(*endTime) {
+		if reoccurance != nil && (endTime == nil || reoccurance.StartTime.Before(*endTime)) {
 			meetingsQueue = append(meetingsQueue, reoccurance)
 		}
 	}
 	heap.Init(&meetingsQueue)
-	opts := options.Find().SetSort(bson.D{{"startTime", 1}})
+	opts := options.Find().SetSort(bson.D{{"startTime", 1}, {"_id", 1}})
 	if endTime != nil {
 		cursor, err = coll.Find(context.TODO(), bson.D{
 			{"$and",
@@ -55,9 +77,11 @@ func MakeSchedule(coll *mongo.Collection, logins []string, startTime, endTime *t
 							bson.D{{"startTime", bson.D{{"$gte", startTime}}}},
 							bson.D{{"startTime", bson.D{{"$lt", endTime}}}},
 						}}},
+						// recurring meetings under way at startTime are in the queue
 						bson.D{{"$and", bson.A{
 							bson.D{{"endTime", bson.D{{"$gt", startTime}}}},
 							bson.D{{"endTime", bson.D{{"$lte", endTime}}}},
+							bson.D{{"reoccurance", NoReoccurence}},
 						}}},
 					}}},
 				}},
@@ -73,6 +97,7 @@ func MakeSchedule(coll *mongo.Collection, logins []string, startTime, endTime *t
 						}}},
 						bson.D{{"$and", bson.A{
 							bson.D{{"endTime", bson.D{{"$gt", startTime}}}},
+							bson.D{{"reoccurance", NoReoccurence}},
 						}}},
 					}}},
 				}},
@@ -89,77 +114,162 @@ func MakeSchedule(coll *mongo.Collection, logins []string, startTime, endTime *t
 }
 
 func (s *Schedule) HasNext() bool {
-	hadNext := true
-	if s.nextInCursor == nil {
-		hadNext = s.cursor.Next(context.TODO())
-		if hadNext {
+	for {
+		if s.nextInCursor == nil && s.cursor.Next(context.TODO()) {
 			s.nextInCursor = &Meeting{}
 			s.err = s.cursor.Decode(s.nextInCursor)
 		}
+		if s.err != nil {
+			return true
+		}
+		if s.nextInCursor == nil && len(s.meetingsQueue) == 0 {
+			return false
+		}
+		// occurrences cancelled with EXDATE are skipped, but still produce
+		// the following ones
+		if !s.peek().isExcluded() {
+			return true
+		}
+		s.pop()
 	}
-	return hadNext || len(s.meetingsQueue) != 0
 }
 
 func (s *Schedule) Next() (*Meeting, error) {
 	if s.err != nil {
 		return nil, s.err
 	}
-	if s.nextInCursor == nil || (len(s.meetingsQueue) != 0 && s.meetingsQueue[0].StartTime.Before(s.nextInCursor.StartTime)) {
-		nextMeeting := heap.Pop(&s.meetingsQueue).(*Meeting)
-		nextOccurance := nextMeeting.NextOccurence(nil)
-		if s.endTime == nil || nextOccurance.StartTime.Before(*s.endTime) {
-			heap.Push(&s.meetingsQueue, nextOccurance)
-		}
-		return nextMeeting, nil
+	return s.pop(), nil
+}
+
+func (s *Schedule) peek() *Meeting {
+	if s.nextInCursor == nil || (len(s.meetingsQueue) != 0 && scheduledBefore(s.meetingsQueue[0], s.nextInCursor)) {
+		return s.meetingsQueue[0]
+	}
+	return s.nextInCursor
+}
+
+func (s *Schedule) pop() *Meeting {
+	var nextMeeting *Meeting
+	if s.nextInCursor == nil || (len(s.meetingsQueue) != 0 && scheduledBefore(s.meetingsQueue[0], s.nextInCursor)) {
+		nextMeeting = heap.Pop(&s.meetingsQueue).(*Meeting)
+	} else {
+		nextMeeting = s.nextInCursor
+		s.nextInCursor = nil
+	}
+	if nextMeeting.Reoccurance != NoReoccurence {
+		s.push(nextMeeting.NextOccurence(nil))
+	}
+	return nextMeeting
+}
+
+func (s *Schedule) push(occurrence *Meeting) {
+	if occurrence == nil || (s.endTime != nil && !occurrence.StartTime.Before(*s.endTime)) {
+		return
 	}
-	nextMeeting := s.nextInCursor
-	if s.nextInCursor.Reoccurance != NoReoccurence {
-		nextOccurance := s.nextInCursor.NextOccurence(nil)
-		if s.endTime == nil || nextOccurance.StartTime.Before(*s.endTime) {
-			heap.Push(&s.meetingsQueue, nextOccurance)
+	heap.Push(&s.meetingsQueue, occurrence)
+}
+
+// scheduledBefore orders schedules by start, and meetings starting at the
+// same time by id, so that listings can be paged.
+func scheduledBefore(a, b *Meeting) bool {
+	if !a.StartTime.Equal(b.StartTime) {
+		return a.StartTime.Before(b.StartTime)
+	}
+	return a.meetingId() < b.meetingId()
+}
+
+func (m *Meeting) isExcluded() bool {
+	for _, exDate := range m.ExDates {
+		if exDate.Equal(m.StartTime) {
+			return true
 		}
 	}
-	s.nextInCursor = nil
-	return nextMeeting, nil
+	return false
 }
 
-func switchDay(orig time.Time, daySrc time.Time) time.Time {
-	return time.Date(
-		daySrc.Year(),
-		daySrc.Month(),
-		daySrc.Day(),
-		orig.Hour(),
-		orig.Minute(),
-		0,
-		0,
-		time.UTC)
+var locations sync.Map // by IANA name
+
+// location is the time zone the meeting repeats in, UTC when it has none.
+func (m *Meeting) location() *time.Location {
+	if m.TimeZone == "" {
+		return time.UTC
+	}
+	if location, ok := locations.Load(m.TimeZone); ok {
+		return location.(*time.Location)
+	}
+	location, err := time.LoadLocation(m.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	locations.Store(m.TimeZone, location)
+	return location
 }
 
-func (m *Meeting) NextOccurence(startingFrom *time.Time) *Meeting {
+// nextStart returns the start of the occurrence following the one starting
+// at start. Occurrences keep the local time of the meeting's time zone when
+// daylight saving time begins or ends. Monthly and yearly meetings skip
+// periods without such a day (e.g. the 31st or February 29th) instead of
+// drifting.
+func (m *Meeting) nextStart(start time.Time) time.Time {
+	local := start.In(m.location())
 	switch m.Reoccurance {
 	case Daily:
-		next := *m
-		next.Id = ""
-		if startingFrom == nil {
-			next.StartTime = next.StartTime.Add(24 * time.Hour)
-			next.EndTime = next.EndTime.Add(24 * time.Hour)
-		} else {
-			next.StartTime = switchDay(next.StartTime, *startingFrom)
-			newEndDay := *startingFrom
-			if next.StartTime.Day() != next.EndTime.Day() {
-				newEndDay.Add(24 * time.Hour)
+		return local.AddDate(0, 0, 1).In(start.Location())
+	case WorkingDays:
+		next := local.AddDate(0, 0, 1)
+		for next.Weekday() == time.Saturday || next.Weekday() == time.Sunday {
+			next = next.AddDate(0, 0, 1)
+		}
+		return next.In(start.Location())
+	case Weekly:
+		return local.AddDate(0, 0, 7).In(start.Location())
+	case Monthly:
+		for months := 1; ; months++ {
+			next := time.Date(local.Year(), local.Month()+time.Month(months), local.Day(), local.Hour(), local.Minute(), local.Second(), 0, local.Location())
+			if next.Day() == local.Day() {
+				return next.In(start.Location())
 			}
-			next.EndTime = switchDay(next.EndTime, newEndDay)
-			if next.EndTime.Before(*startingFrom) {
-				next.StartTime = next.StartTime.Add(24 * time.Hour)
-				next.EndTime = next.EndTime.Add(24 * time.Hour)
+		}
+	case Yearly:
+		for years := 1; ; years++ {
+			next := time.Date(local.Year()+years, local.Month(), local.Day(), local.Hour(), local.Minute(), local.Second(), 0, local.Location())
+			if next.Day() == local.Day() {
+				return next.In(start.Location())
 			}
 		}
-		return &next
 	default:
 		log.Fatalf("invalid reoccurance")
 	}
-	return nil
+	return start
+}
+
+// NextOccurence returns the occurrence following m, or, when startingFrom is
+// given, the first occurrence which has not ended by startingFrom. It returns
+// nil when the meeting does not repeat past its ReoccurUntil.
+func (m *Meeting) NextOccurence(startingFrom *time.Time) *Meeting {
+	duration := m.EndTime.Sub(m.StartTime)
+	start := m.nextStart(m.StartTime)
+	if startingFrom != nil {
+		start = m.StartTime
+		// skip whole weeks at once, the remainder is walked period by period
+		if weeks := int(startingFrom.Sub(m.EndTime) / (7 * 24 * time.Hour)); weeks > 0 && (m.Reoccurance == Daily || m.Reoccurance == WorkingDays || m.Reoccurance == Weekly) {
+			start = start.In(m.location()).AddDate(0, 0, 7*weeks).In(start.Location())
+		}
+		for start.Add(duration).Before(*startingFrom) {
+			start = m.nextStart(start)
+		}
+	}
+	if m.ReoccurUntil != nil && start.After(*m.ReoccurUntil) {
+		return nil
+	}
+	next := *m
+	if m.Id != "" {
+		next.seriesId = m.Id
+	}
+	next.Id = ""
+	next.StartTime = start
+	next.EndTime = start.Add(duration)
+	return &next
 }
 
 type PriorityQueue []*Meeting
@@ -167,7 +277,7 @@ type PriorityQueue []*Meeting
 func (pq PriorityQueue) Len() int { return len(pq) }
 
 func (pq PriorityQueue) Less(i, j int) bool {
-	return pq[i].StartTime.Before(pq[j].StartTime)
+	return scheduledBefore(pq[i], pq[j])
 }
 
 func (pq PriorityQueue) Swap(i, j int) {
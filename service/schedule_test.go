@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOccurrencesKeepLocalTime(t *testing.T) {
+	// 9:00 in Berlin, which is 8:00 UTC in winter and 7:00 UTC in summer
+	meeting := &Meeting{
+		StartTime:   time.Date(2023, 3, 24, 8, 0, 0, 0, time.UTC),
+		EndTime:     time.Date(2023, 3, 24, 8, 30, 0, 0, time.UTC),
+		Reoccurance: Daily,
+		TimeZone:    "Europe/Berlin",
+	}
+	starts := []time.Time{}
+	for occurrence := meeting; len(starts) < 4; occurrence = occurrence.NextOccurence(nil) {
+		starts = append(starts, occurrence.StartTime)
+	}
+	require.Equal(t, []time.Time{
+		time.Date(2023, 3, 24, 8, 0, 0, 0, time.UTC),
+		time.Date(2023, 3, 25, 8, 0, 0, 0, time.UTC),
+		time.Date(2023, 3, 26, 7, 0, 0, 0, time.UTC),
+		time.Date(2023, 3, 27, 7, 0, 0, 0, time.UTC),
+	}, starts)
+
+	from := time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2023, 4, 20, 7, 0, 0, 0, time.UTC), meeting.NextOccurence(&from).StartTime)
+	meeting.Reoccurance = Weekly
+	require.Equal(t, time.Date(2023, 4, 21, 7, 0, 0, 0, time.UTC), meeting.NextOccurence(&from).StartTime)
+
+	// without a time zone meetings repeat in UTC
+	meeting.TimeZone = ""
+	require.Equal(t, time.Date(2023, 4, 21, 8, 0, 0, 0, time.UTC), meeting.NextOccurence(&from).StartTime)
+}
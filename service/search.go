@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Meetings are searched by the words of their titles, descriptions and
+// locations with the text index of the meetings, words of titles weigh the
+// most. Results are ranked by relevance and only include meetings whose
+// details the caller may see, busy ones would give their details away.
+
+const (
+	defaultSearchResults = 20
+	maxSearchResults     = 100
+)
+
+// SearchMeetings returns the meetings matching the words of q, the most
+// relevant first.
+func (s *Service) SearchMeetings(w http.ResponseWriter, r *http.Request) {
+	pol, err := s.policy(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	filter, err := parseSearch(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	limit := defaultSearchResults
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 || limit > maxSearchResults {
+			writeError(w, r, badRequest("invalid limit %q", value))
+			return
+		}
+	}
+	score := bson.D{{"$meta", "textScore"}}
+	opts := options.Find().SetProjection(bson.D{{"score", score}}).SetSort(bson.D{{"score", score}, {"startTime", -1}})
+	cursor, err := s.DbClient.Database("db").Collection("meetings").Find(context.TODO(), filter, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer cursor.Close(context.TODO())
+	meetings := []Meeting{}
+	for len(meetings) < limit && cursor.Next(context.TODO()) {
+		var meeting Meeting
+		if err := cursor.Decode(&meeting); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		if pol.can(actionView, &meeting) {
+			meetings = append(meetings, meeting)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, meetings)
+}
+
+// parseSearch returns the filter of a search: the words of q, the users of
+// participants, who all take part, and the range of from and to, which
+// series of recurring meetings overlap when one of their occurrences may.
+func parseSearch(r *http.Request) (bson.D, error) {
+	query := r.URL.Query()
+	text := strings.TrimSpace(query.Get("q"))
+	if text == "" {
+		return nil, validationFailed("missing search", ErrorDetail{"q", "is required"})
+	}
+	filter := bson.D{{"$text", bson.D{{"$search", text}}}, {"deleted", bson.D{{"$ne", true}}}}
+	conditions := bson.A{}
+	for _, login := range splitIds(query.Get("participants")) {
+		conditions = append(conditions, bson.D{{"$or", bson.A{bson.D{{"owner", login}}, bson.D{{"invited.invitee", login}}}}})
+	}
+	if value := query.Get("from"); value != "" {
+		from, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return nil, badRequest("invalid from: %v", err)
+		}
+		conditions = append(conditions, bson.D{{"$or", bson.A{
+			bson.D{{"endTime", bson.D{{"$gt", from}}}},
+			bson.D{{"reoccurance", bson.D{{"$ne", NoReoccurence}}}, {"reoccurUntil", nil}},
+			bson.D{{"reoccurance", bson.D{{"$ne", NoReoccurence}}}, {"reoccurUntil", bson.D{{"$gte", from}}}},
+		}}})
+	}
+	if value := query.Get("to"); value != "" {
+		to, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return nil, badRequest("invalid to: %v", err)
+		}
+		conditions = append(conditions, bson.D{{"startTime", bson.D{{"$lt", to}}}})
+	}
+	if len(conditions) != 0 {
+		filter = append(filter, bson.E{"$and", conditions})
+	}
+	return filter, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestParseSearch(t *testing.T) {
+	filter, err := parseSearch(httptest.NewRequest("GET", "/?q=design+review", nil))
+	require.NoError(t, err)
+	require.Equal(t, bson.D{{"$text", bson.D{{"$search", "design review"}}}, {"deleted", bson.D{{"$ne", true}}}}, filter)
+
+	filter, err = parseSearch(httptest.NewRequest("GET", "/?q=review&participants=alice,+bob&from=2023-02-01T00:00:00Z&to=2023-03-01T00:00:00Z", nil))
+	require.NoError(t, err)
+	conditions := filter[2].Value.(bson.A)
+	require.Len(t, conditions, 4)
+	require.Equal(t, bson.D{{"$or", bson.A{bson.D{{"owner", "bob"}}, bson.D{{"invited.invitee", "bob"}}}}}, conditions[1])
+	require.Equal(t, bson.D{{"startTime", bson.D{{"$lt", time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)}}}}, conditions[3])
+
+	_, err = parseSearch(httptest.NewRequest("GET", "/?q=+", nil))
+	require.Equal(t, CodeValidationFailed, toApiError(err).Code)
+	_, err = parseSearch(httptest.NewRequest("GET", "/?q=review&from=february", nil))
+	require.Equal(t, CodeBadRequest, toApiError(err).Code)
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gorilla/mux"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -15,6 +16,41 @@ type Service struct {
 	DbClient *mongo.Client
 	Server   *http.Server
 	StopWg   *sync.WaitGroup
+	// MailDomain is used to build calendar addresses of users, e.g. in
+	// iCalendar exports.
+	MailDomain string
+	// Mail delivers invitations to invitees, none are sent when it is nil.
+	Mail     MailSender
+	MailFrom string
+	// Conferences creates calls of meetings without one, meetings only have
+	// the calls their owners give them when it is nil.
+	Conferences ConferenceProvider
+	// Notifiers deliver reminders, they add channels or replace the built-in
+	// log, email and webhook ones.
+	Notifiers map[ReminderChannel]Notifier
+	// AdminToken authenticates the admin, who may act as any user. Sessions
+	// are signed with SessionSecret, there are none when it is empty.
+	AdminToken    string
+	SessionSecret []byte
+	// OpenSignup lets anyone create a user, otherwise only the admin does.
+	OpenSignup bool
+	// PrivateWebhooks lets webhooks post to private and loopback addresses,
+	// e.g. to receivers next to the service in development.
+	PrivateWebhooks bool
+	// WebhookKey encrypts the secrets webhooks are signed with, which are
+	// stored sealed with it. There are no webhooks when it is empty.
+	WebhookKey []byte
+	// StreamOrigins are the origins of web apps, e.g. "https://app.example.com",
+	// which may open WebSocket streams besides the service's own.
+	StreamOrigins []string
+
+	stopWorkers context.CancelFunc
+	// mailsMu orders the mails sent against StopServing waiting for them
+	mailsMu      sync.Mutex
+	mails        sync.WaitGroup
+	mailsStopped bool
+	streams      *broadcaster
+	localEvents  atomic.Bool
 }
 
 func (s *Service) ServeHttp() error {
@@ -26,28 +62,169 @@ func (s *Service) ServeHttp() error {
 	if err != nil {
 		return err
 	}
+	if err = EnsureIndexes(s.DbClient.Database("db")); err != nil {
+		return err
+	}
 	r := mux.NewRouter()
+	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, notFound("no route for %s", r.URL.Path))
+	})
+	r.MethodNotAllowedHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, &ApiError{Status: http.StatusMethodNotAllowed, Code: CodeMethodNotAllowed, Message: "method not allowed"})
+	})
+	r.Use(s.authenticate)
+	r.Use(validateRequest)
+	r.HandleFunc("/api/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		s.GetSpec(w, r)
+	}).Methods("GET")
 	r.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
 		s.AddUser(w, r)
 	}).Methods("POST")
+	r.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
+		s.ListUsers(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/users/{login}", func(w http.ResponseWriter, r *http.Request) {
+		s.GetUser(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/users/{login}", func(w http.ResponseWriter, r *http.Request) {
+		s.UpdateUser(w, r)
+	}).Methods("PATCH")
+	r.HandleFunc("/api/users/{login}", func(w http.ResponseWriter, r *http.Request) {
+		s.DeleteUser(w, r)
+	}).Methods("DELETE")
+	r.HandleFunc("/api/users/{login}/tokens", func(w http.ResponseWriter, r *http.Request) {
+		s.CreateToken(w, r)
+	}).Methods("POST")
+	r.HandleFunc("/api/users/{login}/tokens", func(w http.ResponseWriter, r *http.Request) {
+		s.ListTokens(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/users/{login}/tokens/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.DeleteToken(w, r)
+	}).Methods("DELETE")
+	r.HandleFunc("/api/sessions", func(w http.ResponseWriter, r *http.Request) {
+		s.CreateSession(w, r)
+	}).Methods("POST")
 	r.HandleFunc("/api/meetings", func(w http.ResponseWriter, r *http.Request) {
 		s.AddMeeting(w, r)
 	}).Methods("POST")
+	r.HandleFunc("/api/meetings/search", func(w http.ResponseWriter, r *http.Request) {
+		s.SearchMeetings(w, r)
+	}).Methods("GET")
 	r.HandleFunc("/api/meetings/{id}", func(w http.ResponseWriter, r *http.Request) {
 		s.GetMeeting(w, r)
 	}).Methods("GET")
+	r.HandleFunc("/api/meetings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.UpdateMeeting(w, r)
+	}).Methods("PUT")
+	r.HandleFunc("/api/meetings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.DeleteMeeting(w, r)
+	}).Methods("DELETE")
+	r.HandleFunc("/api/meetings/{id}/attachments", func(w http.ResponseWriter, r *http.Request) {
+		s.UploadAttachment(w, r)
+	}).Methods("POST")
+	r.HandleFunc("/api/meetings/{id}/attachments/{attachmentId}", func(w http.ResponseWriter, r *http.Request) {
+		s.DownloadAttachment(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/meetings/{id}/attachments/{attachmentId}", func(w http.ResponseWriter, r *http.Request) {
+		s.DeleteAttachment(w, r)
+	}).Methods("DELETE")
 	r.HandleFunc("/api/users/{login}/meetings", func(w http.ResponseWriter, r *http.Request) {
 		s.ListMeetings(w, r)
 	}).Methods("GET").Queries("startTime", "{startTime}").Queries("endTime", "{endTime}")
+	r.HandleFunc("/api/users/{login}/reminders", func(w http.ResponseWriter, r *http.Request) {
+		s.SetReminders(w, r)
+	}).Methods("PUT")
+	r.HandleFunc("/api/users/{login}/shares", func(w http.ResponseWriter, r *http.Request) {
+		s.SetShares(w, r)
+	}).Methods("PUT")
+	r.HandleFunc("/api/users/{login}/calendars", func(w http.ResponseWriter, r *http.Request) {
+		s.CreateCalendar(w, r)
+	}).Methods("POST")
+	r.HandleFunc("/api/users/{login}/calendars", func(w http.ResponseWriter, r *http.Request) {
+		s.ListCalendars(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/users/{login}/calendars/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.UpdateCalendar(w, r)
+	}).Methods("PUT")
+	r.HandleFunc("/api/users/{login}/calendars/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.DeleteCalendar(w, r)
+	}).Methods("DELETE")
+	r.HandleFunc("/api/groups", func(w http.ResponseWriter, r *http.Request) {
+		s.CreateGroup(w, r)
+	}).Methods("POST")
+	r.HandleFunc("/api/groups", func(w http.ResponseWriter, r *http.Request) {
+		s.ListGroups(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/groups/{name}", func(w http.ResponseWriter, r *http.Request) {
+		s.GetGroup(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/groups/{name}", func(w http.ResponseWriter, r *http.Request) {
+		s.UpdateGroup(w, r)
+	}).Methods("PUT")
+	r.HandleFunc("/api/groups/{name}", func(w http.ResponseWriter, r *http.Request) {
+		s.DeleteGroup(w, r)
+	}).Methods("DELETE")
+	r.HandleFunc("/api/users/{login}/sync", func(w http.ResponseWriter, r *http.Request) {
+		s.SyncMeetings(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/users/{login}/stream", func(w http.ResponseWriter, r *http.Request) {
+		s.StreamMeetings(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/users/{login}/calendar.ics", func(w http.ResponseWriter, r *http.Request) {
+		s.ExportCalendar(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/users/{login}/import", func(w http.ResponseWriter, r *http.Request) {
+		s.ImportCalendar(w, r)
+	}).Methods("POST")
+	r.HandleFunc("/api/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		s.AddWebhook(w, r)
+	}).Methods("POST")
+	r.HandleFunc("/api/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		s.ListWebhooks(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.GetWebhook(w, r)
+	}).Methods("GET")
+	r.HandleFunc("/api/webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.DeleteWebhook(w, r)
+	}).Methods("DELETE")
+	r.HandleFunc("/api/webhooks/{id}/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		s.ListDeliveries(w, r)
+	}).Methods("GET")
 	r.HandleFunc("/api/findSlot", func(w http.ResponseWriter, r *http.Request) {
 		s.FindSlot(w, r)
 	}).Methods("GET").Queries("startTime", "{startTime}").Queries("durationMinutes", "{durationMinutes}").Queries("logins", "{logins}")
 	r.HandleFunc("/api/acceptMeeting", func(w http.ResponseWriter, r *http.Request) {
 		s.AcceptMeeting(w, r)
 	}).Methods("POST")
+	r.HandleFunc("/api/itip", func(w http.ResponseWriter, r *http.Request) {
+		s.ReceiveItip(w, r)
+	}).Methods("POST")
+	s.registerDav(r)
 
-	s.Server = &http.Server{Addr: ":8080", Handler: r}
+	s.Server = &http.Server{Addr: ":8080", Handler: withRequestId(r)}
+	s.Server.RegisterOnShutdown(func() {
+		s.streams.closeAll()
+	})
 	s.StopWg = &sync.WaitGroup{}
+	var workers context.Context
+	workers, s.stopWorkers = context.WithCancel(context.Background())
+	s.startStreams(workers)
+	s.StopWg.Add(1)
+	go func() {
+		defer s.StopWg.Done()
+		s.dispatchWebhooks(workers)
+	}()
+	s.StopWg.Add(1)
+	go func() {
+		defer s.StopWg.Done()
+		s.purgeTombstones(workers)
+	}()
+	s.StopWg.Add(1)
+	go func() {
+		defer s.StopWg.Done()
+		s.scheduleReminders(workers)
+	}()
 	s.StopWg.Add(1)
 	go func() {
 		defer s.StopWg.Done()
@@ -58,6 +235,14 @@ func (s *Service) ServeHttp() error {
 	return nil
 }
 
+// stopMails waits for the mails in flight, and drops the ones sent later.
+func (s *Service) stopMails() {
+	s.mailsMu.Lock()
+	s.mailsStopped = true
+	s.mailsMu.Unlock()
+	s.mails.Wait()
+}
+
 func (s *Service) StopServing() error {
 	if s.Server == nil {
 		return errors.New("already stopped")
@@ -65,6 +250,8 @@ func (s *Service) StopServing() error {
 	if err := s.Server.Shutdown(context.TODO()); err != nil {
 		return err
 	}
+	s.stopWorkers()
 	s.StopWg.Wait()
+	s.stopMails()
 	return s.DbClient.Disconnect(context.TODO())
 }
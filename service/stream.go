@@ -0,0 +1,158 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+const streamKeepAlive = 15 * time.Second
+
+// StreamMeetings pushes the events of the meetings of a user as they happen,
+// as Server-Sent Events or, when the client asks to upgrade, over a WebSocket.
+func (s *Service) StreamMeetings(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		s.streamWebSocket(w, r, login)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, r, &ApiError{Status: http.StatusInternalServerError, Code: CodeInternal, Message: "streaming unsupported"})
+		return
+	}
+	sub := s.streams.subscribe(login)
+	defer s.streams.unsubscribe(sub)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	// clients reconnect after this many milliseconds when the stream ends
+	fmt.Fprint(w, "retry: 3000\n\n")
+	flusher.Flush()
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case event, ok := <-sub.events:
+			if !ok {
+				return
+			}
+			data, _ := json.Marshal(event)
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+		}
+		flusher.Flush()
+	}
+}
+
+// streamWriteTimeout bounds the writes to WebSockets, and a client which
+// doesn't answer pings for twice the keep-alive interval is gone.
+const streamWriteTimeout = 10 * time.Second
+
+// streamReadLimit bounds client messages, which are only read to answer pings
+// and notice closes.
+const streamReadLimit = 4096
+
+func (s *Service) streamWebSocket(w http.ResponseWriter, r *http.Request, login string) {
+	if err := s.checkOrigin(r); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	upgrader := websocket.Upgrader{
+		// checked above, so that refusals are answered like other errors
+		CheckOrigin: func(*http.Request) bool { return true },
+		Error: func(w http.ResponseWriter, r *http.Request, status int, reason error) {
+			code := CodeBadRequest
+			switch {
+			case status == http.StatusMethodNotAllowed:
+				code = CodeMethodNotAllowed
+			case status >= 500:
+				code = CodeInternal
+			}
+			writeError(w, r, &ApiError{Status: status, Code: code, Message: reason.Error()})
+		},
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	sub := s.streams.subscribe(login)
+	defer s.streams.unsubscribe(sub)
+	conn.SetReadLimit(streamReadLimit)
+	conn.SetReadDeadline(time.Now().Add(2 * streamKeepAlive))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * streamKeepAlive))
+	})
+	// the default handlers answer pings and closes while the client is read
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		var err error
+		select {
+		case <-closed:
+			return
+		case <-keepAlive.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout))
+		case event, ok := <-sub.events:
+			if !ok {
+				// the client fell too far behind, or the service stops
+				message := websocket.FormatCloseMessage(websocket.CloseGoingAway, "")
+				conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(streamWriteTimeout))
+				return
+			}
+			conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			err = conn.WriteJSON(event)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// checkOrigin lets browsers open WebSockets from the origin of the service
+// and from StreamOrigins only, as they don't apply CORS to them. Other
+// clients send no Origin.
+func (s *Service) checkOrigin(r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	if parsed, err := url.Parse(origin); err == nil && strings.EqualFold(parsed.Host, r.Host) {
+		return nil
+	}
+	for _, allowed := range s.StreamOrigins {
+		if strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return nil
+		}
+	}
+	return forbidden("origin %q may not open streams", origin)
+}
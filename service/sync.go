@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Every write of a meeting takes the next version from a counter. Deleted
+// meetings are kept as tombstones for tombstoneRetention, so that clients can
+// sync the deletion, tokens older than the purged tombstones expire.
+
+const (
+	// syncSettle is how long a version may take from being taken to being
+	// written, tokens don't move past younger changes so none is skipped
+	syncSettle         = 5 * time.Second
+	tombstoneRetention = 30 * 24 * time.Hour
+	tombstonePurge     = time.Hour
+)
+
+type SyncResult struct {
+	Created   []Meeting `json:"created"`
+	Updated   []Meeting `json:"updated"`
+	Deleted   []string  `json:"deleted"`
+	SyncToken string    `json:"syncToken"`
+	More      bool      `json:"more"` // another page is ready, sync again with SyncToken right away, never set while changes settle
+}
+
+// syncPosition is the last change a client has seen, changes are ordered by
+// version and then id.
+type syncPosition struct {
+	version int64
+	id      primitive.ObjectID
+}
+
+func (p syncPosition) token() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("v1:%d:%s", p.version, p.id.Hex())))
+}
+
+func parseSyncToken(token string) (syncPosition, error) {
+	position := syncPosition{}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return position, fmt.Errorf("invalid sync token")
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || parts[0] != "v1" {
+		return position, fmt.Errorf("invalid sync token")
+	}
+	if position.version, err = strconv.ParseInt(parts[1], 10, 64); err != nil || position.version < 0 {
+		return position, fmt.Errorf("invalid sync token")
+	}
+	if position.id, err = primitive.ObjectIDFromHex(parts[2]); err != nil {
+		return position, fmt.Errorf("invalid sync token")
+	}
+	return position, nil
+}
+
+// SyncMeetings returns the meetings of a user changed since the sync token,
+// or all of them without one. Deleted meetings, and meetings the user was
+// removed from, are listed by id.
+func (s *Service) SyncMeetings(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	limit := 100
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 || limit > 1000 {
+			writeError(w, r, badRequest("invalid limit %q", value))
+			return
+		}
+	}
+	token := r.URL.Query().Get("token")
+	position := syncPosition{}
+	filter := participantFilter([]string{login})
+	if token != "" {
+		var err error
+		if position, err = parseSyncToken(token); err != nil {
+			writeError(w, r, badRequest("%v", err))
+			return
+		}
+		purged, err := s.counter("purged")
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		if position.version < purged {
+			writeError(w, r, &ApiError{Status: http.StatusGone, Code: CodeSyncTokenExpired, Message: "sync token expired, sync again without a token"})
+			return
+		}
+		filter = bson.D{{"$and", bson.A{
+			bson.D{{"$or", bson.A{
+				bson.D{{"owner", login}},
+				bson.D{{"invited.invitee", login}},
+				bson.D{{"left", login}},
+			}}},
+			bson.D{{"$or", bson.A{
+				bson.D{{"version", bson.D{{"$gt", position.version}}}},
+				bson.D{{"version", position.version}, {"_id", bson.D{{"$gt", position.id}}}},
+			}}},
+		}}}
+	}
+	opts := options.Find().SetSort(bson.D{{"version", 1}, {"_id", 1}}).SetLimit(int64(limit) + 1)
+	cursor, err := s.DbClient.Database("db").Collection("meetings").Find(context.TODO(), filter, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	meetings := []Meeting{}
+	if err = cursor.All(context.TODO(), &meetings); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	result := SyncResult{Created: []Meeting{}, Updated: []Meeting{}, Deleted: []string{}}
+	if len(meetings) > limit {
+		meetings = meetings[:limit]
+		result.More = true
+	}
+	settled := time.Now().Add(-syncSettle)
+	next, advancing := position, true
+	for _, meeting := range meetings {
+		// younger changes are returned, but synced again next time
+		if advancing = advancing && meeting.UpdatedAt.Before(settled); advancing {
+			next.version = meeting.Version
+			next.id, _ = primitive.ObjectIDFromHex(meeting.Id)
+		}
+		switch {
+		case meeting.Deleted || (meeting.Owner != login && !meeting.isInvited(login)):
+			if meeting.CreatedVersion <= position.version {
+				result.Deleted = append(result.Deleted, meeting.Id)
+			}
+		case token == "" || meeting.CreatedVersion > position.version:
+			result.Created = append(result.Created, meeting)
+		default:
+			result.Updated = append(result.Updated, meeting)
+		}
+	}
+	// the rest of the page is synced again, later pages aren't ready either
+	result.More = result.More && advancing
+	result.SyncToken = next.token()
+	writeJson(w, http.StatusOK, result)
+}
+
+// nextVersion takes the version of a write of a meeting.
+func (s *Service) nextVersion() (int64, error) {
+	var counter struct {
+		Value int64 `bson:"value"`
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	update := bson.D{{"$inc", bson.D{{"value", int64(1)}}}}
+	err := s.DbClient.Database("db").Collection("counters").FindOneAndUpdate(context.TODO(), bson.D{{"_id", "meetings"}}, update, opts).Decode(&counter)
+	return counter.Value, err
+}
+
+func (s *Service) counter(name string) (int64, error) {
+	var counter struct {
+		Value int64 `bson:"value"`
+	}
+	err := s.DbClient.Database("db").Collection("counters").FindOne(context.TODO(), bson.D{{"_id", name}}).Decode(&counter)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	return counter.Value, err
+}
+
+// purgeTombstones removes old tombstones until ctx is done.
+func (s *Service) purgeTombstones(ctx context.Context) {
+	ticker := time.NewTicker(tombstonePurge)
+	defer ticker.Stop()
+	for {
+		if err := s.purgeTombstonesBefore(time.Now().Add(-tombstoneRetention)); err != nil {
+			log.Printf("sync: purge tombstones: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) purgeTombstonesBefore(before time.Time) error {
+	database := s.DbClient.Database("db")
+	opts := options.FindOne().SetSort(bson.D{{"version", -1}})
+	var newest Meeting
+	err := database.Collection("meetings").FindOne(context.TODO(), bson.D{{"deleted", true}, {"updatedAt", bson.D{{"$lt", before}}}}, opts).Decode(&newest)
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// tokens expire before the tombstones they might miss are gone
+	update := bson.D{{"$max", bson.D{{"value", newest.Version}}}}
+	if _, err = database.Collection("counters").UpdateOne(context.TODO(), bson.D{{"_id", "purged"}}, update, options.Update().SetUpsert(true)); err != nil {
+		return err
+	}
+	_, err = database.Collection("meetings").DeleteMany(context.TODO(), bson.D{{"deleted", true}, {"version", bson.D{{"$lte", newest.Version}}}})
+	return err
+}
+
+// leftParticipants returns who took part in existing, or left it before, but
+// does not take part in meeting.
+func leftParticipants(existing, meeting *Meeting) []string {
+	current := map[string]bool{}
+	for _, login := range meeting.participants() {
+		current[login] = true
+	}
+	left := []string{}
+	for _, login := range append(existing.participants(), existing.Left...) {
+		if !current[login] {
+			current[login] = true
+			left = append(left, login)
+		}
+	}
+	return left
+}
+
+// removedParticipants returns who took part in existing but does not take
+// part in meeting.
+func removedParticipants(existing, meeting *Meeting) []string {
+	current := map[string]bool{}
+	for _, login := range meeting.participants() {
+		current[login] = true
+	}
+	removed := []string{}
+	for _, login := range existing.participants() {
+		if !current[login] {
+			removed = append(removed, login)
+		}
+	}
+	return removed
+}
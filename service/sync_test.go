@@ -0,0 +1,29 @@
+package service
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSyncToken(t *testing.T) {
+	id, _ := primitive.ObjectIDFromHex("640a4862377457548608f50a")
+	position := syncPosition{version: 42, id: id}
+	parsed, err := parseSyncToken(position.token())
+	require.NoError(t, err)
+	require.Equal(t, position, parsed)
+
+	for _, token := range []string{"", "not base64!", base64.RawURLEncoding.EncodeToString([]byte("v2:42:640a4862377457548608f50a")), base64.RawURLEncoding.EncodeToString([]byte("v1:-1:640a4862377457548608f50a"))} {
+		_, err = parseSyncToken(token)
+		require.Error(t, err, token)
+	}
+}
+
+func TestLeftParticipants(t *testing.T) {
+	existing := &Meeting{Owner: "bob", Invited: []Invitation{{Invitee: "alice"}, {Invitee: "carl"}}, Left: []string{"dave", "erin"}}
+	meeting := &Meeting{Owner: "bob", Invited: []Invitation{{Invitee: "alice"}, {Invitee: "erin"}}}
+	require.Equal(t, []string{"carl", "dave"}, leftParticipants(existing, meeting))
+	require.Equal(t, []string{"carl"}, removedParticipants(existing, meeting))
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Logins never change, they are referenced by meetings, shares, groups and
+// the rest. Deleting a user cancels the meetings they own, or transfers them
+// to another user, and removes them from everything else.
+
+const maxDisplayName = 100
+
+// loginPattern keeps logins usable in paths, calendar addresses and
+// iCalendar properties.
+var loginPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// ListUsers returns every user, the profile of others only.
+func (s *Service) ListUsers(w http.ResponseWriter, r *http.Request) {
+	opts := options.Find().SetSort(bson.D{{"login", 1}})
+	cursor, err := s.DbClient.Database("db").Collection("users").Find(context.TODO(), bson.D{}, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	users := []User{}
+	if err = cursor.All(context.TODO(), &users); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	for i := range users {
+		users[i] = userView(r, &users[i])
+	}
+	writeJson(w, http.StatusOK, users)
+}
+
+func (s *Service) GetUser(w http.ResponseWriter, r *http.Request) {
+	user, err := s.findUser(mux.Vars(r)["login"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, userView(r, user))
+}
+
+// UpdateUser changes the profile of a user.
+func (s *Service) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var request UserUpdate
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	profile := User{}
+	set, unset := bson.D{}, bson.D{}
+	for _, field := range []struct {
+		name    string
+		value   *string
+		profile *string
+	}{
+		{"displayName", request.DisplayName, &profile.DisplayName},
+		{"email", request.Email, &profile.Email},
+		{"timeZone", request.TimeZone, &profile.TimeZone},
+	} {
+		if field.value == nil {
+			continue
+		}
+		if *field.profile = strings.TrimSpace(*field.value); *field.profile == "" {
+			unset = append(unset, bson.E{field.name, ""})
+		} else {
+			set = append(set, bson.E{field.name, *field.profile})
+		}
+	}
+	if err := validateProfile(&profile); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	update := bson.D{}
+	if len(set) != 0 {
+		update = append(update, bson.E{"$set", set})
+	}
+	if len(unset) != 0 {
+		update = append(update, bson.E{"$unset", unset})
+	}
+	if len(update) == 0 {
+		user, err := s.findUser(login)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeJson(w, http.StatusOK, user)
+		return
+	}
+	var user User
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := s.DbClient.Database("db").Collection("users").FindOneAndUpdate(context.TODO(), bson.D{{"login", login}}, update, opts).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		writeError(w, r, notFound("user %q not found", login))
+		return
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, user)
+}
+
+// DeleteUser deletes a user. The meetings they own are transferred to the
+// user of the transferTo parameter, or cancelled without one, and their
+// invitations are removed.
+func (s *Service) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+	if err := authorize(r, login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, err := s.findUser(login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	transferTo := r.URL.Query().Get("transferTo")
+	if transferTo == login {
+		writeError(w, r, validationFailed("invalid transfer", ErrorDetail{"transferTo", "must be another user"}))
+		return
+	}
+	if transferTo != "" {
+		if err := s.checkUsersExist([]string{transferTo}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+	if err := s.removeUser(login, transferTo, requestPrincipal(r).login); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeUser removes login from everything, by by, and then deletes the
+// user. The user is deleted last, so that a failed deletion can be retried.
+func (s *Service) removeUser(login, transferTo, by string) error {
+	database := s.DbClient.Database("db")
+	// groups first, so that refreshing them doesn't invite the user again
+	if _, err := database.Collection("groups").UpdateMany(context.TODO(), bson.D{{"members", login}}, bson.D{{"$pull", bson.D{{"members", login}}}}); err != nil {
+		return err
+	}
+	if transferTo != "" {
+		if _, err := database.Collection("groups").UpdateMany(context.TODO(), bson.D{{"owner", login}}, bson.D{{"$set", bson.D{{"owner", transferTo}}}}); err != nil {
+			return err
+		}
+	}
+	cursor, err := database.Collection("meetings").Find(context.TODO(), participantFilter([]string{login}))
+	if err != nil {
+		return err
+	}
+	meetings := []Meeting{}
+	if err = cursor.All(context.TODO(), &meetings); err != nil {
+		return err
+	}
+	for i := range meetings {
+		existing := &meetings[i]
+		if existing.Owner == login && transferTo == "" {
+			existing.UpdatedBy = by
+			if err := s.deleteMeeting(existing); err != nil {
+				return err
+			}
+			continue
+		}
+		meeting := *existing
+		meeting.Invited = []Invitation{}
+		for _, invitation := range existing.Invited {
+			if invitation.Invitee != login && !(existing.Owner == login && invitation.Invitee == transferTo) {
+				meeting.Invited = append(meeting.Invited, invitation)
+			}
+		}
+		meeting.UpdatedBy = by
+		if existing.Owner == login {
+			// the calendars of the user are gone
+			meeting.Owner, meeting.CalendarId, meeting.CalendarVisibility = transferTo, "", ""
+			meeting.Sequence = existing.Sequence + 1
+		}
+		if err := s.storeMeeting(&meeting, existing); err != nil {
+			return err
+		}
+		if existing.Owner == login {
+			s.notifyInvitees(existing, &meeting)
+		}
+	}
+	if _, err := database.Collection("users").UpdateMany(context.TODO(), bson.D{{"shares.grantee", login}}, bson.D{{"$pull", bson.D{{"shares", bson.D{{"grantee", login}}}}}}); err != nil {
+		return err
+	}
+	webhookIds, err := database.Collection("webhooks").Distinct(context.TODO(), "_id", bson.D{{"login", login}})
+	if err != nil {
+		return err
+	}
+	if len(webhookIds) != 0 {
+		ids := []string{}
+		for _, id := range webhookIds {
+			if id, ok := id.(primitive.ObjectID); ok {
+				ids = append(ids, id.Hex())
+			}
+		}
+		filter := bson.D{{"webhookId", bson.D{{"$in", ids}}}, {"status", DeliveryPending}}
+		update := bson.D{{"$set", bson.D{{"status", DeliveryFailed}, {"lastError", "webhook deleted"}, {"updatedAt", time.Now().UTC()}}}}
+		if _, err := database.Collection("deliveries").UpdateMany(context.TODO(), filter, update); err != nil {
+			return err
+		}
+	}
+	for _, collection := range []struct{ name, field string }{
+		{"webhooks", "login"},
+		{"calendars", "owner"},
+		{"tokens", "login"},
+		{"users", "login"},
+	} {
+		if _, err := database.Collection(collection.name).DeleteMany(context.TODO(), bson.D{{collection.field, login}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateLogin returns the problems of the login of a new user.
+func validateLogin(login string) []ErrorDetail {
+	switch _, group := groupName(login); {
+	case login == "":
+		return []ErrorDetail{{"login", "is required"}}
+	case group:
+		return []ErrorDetail{{"login", fmt.Sprintf("may not start with %q", groupPrefix)}}
+	case !loginPattern.MatchString(login):
+		return []ErrorDetail{{"login", "at most 64 letters, digits, '.', '_' and '-', starting with a letter or digit"}}
+	}
+	return nil
+}
+
+func validateProfile(user *User) error {
+	details := []ErrorDetail{}
+	if len(user.DisplayName) > maxDisplayName {
+		details = append(details, ErrorDetail{"displayName", "at most 100 characters"})
+	}
+	if user.Email != "" {
+		if address, err := mail.ParseAddress(user.Email); err != nil || address.Address != user.Email {
+			details = append(details, ErrorDetail{"email", "must be an email address"})
+		}
+	}
+	if user.TimeZone != "" {
+		if _, err := time.LoadLocation(user.TimeZone); err != nil || user.TimeZone == "Local" {
+			details = append(details, ErrorDetail{"timeZone", "must be an IANA time zone, e.g. Europe/Berlin"})
+		}
+	}
+	if len(details) != 0 {
+		return validationFailed("invalid profile", details...)
+	}
+	return nil
+}
+
+// userView returns the user as the principal of the request may see them:
+// the user themselves and the admin see all of it, others the profile.
+func userView(r *http.Request, user *User) User {
+	if authorize(r, user.Login) == nil {
+		return *user
+	}
+	return User{Id: user.Id, Login: user.Login, DisplayName: user.DisplayName, TimeZone: user.TimeZone}
+}
+
+// location returns the time zone of the user, UTC when they have none.
+func (u *User) location() *time.Location {
+	if location, err := time.LoadLocation(u.TimeZone); err == nil && u.TimeZone != "" {
+		return location
+	}
+	return time.UTC
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateProfile(t *testing.T) {
+	require.NoError(t, validateProfile(&User{DisplayName: "Alice", Email: "alice@example.com", TimeZone: "Europe/Berlin"}))
+	err := toApiError(validateProfile(&User{DisplayName: strings.Repeat("a", 101), Email: "Alice <alice@example.com>", TimeZone: "Mars/Olympus"}))
+	require.Equal(t, CodeValidationFailed, err.Code)
+	require.Len(t, err.Details, 3)
+	require.Error(t, validateProfile(&User{TimeZone: "Local"}))
+
+	require.Equal(t, time.UTC, (&User{}).location())
+	require.Equal(t, "Europe/Berlin", (&User{TimeZone: "Europe/Berlin"}).location().String())
+}
+
+func TestValidateLogin(t *testing.T) {
+	for _, login := range []string{"bob", "Alice.Smith", "carol_1", "d", strings.Repeat("e", 64)} {
+		require.Empty(t, validateLogin(login), login)
+	}
+	for _, login := range []string{"", " bob", "bob ", "-bob", "bob@example.com", "bob/alice", "team:engineering", strings.Repeat("e", 65)} {
+		require.Len(t, validateLogin(login), 1, login)
+	}
+}
+
+func TestUserView(t *testing.T) {
+	user := &User{Login: "bob", DisplayName: "Bob", Email: "bob@example.com", TimeZone: "UTC", Shares: []Share{{Grantee: "carol", Level: ShareEdit}}}
+	r := httptest.NewRequest("GET", "/api/users/bob", nil)
+	bob := r.WithContext(context.WithValue(r.Context(), principalKey{}, &principal{login: "bob"}))
+	alice := r.WithContext(context.WithValue(r.Context(), principalKey{}, &principal{login: "alice"}))
+	require.Equal(t, *user, userView(bob, user))
+	require.Equal(t, User{Login: "bob", DisplayName: "Bob", TimeZone: "UTC"}, userView(alice, user))
+}
@@ -0,0 +1,570 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	webhookPollInterval   = time.Second
+	webhookTimeout        = 10 * time.Second
+	webhookMaxAttempts    = 8
+	webhookInitialBackoff = 30 * time.Second
+	webhookMaxBackoff     = time.Hour
+	// deliveries in flight, one slow webhook only holds up its own
+	webhookWorkers     = 16
+	webhookConcurrency = 4
+	// a claimed delivery is retried by another replica when its dispatcher
+	// dies before recording the outcome
+	webhookLease = time.Minute
+)
+
+// Webhook subscribes an url to the events of the meetings of a user, or of
+// all meetings when Login is empty.
+type Webhook struct {
+	Id        string      `json:"id,omitempty" bson:"_id,omitempty"`
+	Login     string      `json:"login,omitempty" bson:"login,omitempty"`
+	Url       string      `json:"url" bson:"url"`
+	Events    []EventType `json:"events,omitempty" bson:"events,omitempty"` // all events when empty
+	Secret    string      `json:"secret,omitempty" bson:"-"`                // only returned on creation
+	CreatedAt time.Time   `json:"createdAt" bson:"createdAt"`
+	// SealedSecret is the Secret encrypted with the WebhookKey.
+	SealedSecret []byte `json:"-" bson:"secret"`
+}
+
+type DeliveryStatus string
+
+var (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Delivery is an event queued for a webhook together with the log of the
+// attempts to deliver it.
+type Delivery struct {
+	Id             string         `json:"id,omitempty" bson:"_id,omitempty"`
+	WebhookId      string         `json:"webhookId" bson:"webhookId"`
+	Event          Event          `json:"event" bson:"event"`
+	Status         DeliveryStatus `json:"status" bson:"status"`
+	Attempts       int            `json:"attempts" bson:"attempts"`
+	NextAttempt    time.Time      `json:"nextAttempt" bson:"nextAttempt"`
+	ResponseStatus int            `json:"responseStatus,omitempty" bson:"responseStatus,omitempty"`
+	LastError      string         `json:"lastError,omitempty" bson:"lastError,omitempty"`
+	CreatedAt      time.Time      `json:"createdAt" bson:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt" bson:"updatedAt"`
+}
+
+func (s *Service) AddWebhook(w http.ResponseWriter, r *http.Request) {
+	if len(s.WebhookKey) == 0 {
+		writeError(w, r, notFound("webhooks are not enabled"))
+		return
+	}
+	var webhook Webhook
+	if err := json.NewDecoder(r.Body).Decode(&webhook); err != nil {
+		writeError(w, r, badRequest("malformed request body: %v", err))
+		return
+	}
+	// only the admin subscribes to the meetings of everyone
+	webhook.Login = actingLogin(r, webhook.Login)
+	if !isHttpUrl(webhook.Url) {
+		writeError(w, r, validationFailed("invalid webhook", ErrorDetail{"url", "must be an absolute http(s) url"}))
+		return
+	}
+	if err := s.checkWebhookHost(r.Context(), webhook.Url); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	for _, eventType := range webhook.Events {
+		if !isEventType(eventType) {
+			writeError(w, r, validationFailed("invalid webhook", ErrorDetail{"events", fmt.Sprintf("unknown event %q", eventType)}))
+			return
+		}
+	}
+	if webhook.Login != "" {
+		if err := s.checkUsersExist([]string{webhook.Login}); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+	if webhook.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		webhook.Secret = hex.EncodeToString(secret)
+	}
+	sealed, err := s.sealSecret(webhook.Secret)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	webhook.SealedSecret = sealed
+	webhook.Id = ""
+	webhook.CreatedAt = time.Now().UTC()
+	res, err := s.DbClient.Database("db").Collection("webhooks").InsertOne(context.TODO(), webhook)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if oid, ok := res.InsertedID.(primitive.ObjectID); ok {
+		webhook.Id = oid.Hex()
+	}
+	writeJson(w, http.StatusOK, webhook)
+}
+
+func (s *Service) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	filter := bson.D{}
+	if login := actingLogin(r, r.URL.Query().Get("login")); login != "" {
+		filter = bson.D{{"login", login}}
+	}
+	opts := options.Find().SetSort(bson.D{{"createdAt", 1}}).SetProjection(bson.D{{"secret", 0}})
+	cursor, err := s.DbClient.Database("db").Collection("webhooks").Find(context.TODO(), filter, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	webhooks := []Webhook{}
+	if err = cursor.All(context.TODO(), &webhooks); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, webhooks)
+}
+
+func (s *Service) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, err := s.findOwnWebhook(r, mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, webhook)
+}
+
+func (s *Service) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, err := s.findOwnWebhook(r, mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	objectId, _ := primitive.ObjectIDFromHex(webhook.Id)
+	database := s.DbClient.Database("db")
+	if _, err := database.Collection("webhooks").DeleteOne(context.TODO(), bson.D{{"_id", objectId}}); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// the log is kept, only pending deliveries are dropped
+	filter := bson.D{{"webhookId", webhook.Id}, {"status", DeliveryPending}}
+	update := bson.D{{"$set", bson.D{{"status", DeliveryFailed}, {"lastError", "webhook deleted"}, {"updatedAt", time.Now().UTC()}}}}
+	if _, err := database.Collection("deliveries").UpdateMany(context.TODO(), filter, update); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListDeliveries returns the most recent deliveries of a webhook.
+func (s *Service) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	webhook, err := s.findOwnWebhook(r, mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	limit := 50
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			writeError(w, r, badRequest("invalid limit %q", value))
+			return
+		}
+	}
+	filter := bson.D{{"webhookId", webhook.Id}}
+	if status := r.URL.Query().Get("status"); status != "" {
+		filter = append(filter, bson.E{"status", status})
+	}
+	opts := options.Find().SetSort(bson.D{{"createdAt", -1}}).SetLimit(int64(limit))
+	cursor, err := s.DbClient.Database("db").Collection("deliveries").Find(context.TODO(), filter, opts)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	deliveries := []Delivery{}
+	if err = cursor.All(context.TODO(), &deliveries); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJson(w, http.StatusOK, deliveries)
+}
+
+// findOwnWebhook looks up a webhook of the user of the request, webhooks of
+// others are not found.
+func (s *Service) findOwnWebhook(r *http.Request, webhookId string) (*Webhook, error) {
+	webhook, err := s.findWebhook(webhookId)
+	if err != nil {
+		return nil, err
+	}
+	if authorize(r, webhook.Login) != nil {
+		return nil, notFound("webhook %q not found", webhookId)
+	}
+	return webhook, nil
+}
+
+func (s *Service) findWebhook(webhookId string) (*Webhook, error) {
+	objectId, err := primitive.ObjectIDFromHex(webhookId)
+	if err != nil {
+		return nil, notFound("webhook %q not found", webhookId)
+	}
+	var webhook Webhook
+	err = s.DbClient.Database("db").Collection("webhooks").FindOne(context.TODO(), bson.D{{"_id", objectId}}).Decode(&webhook)
+	if err == mongo.ErrNoDocuments {
+		return nil, notFound("webhook %q not found", webhookId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// webhookCipher encrypts secrets with AES-256-GCM, under the SHA-256 of the
+// WebhookKey.
+func (s *Service) webhookCipher() (cipher.AEAD, error) {
+	key := sha256.Sum256(s.WebhookKey)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealSecret encrypts the secret of a webhook, the nonce is prepended.
+func (s *Service) sealSecret(secret string) ([]byte, error) {
+	aead, err := s.webhookCipher()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, []byte(secret), nil), nil
+}
+
+// openSecret decrypts a secret sealed by sealSecret.
+func (s *Service) openSecret(sealed []byte) (string, error) {
+	aead, err := s.webhookCipher()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("sealed secret too short")
+	}
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("open secret: %w", err)
+	}
+	return string(secret), nil
+}
+
+func isEventType(eventType EventType) bool {
+	for _, known := range eventTypes {
+		if known == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// publicAddress reports whether ip is an address of the internet, webhooks
+// must not reach into the network of the service.
+func publicAddress(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() && !ip.IsMulticast()
+}
+
+// checkWebhookHost checks that the host of a webhook url only resolves to
+// public addresses, unless PrivateWebhooks is set.
+func (s *Service) checkWebhookHost(ctx context.Context, webhookUrl string) error {
+	if s.PrivateWebhooks {
+		return nil
+	}
+	target, err := url.Parse(webhookUrl)
+	if err != nil {
+		return validationFailed("invalid webhook", ErrorDetail{"url", "must be an absolute http(s) url"})
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, target.Hostname())
+	if err != nil {
+		return validationFailed("invalid webhook", ErrorDetail{"url", fmt.Sprintf("host %q does not resolve", target.Hostname())})
+	}
+	for _, addr := range addrs {
+		if !publicAddress(addr.IP) {
+			return validationFailed("invalid webhook", ErrorDetail{"url", fmt.Sprintf("host %q has the private address %s", target.Hostname(), addr.IP)})
+		}
+	}
+	return nil
+}
+
+// webhookClient posts to webhooks. Addresses are checked again when they are
+// dialed, as the host may resolve to others since the webhook was added.
+func webhookClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !allowPrivate && !publicAddress(ip) {
+				return fmt.Errorf("refusing to connect to the private address %s", host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would connect to the webhook instead of the dialer
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Transport: transport}
+}
+
+// enqueueDeliveries queues the event for every webhook subscribed to it, with
+// the meeting as the user of the webhook sees it.
+func (s *Service) enqueueDeliveries(event *Event) error {
+	database := s.DbClient.Database("db")
+	filter := bson.D{{"$and", bson.A{
+		bson.D{{"$or", bson.A{
+			bson.D{{"login", bson.D{{"$exists", false}}}},
+			bson.D{{"login", bson.D{{"$in", event.recipients()}}}},
+		}}},
+		bson.D{{"$or", bson.A{
+			bson.D{{"events", bson.D{{"$exists", false}}}},
+			bson.D{{"events", event.Type}},
+		}}},
+	}}}
+	cursor, err := database.Collection("webhooks").Find(context.TODO(), filter, options.Find().SetProjection(bson.D{{"_id", 1}, {"login", 1}}))
+	if err != nil {
+		return err
+	}
+	webhooks := []Webhook{}
+	if err = cursor.All(context.TODO(), &webhooks); err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+	deliveries := []interface{}{}
+	policies := map[string]*policy{}
+	for _, webhook := range webhooks {
+		pol, ok := policies[webhook.Login]
+		if !ok {
+			// webhooks of all meetings are the admin's
+			p := &principal{login: webhook.Login, admin: webhook.Login == ""}
+			if pol, err = s.principalPolicy(p); err != nil {
+				return err
+			}
+			policies[webhook.Login] = pol
+		}
+		payload := *event
+		payload.Meeting = pol.view(&event.Meeting)
+		deliveries = append(deliveries, Delivery{
+			WebhookId:   webhook.Id,
+			Event:       payload,
+			Status:      DeliveryPending,
+			NextAttempt: event.Time,
+			CreatedAt:   event.Time,
+			UpdatedAt:   event.Time,
+		})
+	}
+	_, err = database.Collection("deliveries").InsertMany(context.TODO(), deliveries)
+	return err
+}
+
+// dispatchWebhooks delivers queued events until ctx is done. Deliveries are
+// claimed with a lease, so that several replicas can share the queue. Up to
+// webhookWorkers deliveries are in flight, at most webhookConcurrency of them
+// to the same webhook.
+func (s *Service) dispatchWebhooks(ctx context.Context) {
+	client := webhookClient(s.PrivateWebhooks)
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+	var (
+		mu       sync.Mutex
+		inFlight = map[string]int{} // by webhook
+		wg       sync.WaitGroup
+		done     = make(chan struct{}, 1)
+	)
+	defer wg.Wait()
+	for {
+		for ctx.Err() == nil {
+			mu.Lock()
+			total, busy := 0, []string{}
+			for webhookId, n := range inFlight {
+				total += n
+				if n >= webhookConcurrency {
+					busy = append(busy, webhookId)
+				}
+			}
+			mu.Unlock()
+			if total >= webhookWorkers {
+				break
+			}
+			delivery, err := s.claimDelivery(busy)
+			if err == mongo.ErrNoDocuments {
+				break
+			}
+			if err != nil {
+				log.Printf("webhooks: claim delivery: %v", err)
+				break
+			}
+			mu.Lock()
+			inFlight[delivery.WebhookId]++
+			mu.Unlock()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.deliver(ctx, client, delivery)
+				mu.Lock()
+				if inFlight[delivery.WebhookId]--; inFlight[delivery.WebhookId] == 0 {
+					delete(inFlight, delivery.WebhookId)
+				}
+				mu.Unlock()
+				select {
+				case done <- struct{}{}:
+				default:
+				}
+			}()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-done:
+		}
+	}
+}
+
+// claimDelivery claims the next due delivery to a webhook other than busy.
+func (s *Service) claimDelivery(busy []string) (*Delivery, error) {
+	now := time.Now().UTC()
+	filter := bson.D{{"status", DeliveryPending}, {"nextAttempt", bson.D{{"$lte", now}}}}
+	if len(busy) != 0 {
+		filter = append(filter, bson.E{"webhookId", bson.D{{"$nin", busy}}})
+	}
+	update := bson.D{{"$set", bson.D{{"nextAttempt", now.Add(webhookLease)}}}}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{"nextAttempt", 1}}).SetReturnDocument(options.After)
+	var delivery Delivery
+	err := s.DbClient.Database("db").Collection("deliveries").FindOneAndUpdate(context.TODO(), filter, update, opts).Decode(&delivery)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (s *Service) deliver(ctx context.Context, client *http.Client, delivery *Delivery) {
+	objectId, _ := primitive.ObjectIDFromHex(delivery.Id)
+	coll := s.DbClient.Database("db").Collection("deliveries")
+	webhook, err := s.findWebhook(delivery.WebhookId)
+	if err != nil {
+		update := bson.D{{"$set", bson.D{{"status", DeliveryFailed}, {"lastError", "webhook deleted"}, {"updatedAt", time.Now().UTC()}}}}
+		coll.UpdateOne(context.TODO(), bson.D{{"_id", objectId}}, update)
+		return
+	}
+	if webhook.Secret, err = s.openSecret(webhook.SealedSecret); err != nil {
+		update := bson.D{{"$set", bson.D{{"status", DeliveryFailed}, {"lastError", err.Error()}, {"updatedAt", time.Now().UTC()}}}}
+		coll.UpdateOne(context.TODO(), bson.D{{"_id", objectId}}, update)
+		return
+	}
+	status, err := postEvent(ctx, client, webhook, delivery)
+	now := time.Now().UTC()
+	set := bson.D{{"attempts", delivery.Attempts + 1}, {"updatedAt", now}, {"responseStatus", status}}
+	switch {
+	case err == nil:
+		set = append(set, bson.E{"status", DeliverySucceeded}, bson.E{"lastError", ""})
+	case delivery.Attempts+1 >= webhookMaxAttempts:
+		set = append(set, bson.E{"status", DeliveryFailed}, bson.E{"lastError", err.Error()})
+	default:
+		set = append(set, bson.E{"nextAttempt", now.Add(webhookBackoff(delivery.Attempts + 1))}, bson.E{"lastError", err.Error()})
+	}
+	if _, err := coll.UpdateOne(context.TODO(), bson.D{{"_id", objectId}}, bson.D{{"$set", set}}); err != nil {
+		log.Printf("webhooks: record delivery %s: %v", delivery.Id, err)
+	}
+}
+
+// webhookBackoff is the delay before the attempt following the given number
+// of failed ones: 30s, 1m, 2m, ... up to an hour.
+func webhookBackoff(failed int) time.Duration {
+	backoff := webhookInitialBackoff
+	for i := 1; i < failed && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > webhookMaxBackoff {
+		backoff = webhookMaxBackoff
+	}
+	return backoff
+}
+
+// postEvent sends the event signed with the secret of the webhook, any
+// response but a 2xx is a failure.
+func postEvent(ctx context.Context, client *http.Client, webhook *Webhook, delivery *Delivery) (int, error) {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, "POST", webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "calendar-webhooks")
+	request.Header.Set("X-Calendar-Event", string(delivery.Event.Type))
+	request.Header.Set("X-Calendar-Delivery", delivery.Id)
+	request.Header.Set("X-Calendar-Timestamp", timestamp)
+	request.Header.Set("X-Calendar-Signature", signPayload(webhook.Secret, timestamp, body))
+	response, err := client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("webhook responded with %s", response.Status)
+	}
+	return response.StatusCode, nil
+}
+
+// signPayload is the HMAC-SHA256 of "{timestamp}.{body}", receivers compute
+// it with their copy of the secret and reject stale timestamps.
+func signPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
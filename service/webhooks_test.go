@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignPayload(t *testing.T) {
+	// echo -n '1678204800.{}' | openssl dgst -sha256 -hmac secret
+	require.Equal(t, "sha256=170ed8c75ad278bfe90a53f2fbc8dc693e5fde77c5fd0aa8c19d1b4c7dcf1c67", signPayload("secret", "1678204800", []byte("{}")))
+	require.NotEqual(t, signPayload("secret", "1678204800", []byte("{}")), signPayload("other", "1678204800", []byte("{}")))
+	require.NotEqual(t, signPayload("secret", "1678204800", []byte("{}")), signPayload("secret", "1678204801", []byte("{}")))
+}
+
+func TestSealSecret(t *testing.T) {
+	s := &Service{WebhookKey: []byte("key")}
+	sealed, err := s.sealSecret("s3cret")
+	require.NoError(t, err)
+	require.NotContains(t, string(sealed), "s3cret")
+	secret, err := s.openSecret(sealed)
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", secret)
+
+	_, err = (&Service{WebhookKey: []byte("other")}).openSecret(sealed)
+	require.Error(t, err)
+	_, err = s.openSecret(sealed[:4])
+	require.Error(t, err)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, webhookBackoff(1))
+	require.Equal(t, time.Minute, webhookBackoff(2))
+	require.Equal(t, 16*time.Minute, webhookBackoff(6))
+	require.Equal(t, time.Hour, webhookBackoff(webhookMaxAttempts))
+}
+
+func TestPostEvent(t *testing.T) {
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "meeting.created", r.Header.Get("X-Calendar-Event"))
+		require.Equal(t, "d1", r.Header.Get("X-Calendar-Delivery"))
+		require.Equal(t, signPayload("secret", r.Header.Get("X-Calendar-Timestamp"), body), r.Header.Get("X-Calendar-Signature"))
+		var event Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		require.Equal(t, "bob", event.Meeting.Owner)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	webhook := &Webhook{Url: server.URL, Secret: "secret"}
+	delivery := &Delivery{Id: "d1", Event: Event{Id: "e1", Type: MeetingCreated, Meeting: Meeting{Owner: "bob"}}}
+
+	code, err := postEvent(context.Background(), webhookClient(true), webhook, delivery)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, code)
+
+	status = http.StatusServiceUnavailable
+	code, err = postEvent(context.Background(), webhookClient(true), webhook, delivery)
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+
+	// the receiver listens on loopback
+	_, err = postEvent(context.Background(), webhookClient(false), webhook, delivery)
+	require.ErrorContains(t, err, "private address")
+}
+
+func TestCheckWebhookHost(t *testing.T) {
+	s := &Service{}
+	for _, url := range []string{"http://127.0.0.1:8080/hook", "http://10.1.2.3/", "https://[::1]/", "http://169.254.169.254/latest", "http://[fe80::1]/", "http://0.0.0.0/"} {
+		require.Equal(t, CodeValidationFailed, toApiError(s.checkWebhookHost(context.Background(), url)).Code, url)
+	}
+	require.NoError(t, s.checkWebhookHost(context.Background(), "https://93.184.216.34/hook"))
+	require.NoError(t, (&Service{PrivateWebhooks: true}).checkWebhookHost(context.Background(), "http://127.0.0.1:8080/hook"))
+	require.True(t, publicAddress(net.ParseIP("2606:2800:220:1::1")))
+	require.False(t, publicAddress(net.ParseIP("::ffff:192.168.1.1")))
+}
@@ -1,12 +1,27 @@
 package tests
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/require"
+	calendar "github.com/vladem/calendar/client"
+	apiclient "github.com/vladem/calendar/openapi/client"
+	"github.com/vladem/calendar/openapi/client/operations"
+	"github.com/vladem/calendar/openapi/models"
 	"github.com/vladem/calendar/service"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -16,13 +31,17 @@ var (
 	url        = "http://api:8080"
 	dbClient   *mongo.Client
 	setupError error
-	client     *CalendarClient
+	// client acts as the admin, who may act as any user
+	client     *calendar.Client
+	adminToken = os.Getenv("CALENDAR_ADMIN_TOKEN")
+	ctx        = context.Background()
 )
 
 func TestMain(m *testing.M) {
 	dbClient, setupError = service.ConnectDb()
-	client = &CalendarClient{url}
-	for i := 0; i < 10 && client.Ping() != nil; i++ {
+	client = calendar.New(url)
+	client.Token = adminToken
+	for i := 0; i < 10 && client.Ping(ctx) != nil; i++ {
 		time.Sleep(time.Second)
 	}
 	os.Exit(m.Run())
@@ -35,81 +54,1504 @@ func cleanup(t *testing.T) {
 	require.Empty(t, err)
 	_, err = database.Collection("meetings").DeleteMany(context.TODO(), bson.M{})
 	require.Empty(t, err)
+	_, err = database.Collection("tokens").DeleteMany(context.TODO(), bson.M{})
+	require.Empty(t, err)
+	_, err = database.Collection("calendars").DeleteMany(context.TODO(), bson.M{})
+	require.Empty(t, err)
+	_, err = database.Collection("groups").DeleteMany(context.TODO(), bson.M{})
+	require.Empty(t, err)
+}
+
+// get and post send requests with the admin token, like client.
+func get(path string) (*http.Response, error) {
+	return send("GET", path, "", nil)
+}
+
+func post(path, contentType string, body io.Reader) (*http.Response, error) {
+	return send("POST", path, contentType, body)
+}
+
+func send(method, path, contentType string, body io.Reader) (*http.Response, error) {
+	request, err := http.NewRequest(method, url+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	request.Header.Set("Authorization", "Bearer "+adminToken)
+	return http.DefaultClient.Do(request)
+}
+
+func addUser(login string) error {
+	_, err := client.AddUser(ctx, login)
+	return err
+}
+
+func addMeeting(meeting calendar.Meeting) (string, error) {
+	created, err := client.AddMeeting(ctx, meeting)
+	if err != nil {
+		return "", err
+	}
+	return created.Id, nil
 }
 
 func createMeetings(t *testing.T) {
-	require.Empty(t, client.PostUser("bob"))
-	require.Empty(t, client.PostUser("alice"))
-	meeting := service.Meeting{
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	meeting := calendar.Meeting{
 		Owner:       "bob",
-		Invited:     []service.Invitation{{Invitee: "alice"}},
+		Invited:     []calendar.Invitation{{Invitee: "alice"}},
 		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
 		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
 		Reoccurance: 1,
+		Description: "planning",
 	}
-	_, err := client.PostMeeting(meeting)
+	_, err := addMeeting(meeting)
 	require.Empty(t, err)
 	meeting.StartTime = parseTimeNoError(t, "2023-03-07T17:00:00.000Z")
 	meeting.EndTime = parseTimeNoError(t, "2023-03-07T17:30:00.000Z")
 	meeting.Reoccurance = 0
-	_, err = client.PostMeeting(meeting)
+	_, err = addMeeting(meeting)
 	require.Empty(t, err)
 	meeting.StartTime = parseTimeNoError(t, "2023-03-07T20:00:00.000Z")
 	meeting.EndTime = parseTimeNoError(t, "2023-03-07T20:30:00.000Z")
 	meeting.Reoccurance = 0
-	_, err = client.PostMeeting(meeting)
+	_, err = addMeeting(meeting)
 	require.Empty(t, err)
 }
 
 func TestListMeetings(t *testing.T) {
 	cleanup(t)
 	createMeetings(t)
-	meetings, err := client.ListMeetings("alice", "2023-03-07T16:00:00.000Z", "2023-03-07T19:00:00.000Z")
+	meetings, err := client.ListMeetings(ctx, "alice", parseTimeNoError(t, "2023-03-07T16:00:00.000Z"), parseTimeNoError(t, "2023-03-07T19:00:00.000Z"))
+	require.Empty(t, err)
+	require.Equal(t, 2, len(meetings))
+	// the first daily meeting is under way, and listed once
+	meetings, err = client.ListMeetings(ctx, "alice", parseTimeNoError(t, "2023-03-07T16:30:00.000Z"), parseTimeNoError(t, "2023-03-07T19:00:00.000Z"))
 	require.Empty(t, err)
 	require.Equal(t, 2, len(meetings))
-	meetings, err = client.ListMeetings("bob", "2023-03-08T16:00:00.000Z", "2023-03-08T16:30:00.000Z")
+	require.Equal(t, parseTimeNoError(t, "2023-03-07T16:20:00Z"), meetings[0].StartTime.UTC())
+	meetings, err = client.ListMeetings(ctx, "bob", parseTimeNoError(t, "2023-03-08T16:00:00.000Z"), parseTimeNoError(t, "2023-03-08T16:30:00.000Z"))
 	require.Empty(t, err)
 	require.Equal(t, 1, len(meetings))
-	meetings, err = client.ListMeetings("bob", "2023-03-08T15:00:00.000Z", "2023-03-08T16:00:00.000Z")
+	meetings, err = client.ListMeetings(ctx, "bob", parseTimeNoError(t, "2023-03-08T15:00:00.000Z"), parseTimeNoError(t, "2023-03-08T16:00:00.000Z"))
 	require.Empty(t, err)
 	require.Equal(t, 0, len(meetings))
 }
 
+func TestListMeetingsPages(t *testing.T) {
+	cleanup(t)
+	createMeetings(t)
+	// a second daily meeting, starting with the first one
+	standupId, err := addMeeting(calendar.Meeting{
+		Owner:       "alice",
+		Invited:     []calendar.Invitation{{Invitee: "bob"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:30:00.000Z"),
+		Reoccurance: 1,
+		Description: "Standup",
+	})
+	require.Empty(t, err)
+	_, err = client.AcceptMeeting(ctx, standupId, "bob" /* decline = */, true)
+	require.Empty(t, err)
+
+	start, end := parseTimeNoError(t, "2023-03-07T00:00:00.000Z"), parseTimeNoError(t, "2023-03-14T00:00:00.000Z")
+	all, err := client.ListMeetings(ctx, "bob", start, end)
+	require.Empty(t, err)
+	require.Equal(t, 16, len(all))
+	paged := []calendar.Meeting{}
+	cursor := ""
+	for pages := 1; ; pages++ {
+		page, err := client.ListMeetingsPage(ctx, "bob", start, end, calendar.MeetingQuery{Limit: 5}, cursor)
+		require.Empty(t, err)
+		paged = append(paged, page.Meetings...)
+		if cursor = page.Cursor; cursor == "" {
+			require.Equal(t, 4, pages)
+			break
+		}
+	}
+	// pages may end between meetings starting together
+	require.Equal(t, all, paged)
+
+	owned, err := client.FindMeetings(ctx, "bob", start, end, calendar.MeetingQuery{Role: "owner", Limit: 2})
+	require.Empty(t, err)
+	require.Equal(t, 9, len(owned))
+	declined, err := client.FindMeetings(ctx, "bob", start, end, calendar.MeetingQuery{Status: "declined", Text: "standup"})
+	require.Empty(t, err)
+	require.Equal(t, 7, len(declined))
+	require.Equal(t, "alice", declined[0].Owner)
+	_, err = client.ListMeetingsPage(ctx, "bob", start, end, calendar.MeetingQuery{}, "invalid")
+	require.ErrorIs(t, err, calendar.ErrBadRequest)
+}
+
 func TestFindSlot(t *testing.T) {
 	cleanup(t)
 	createMeetings(t)
-	slotStartTime, err := client.FindSlot([]string{"alice", "bob"}, "2023-03-08T16:00:00.000Z", 30)
+	slotStartTime, err := client.FindSlot(ctx, []string{"alice", "bob"}, parseTimeNoError(t, "2023-03-08T16:00:00.000Z"), 30*time.Minute)
 	require.Empty(t, err)
-	require.Equal(t, "2023-03-08T16:40:00Z", slotStartTime)
-	slotStartTime, err = client.FindSlot([]string{"alice", "bob"}, "2023-03-07T15:50:00.000Z", 30)
+	require.Equal(t, parseTimeNoError(t, "2023-03-08T16:40:00Z"), slotStartTime.UTC())
+	slotStartTime, err = client.FindSlot(ctx, []string{"alice", "bob"}, parseTimeNoError(t, "2023-03-07T15:50:00.000Z"), 30*time.Minute)
 	require.Empty(t, err)
-	require.Equal(t, "2023-03-07T15:50:00Z", slotStartTime)
-	slotStartTime, err = client.FindSlot([]string{"alice", "bob"}, "2023-03-07T15:51:00.000Z", 30)
+	require.Equal(t, parseTimeNoError(t, "2023-03-07T15:50:00Z"), slotStartTime.UTC())
+	slotStartTime, err = client.FindSlot(ctx, []string{"alice", "bob"}, parseTimeNoError(t, "2023-03-07T15:51:00.000Z"), 30*time.Minute)
 	require.Empty(t, err)
-	require.Equal(t, "2023-03-07T17:30:00Z", slotStartTime)
+	require.Equal(t, parseTimeNoError(t, "2023-03-07T17:30:00Z"), slotStartTime.UTC())
 }
 
 func TestAcceptMeeting(t *testing.T) {
 	cleanup(t)
-	require.Empty(t, client.PostUser("bob"))
-	require.Empty(t, client.PostUser("alice"))
-	require.Empty(t, client.PostUser("carl"))
-	meeting := &service.Meeting{
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	require.Empty(t, addUser("carl"))
+	meeting := &calendar.Meeting{
 		Owner:       "bob",
-		Invited:     []service.Invitation{{Invitee: "alice"}, {Invitee: "carl"}},
+		Invited:     []calendar.Invitation{{Invitee: "alice"}, {Invitee: "carl"}},
 		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
 		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
 		Reoccurance: 1,
+		Description: "planning",
+	}
+	meetingId1, err := addMeeting(*meeting)
+	require.Empty(t, err)
+	meetingId2, err := addMeeting(*meeting)
+	require.Empty(t, err)
+	meeting, err = client.AcceptMeeting(ctx, meetingId1, "alice" /* decline = */, false)
+	require.Empty(t, err)
+	require.Equal(t, meeting.Invited[0].Accepted, calendar.Accepted)
+	require.Equal(t, meeting.Invited[1].Accepted, calendar.NotReviewed)
+	meeting, err = client.GetMeeting(ctx, meetingId2)
+	require.Empty(t, err)
+	require.Equal(t, meeting.Invited[0].Accepted, calendar.NotReviewed)
+	require.Equal(t, meeting.Invited[1].Accepted, calendar.NotReviewed)
+}
+
+func TestUpdateAndDeleteMeeting(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	require.Empty(t, addUser("carl"))
+	meeting := calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}, {Invitee: "carl"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "standup",
 	}
-	meetingId1, err := client.PostMeeting(*meeting)
+	meetingId, err := addMeeting(meeting)
+	require.Empty(t, err)
+	_, err = client.AcceptMeeting(ctx, meetingId, "alice" /* decline = */, false)
+	require.Empty(t, err)
+
+	// answers survive changes of the description, not of the time
+	meeting.Description = "planning"
+	updated, err := client.UpdateMeeting(ctx, meetingId, meeting)
+	require.Empty(t, err)
+	require.Equal(t, 1, updated.Sequence)
+	require.Equal(t, calendar.Accepted, updated.Invited[0].Accepted)
+	meeting.StartTime = parseTimeNoError(t, "2023-03-07T17:20:00.000Z")
+	meeting.EndTime = parseTimeNoError(t, "2023-03-07T17:40:00.000Z")
+	updated, err = client.UpdateMeeting(ctx, meetingId, meeting)
+	require.Empty(t, err)
+	require.Equal(t, 2, updated.Sequence)
+	require.Equal(t, calendar.NotReviewed, updated.Invited[0].Accepted)
+
+	require.Empty(t, client.DeleteMeeting(ctx, meetingId))
+	_, err = client.GetMeeting(ctx, meetingId)
+	require.ErrorIs(t, err, calendar.ErrNotFound)
+}
+
+func TestMeetingDetails(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	meeting := calendar.Meeting{
+		Owner:         "bob",
+		Invited:       []calendar.Invitation{{Invitee: "alice"}},
+		StartTime:     parseTimeNoError(t, "2023-03-07T16:00:00.000Z"),
+		EndTime:       parseTimeNoError(t, "2023-03-07T17:00:00.000Z"),
+		Title:         "Design review",
+		Location:      "Room 4",
+		ConferenceUrl: "https://meet.example.com/design",
+		Attachments:   []calendar.Attachment{{Name: "agenda.pdf", Url: "https://files.example.com/agenda.pdf", MimeType: "application/pdf"}},
+	}
+	meetingId, err := addMeeting(meeting)
+	require.Empty(t, err)
+	found, err := client.GetMeeting(ctx, meetingId)
+	require.Empty(t, err)
+	require.Equal(t, "Design review", found.Title)
+	require.Equal(t, "Room 4", found.Location)
+	require.Equal(t, meeting.ConferenceUrl, found.ConferenceUrl)
+	require.Equal(t, meeting.Attachments, found.Attachments)
+
+	meeting.Location = "Room 5"
+	meeting.Attachments = nil
+	updated, err := client.UpdateMeeting(ctx, meetingId, meeting)
+	require.Empty(t, err)
+	require.Equal(t, "Room 5", updated.Location)
+	require.Empty(t, updated.Attachments)
+	listed, err := client.FindMeetings(ctx, "alice", meeting.StartTime, meeting.EndTime, calendar.MeetingQuery{Text: "room 5"})
+	require.Empty(t, err)
+	require.Equal(t, 1, len(listed))
+	searched, err := client.SearchMeetings(ctx, calendar.SearchQuery{Text: "design"})
+	require.Empty(t, err)
+	require.Equal(t, 1, len(searched))
+
+	response, err := get("/api/users/alice/calendar.ics")
+	require.Empty(t, err)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	require.Empty(t, err)
+	require.Contains(t, string(body), "SUMMARY:Design review")
+	require.Contains(t, string(body), "LOCATION:Room 5")
+	require.Contains(t, string(body), "CONFERENCE;VALUE=URI;FEATURE=VIDEO:https://meet.example.com/design")
+
+	meeting.ConferenceUrl = "javascript:alert(1)"
+	_, err = client.UpdateMeeting(ctx, meetingId, meeting)
+	require.ErrorIs(t, err, calendar.ErrValidation)
+}
+
+func TestAttachments(t *testing.T) {
+	cleanup(t)
+	tokens := map[string]string{}
+	for _, login := range []string{"bob", "alice", "dave"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		tokens[login] = user.Token
+	}
+	meetingId, err := addMeeting(calendar.Meeting{
+		Owner:     "bob",
+		Invited:   []calendar.Invitation{{Invitee: "alice"}},
+		StartTime: parseTimeNoError(t, "2023-03-07T16:00:00.000Z"),
+		EndTime:   parseTimeNoError(t, "2023-03-07T17:00:00.000Z"),
+		Title:     "Design review",
+	})
+	require.Empty(t, err)
+
+	// the type is sniffed from the content
+	agenda := "%PDF-1.4\n% agenda"
+	created, err := client.UploadAttachment(ctx, meetingId, "agenda.pdf", "", strings.NewReader(agenda))
+	require.Empty(t, err)
+	attachment := *created
+	require.Equal(t, "application/pdf", attachment.MimeType)
+	require.Equal(t, int64(len(agenda)), attachment.Size)
+	require.Equal(t, "/api/meetings/"+meetingId+"/attachments/"+attachment.Id, attachment.Url)
+
+	// updates keep stored files
+	meeting, err := client.GetMeeting(ctx, meetingId)
+	require.Empty(t, err)
+	require.Equal(t, []calendar.Attachment{attachment}, meeting.Attachments)
+	meeting.Attachments = nil
+	meeting, err = client.UpdateMeeting(ctx, meetingId, *meeting)
+	require.Empty(t, err)
+	require.Equal(t, []calendar.Attachment{attachment}, meeting.Attachments)
+
+	response, err := get(attachment.Url)
+	require.Empty(t, err)
+	response.Body.Close()
+	require.Equal(t, "application/pdf", response.Header.Get("Content-Type"))
+	require.Equal(t, `attachment; filename=agenda.pdf`, response.Header.Get("Content-Disposition"))
+	download := func(token string) (string, error) {
+		c := calendar.New(url)
+		c.Token = token
+		body := &bytes.Buffer{}
+		err := c.DownloadAttachment(ctx, meetingId, attachment.Id, body)
+		return body.String(), err
+	}
+	body, err := download(tokens["alice"])
+	require.Empty(t, err)
+	require.Equal(t, agenda, body)
+	_, err = download(tokens["dave"])
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+
+	response, err = post("/api/meetings/"+meetingId+"/attachments?name=big.bin", "application/octet-stream", bytes.NewReader(make([]byte, 25<<20+1)))
+	require.Empty(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, response.StatusCode)
+
+	// concurrent uploads all end up attached
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			response, err := post(fmt.Sprintf("/api/meetings/%s/attachments?name=notes-%d.txt", meetingId, i), "text/plain", strings.NewReader("notes"))
+			require.Empty(t, err)
+			defer response.Body.Close()
+			require.Equal(t, http.StatusOK, response.StatusCode)
+		}(i)
+	}
+	wg.Wait()
+	meeting, err = client.GetMeeting(ctx, meetingId)
+	require.Empty(t, err)
+	require.Equal(t, 6, len(meeting.Attachments))
+
+	// files go with their meeting
+	files := dbClient.Database("db").Collection("attachments.files")
+	count, err := files.CountDocuments(ctx, bson.M{"metadata.meetingId": meetingId})
+	require.Empty(t, err)
+	require.Equal(t, int64(6), count)
+	require.Empty(t, client.DeleteMeeting(ctx, meetingId))
+	count, err = files.CountDocuments(ctx, bson.M{"metadata.meetingId": meetingId})
+	require.Empty(t, err)
+	require.Equal(t, int64(0), count)
+}
+
+func TestSyncMeetings(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	require.Empty(t, addUser("carl"))
+	meeting := calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "standup",
+	}
+	updatedId, err := addMeeting(meeting)
+	require.Empty(t, err)
+	deletedId, err := addMeeting(meeting)
+	require.Empty(t, err)
+	// tokens only move past changes older than a few seconds
+	time.Sleep(6 * time.Second)
+
+	result, err := client.SyncMeetings(ctx, "alice", "")
+	require.Empty(t, err)
+	require.Equal(t, 2, len(result.Created))
+	require.False(t, result.More)
+
+	meeting.Description = "planning"
+	_, err = client.UpdateMeeting(ctx, updatedId, meeting)
+	require.Empty(t, err)
+	require.Empty(t, client.DeleteMeeting(ctx, deletedId))
+	createdId, err := addMeeting(meeting)
+	require.Empty(t, err)
+	time.Sleep(6 * time.Second)
+
+	changes, err := client.SyncMeetings(ctx, "alice", result.SyncToken)
+	require.Empty(t, err)
+	require.Equal(t, 1, len(changes.Created))
+	require.Equal(t, createdId, changes.Created[0].Id)
+	require.Equal(t, 1, len(changes.Updated))
+	require.Equal(t, "planning", changes.Updated[0].Description)
+	require.Equal(t, []string{deletedId}, changes.Deleted)
+
+	// carl takes no part in any of it
+	result, err = client.SyncMeetings(ctx, "carl", result.SyncToken)
+	require.Empty(t, err)
+	require.Empty(t, result.Created)
+	require.Empty(t, result.Updated)
+	require.Empty(t, result.Deleted)
+
+	changes, err = client.SyncMeetings(ctx, "alice", changes.SyncToken)
+	require.Empty(t, err)
+	require.Empty(t, changes.Created)
+	require.Empty(t, changes.Updated)
+	require.Empty(t, changes.Deleted)
+
+	// pages of changes that are yet to settle don't ask for more
+	for i := 0; i < 2; i++ {
+		_, err = addMeeting(meeting)
+		require.Empty(t, err)
+	}
+	response, err := get("/api/users/alice/sync?limit=1&token=" + changes.SyncToken)
+	require.Empty(t, err)
+	defer response.Body.Close()
+	page := calendar.SyncResult{}
+	require.Empty(t, json.NewDecoder(response.Body).Decode(&page))
+	require.Equal(t, 1, len(page.Created))
+	require.False(t, page.More)
+	require.Equal(t, changes.SyncToken, page.SyncToken)
+
+	_, err = client.SyncMeetings(ctx, "alice", "garbage")
+	require.ErrorIs(t, err, calendar.ErrBadRequest)
+}
+
+func TestItipReply(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	alice, err := client.AddUser(ctx, "alice")
+	require.Empty(t, err)
+	require.Empty(t, addUser("carl"))
+	meetingId, err := addMeeting(calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}, {Invitee: "carl"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	reply := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"METHOD:REPLY",
+		"BEGIN:VEVENT",
+		"UID:" + meetingId + "@calendar",
+		"DTSTAMP:20230306T120000Z",
+		"ATTENDEE;PARTSTAT=DECLINED:mailto:alice@calendar.local",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+	response, err := post("/api/itip", "text/calendar", strings.NewReader(reply))
+	require.Empty(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	meeting, err := client.GetMeeting(ctx, meetingId)
+	require.Empty(t, err)
+	require.Equal(t, calendar.Declined, meeting.Invited[0].Accepted)
+
+	// a reply for someone else is refused as a whole
+	reply = strings.Replace(reply, "ATTENDEE;PARTSTAT=DECLINED:mailto:alice@calendar.local",
+		"ATTENDEE;PARTSTAT=ACCEPTED:mailto:alice@calendar.local\r\nATTENDEE;PARTSTAT=ACCEPTED:mailto:carl@calendar.local", 1)
+	request, err := http.NewRequest("POST", url+"/api/itip", strings.NewReader(reply))
+	require.Empty(t, err)
+	request.Header.Set("Content-Type", "text/calendar")
+	request.Header.Set("Authorization", "Bearer "+alice.Token)
+	response, err = http.DefaultClient.Do(request)
+	require.Empty(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusForbidden, response.StatusCode)
+	meeting, err = client.GetMeeting(ctx, meetingId)
+	require.Empty(t, err)
+	require.Equal(t, calendar.Declined, meeting.Invited[0].Accepted)
+	require.Equal(t, calendar.NotReviewed, meeting.Invited[1].Accepted)
+}
+
+func TestAuthentication(t *testing.T) {
+	cleanup(t)
+	anonymous := calendar.New(url)
+	// signup is closed unless CALENDAR_OPEN_SIGNUP is set
+	_, err := anonymous.AddUser(ctx, "bob")
+	require.ErrorIs(t, err, calendar.ErrUnauthorized)
+	bobUser, err := client.AddUser(ctx, "bob")
+	require.Empty(t, err)
+	require.NotEmpty(t, bobUser.Token)
+	aliceUser, err := client.AddUser(ctx, "alice")
+	require.Empty(t, err)
+	bob, alice := calendar.New(url), calendar.New(url)
+	bob.Token, alice.Token = bobUser.Token, aliceUser.Token
+	_, err = bob.AddUser(ctx, "carl")
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+	// only streams take the token in the query
+	response, err := http.Get(url + "/api/users/bob/calendar.ics?access_token=" + bobUser.Token)
+	require.Empty(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	streamCtx, cancel := context.WithCancel(ctx)
+	request, err := http.NewRequestWithContext(streamCtx, "GET", url+"/api/users/bob/stream?access_token="+bobUser.Token, nil)
+	require.Empty(t, err)
+	response, err = http.DefaultClient.Do(request)
+	require.Empty(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	cancel()
+	response.Body.Close()
+
+	meeting := calendar.Meeting{
+		Owner:       "alice",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "planning",
+	}
+	_, err = anonymous.AddMeeting(ctx, meeting)
+	require.ErrorIs(t, err, calendar.ErrUnauthorized)
+	// users organize as themselves, whatever they declare
+	created, err := bob.AddMeeting(ctx, meeting)
+	require.Empty(t, err)
+	require.Equal(t, "bob", created.Owner)
+	_, err = bob.AcceptMeeting(ctx, created.Id, "alice" /* decline = */, true)
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+	accepted, err := alice.AcceptMeeting(ctx, created.Id, "bob" /* decline = */, false)
+	require.Empty(t, err)
+	require.Equal(t, calendar.Accepted, accepted.Invited[0].Accepted)
+	require.ErrorIs(t, alice.DeleteMeeting(ctx, created.Id), calendar.ErrForbidden)
+	_, err = alice.CreateToken(ctx, "bob", "laptop")
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+
+	// tokens can be revoked
+	token, err := alice.CreateToken(ctx, "alice", "laptop")
+	require.Empty(t, err)
+	laptop := calendar.New(url)
+	laptop.Token = token.Token
+	_, err = laptop.GetMeeting(ctx, created.Id)
+	require.Empty(t, err)
+	listed, err := alice.ListTokens(ctx, "alice")
+	require.Empty(t, err)
+	require.Equal(t, "laptop", listed[len(listed)-1].Name)
+	require.Empty(t, listed[len(listed)-1].Token)
+	require.Empty(t, alice.DeleteToken(ctx, "alice", token.Id))
+	_, err = laptop.GetMeeting(ctx, created.Id)
+	require.ErrorIs(t, err, calendar.ErrUnauthorized)
+
+	session, err := alice.CreateSession(ctx)
+	if errors.Is(err, calendar.ErrNotFound) {
+		t.Skip("CALENDAR_SESSION_SECRET is not set")
+	}
+	require.Empty(t, err)
+	browser := calendar.New(url)
+	browser.Token = session.Token
+	_, err = browser.GetMeeting(ctx, created.Id)
+	require.Empty(t, err)
+
+	// sessions end with their user, a new alice doesn't get them
+	require.Empty(t, client.DeleteUser(ctx, "alice", ""))
+	_, err = browser.GetMeeting(ctx, created.Id)
+	require.ErrorIs(t, err, calendar.ErrUnauthorized)
+	require.Empty(t, addUser("alice"))
+	_, err = browser.GetMeeting(ctx, created.Id)
+	require.ErrorIs(t, err, calendar.ErrUnauthorized)
+}
+
+func TestAuthorizationRules(t *testing.T) {
+	cleanup(t)
+	users := map[string]*calendar.Client{}
+	for _, login := range []string{"bob", "alice", "carol", "dave"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		users[login] = calendar.New(url)
+		users[login].Token = user.Token
+	}
+	bob, alice, carol, dave := users["bob"], users["alice"], users["carol"], users["dave"]
+	_, err := bob.SetShares(ctx, "bob", []calendar.Share{{Grantee: "carol", Level: calendar.ShareEdit}})
+	require.Empty(t, err)
+	_, err = carol.SetShares(ctx, "bob", []calendar.Share{{Grantee: "dave", Level: calendar.ShareEdit}})
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+
+	// editors organize for the owner
+	meeting := calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "1:1",
+	}
+	created, err := carol.AddMeeting(ctx, meeting)
+	require.Empty(t, err)
+	require.Equal(t, "bob", created.Owner)
+	created, err = dave.AddMeeting(ctx, meeting)
+	require.Empty(t, err)
+	require.Equal(t, "dave", created.Owner)
+	require.Empty(t, dave.DeleteMeeting(ctx, created.Id))
+	created, err = carol.AddMeeting(ctx, meeting)
+	require.Empty(t, err)
+
+	// participants and editors see the details, others when it is busy
+	for _, participant := range []*calendar.Client{bob, alice, carol} {
+		found, err := participant.GetMeeting(ctx, created.Id)
+		require.Empty(t, err)
+		require.False(t, found.Busy)
+		require.Equal(t, "1:1", found.Description)
+	}
+	found, err := dave.GetMeeting(ctx, created.Id)
+	require.Empty(t, err)
+	require.True(t, found.Busy)
+	require.Empty(t, found.Description)
+	require.Empty(t, found.Owner)
+	require.Equal(t, created.StartTime, found.StartTime)
+	_, err = dave.ListMeetings(ctx, "alice", created.StartTime, created.EndTime)
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+	_, err = dave.FindSlot(ctx, []string{"dave", "alice"}, created.StartTime, 30*time.Minute)
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+	_, err = alice.SetShares(ctx, "alice", []calendar.Share{{Grantee: "dave", Level: calendar.ShareFreeBusy}})
+	require.Empty(t, err)
+	listed, err := dave.ListMeetings(ctx, "alice", created.StartTime, created.EndTime)
+	require.Empty(t, err)
+	require.Equal(t, 2, len(listed))
+	for _, meeting := range listed {
+		require.True(t, meeting.Busy)
+	}
+	slot, err := dave.FindSlot(ctx, []string{"dave", "alice"}, created.StartTime, 30*time.Minute)
+	require.Empty(t, err)
+	require.Equal(t, created.EndTime, slot.UTC())
+
+	// only invitees answer, for themselves
+	for _, other := range []*calendar.Client{bob, carol, dave} {
+		_, err = other.AcceptMeeting(ctx, created.Id, "alice" /* decline = */, false)
+		require.ErrorIs(t, err, calendar.ErrForbidden)
+	}
+	_, err = alice.AcceptMeeting(ctx, created.Id, "alice" /* decline = */, false)
+	require.Empty(t, err)
+
+	// the owner and editors edit and delete
+	created.Description = "weekly 1:1"
+	for _, other := range []*calendar.Client{alice, dave} {
+		_, err = other.UpdateMeeting(ctx, created.Id, *created)
+		require.ErrorIs(t, err, calendar.ErrForbidden)
+		require.ErrorIs(t, other.DeleteMeeting(ctx, created.Id), calendar.ErrForbidden)
+	}
+	updated, err := carol.UpdateMeeting(ctx, created.Id, *created)
+	require.Empty(t, err)
+	require.Equal(t, "bob", updated.Owner)
+	require.Equal(t, "weekly 1:1", updated.Description)
+	require.Empty(t, carol.DeleteMeeting(ctx, created.Id))
+}
+
+func TestVisibility(t *testing.T) {
+	cleanup(t)
+	tokens := map[string]string{}
+	for _, login := range []string{"bob", "alice", "dave"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		tokens[login] = user.Token
+	}
+	alice, dave := calendar.New(url), calendar.New(url)
+	alice.Token, dave.Token = tokens["alice"], tokens["dave"]
+	_, err := alice.SetShares(ctx, "alice", []calendar.Share{{Grantee: "dave", Level: calendar.ShareFreeBusy}})
+	require.Empty(t, err)
+	meetings := map[calendar.Visibility]*calendar.Meeting{}
+	start := parseTimeNoError(t, "2023-03-07T10:00:00.000Z")
+	for i, visibility := range []calendar.Visibility{calendar.VisibilityPublic, calendar.VisibilityPrivate, calendar.VisibilityConfidential} {
+		created, err := alice.AddMeeting(ctx, calendar.Meeting{
+			Invited:     []calendar.Invitation{{Invitee: "bob"}},
+			StartTime:   start.Add(time.Duration(i) * time.Hour),
+			EndTime:     start.Add(time.Duration(i)*time.Hour + 30*time.Minute),
+			Description: string(visibility) + " meeting",
+			Visibility:  visibility,
+		})
+		require.Empty(t, err)
+		meetings[visibility] = created
+	}
+
+	listed, err := dave.ListMeetings(ctx, "alice", start, start.Add(3*time.Hour))
+	require.Empty(t, err)
+	require.Equal(t, 3, len(listed))
+	require.False(t, listed[0].Busy)
+	require.Equal(t, "public meeting", listed[0].Description)
+	for _, meeting := range listed[1:] {
+		require.True(t, meeting.Busy)
+		require.Empty(t, meeting.Description)
+	}
+	found, err := dave.GetMeeting(ctx, meetings[calendar.VisibilityConfidential].Id)
+	require.Empty(t, err)
+	require.True(t, found.Busy)
+	require.Equal(t, calendar.VisibilityConfidential, found.Visibility)
+
+	// placeholders in exports, the details for participants
+	export := func(token, login string) (string, error) {
+		c := calendar.New(url)
+		c.Token = token
+		ics := &strings.Builder{}
+		err := c.ExportCalendar(ctx, login, ics)
+		return ics.String(), err
+	}
+	ics, err := export(tokens["dave"], "alice")
+	require.Empty(t, err)
+	require.Contains(t, ics, "SUMMARY:public meeting")
+	require.Contains(t, ics, "CLASS:CONFIDENTIAL")
+	require.Equal(t, 2, strings.Count(ics, "SUMMARY:Busy"))
+	require.NotContains(t, ics, "private meeting")
+	ics, err = export(tokens["bob"], "bob")
+	require.Empty(t, err)
+	require.Contains(t, ics, "SUMMARY:confidential meeting")
+	// calendars are only exported to users they are shared with
+	_, err = export(tokens["bob"], "alice")
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+
+	// hidden meetings still take the time
+	slot, err := dave.FindSlot(ctx, []string{"alice"}, start, time.Hour)
+	require.Empty(t, err)
+	require.Equal(t, start.Add(2*time.Hour+30*time.Minute), slot.UTC())
+}
+
+func TestSearchMeetings(t *testing.T) {
+	cleanup(t)
+	tokens := map[string]string{}
+	for _, login := range []string{"bob", "alice", "dave"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		tokens[login] = user.Token
+	}
+	alice, dave := calendar.New(url), calendar.New(url)
+	alice.Token, dave.Token = tokens["alice"], tokens["dave"]
+	for i, meeting := range []calendar.Meeting{
+		{Description: "Design review\nof the api design", Visibility: calendar.VisibilityPrivate, Invited: []calendar.Invitation{{Invitee: "alice"}}},
+		{Description: "Design sync", Visibility: calendar.VisibilityPublic},
+		{Description: "Budget review", Visibility: calendar.VisibilityPublic, Invited: []calendar.Invitation{{Invitee: "dave"}}},
+	} {
+		meeting.Owner = "bob"
+		meeting.StartTime = parseTimeNoError(t, "2023-02-07T10:00:00.000Z").AddDate(0, i, 0)
+		meeting.EndTime = meeting.StartTime.Add(time.Hour)
+		_, err := client.AddMeeting(ctx, meeting)
+		require.Empty(t, err)
+	}
+	descriptions := func(meetings []calendar.Meeting) []string {
+		result := []string{}
+		for _, meeting := range meetings {
+			result = append(result, meeting.Description)
+		}
+		return result
+	}
+
+	// ranked by relevance, and only what the caller may see
+	found, err := alice.SearchMeetings(ctx, calendar.SearchQuery{Text: "design"})
+	require.Empty(t, err)
+	require.Equal(t, []string{"Design review\nof the api design", "Design sync"}, descriptions(found))
+	found, err = dave.SearchMeetings(ctx, calendar.SearchQuery{Text: "design"})
+	require.Empty(t, err)
+	require.Equal(t, []string{"Design sync"}, descriptions(found))
+
+	found, err = alice.SearchMeetings(ctx, calendar.SearchQuery{Text: "review", Participants: []string{"alice"}})
+	require.Empty(t, err)
+	require.Equal(t, []string{"Design review\nof the api design"}, descriptions(found))
+	found, err = dave.SearchMeetings(ctx, calendar.SearchQuery{
+		Text: "review",
+		From: parseTimeNoError(t, "2023-03-01T00:00:00.000Z"),
+		To:   parseTimeNoError(t, "2023-05-01T00:00:00.000Z"),
+	})
+	require.Empty(t, err)
+	require.Equal(t, []string{"Budget review"}, descriptions(found))
+	_, err = dave.SearchMeetings(ctx, calendar.SearchQuery{})
+	require.ErrorIs(t, err, calendar.ErrValidation)
+}
+
+func TestSharing(t *testing.T) {
+	cleanup(t)
+	users := map[string]*calendar.Client{}
+	for _, login := range []string{"bob", "alice", "carol", "erin"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		users[login] = calendar.New(url)
+		users[login].Token = user.Token
+	}
+	bob, alice, carol, erin := users["bob"], users["alice"], users["carol"], users["erin"]
+	// carol assists bob, erin follows his calendar
+	_, err := bob.SetShares(ctx, "bob", []calendar.Share{
+		{Grantee: "carol", Level: calendar.ShareRespond},
+		{Grantee: "erin", Level: calendar.ShareDetails},
+	})
+	require.Empty(t, err)
+
+	start := parseTimeNoError(t, "2023-03-07T10:00:00.000Z")
+	invitation, err := alice.AddMeeting(ctx, calendar.Meeting{
+		Invited:     []calendar.Invitation{{Invitee: "bob"}},
+		StartTime:   start,
+		EndTime:     start.Add(30 * time.Minute),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	answered, err := carol.AcceptMeeting(ctx, invitation.Id, "bob" /* decline = */, false)
+	require.Empty(t, err)
+	require.Equal(t, calendar.Accepted, answered.Invited[0].Accepted)
+	require.Equal(t, "carol", answered.Invited[0].RespondedBy)
+	_, err = erin.AcceptMeeting(ctx, invitation.Id, "bob" /* decline = */, true)
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+
+	organized, err := carol.AddMeeting(ctx, calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}},
+		StartTime:   start.Add(time.Hour),
+		EndTime:     start.Add(90 * time.Minute),
+		Description: "1:1",
+	})
+	require.Empty(t, err)
+	require.Equal(t, "bob", organized.Owner)
+	require.Equal(t, "carol", organized.UpdatedBy)
+	confidential, err := bob.AddMeeting(ctx, calendar.Meeting{
+		StartTime:   start.Add(2 * time.Hour),
+		EndTime:     start.Add(150 * time.Minute),
+		Description: "doctor",
+		Visibility:  calendar.VisibilityConfidential,
+	})
+	require.Empty(t, err)
+	require.ErrorIs(t, carol.DeleteMeeting(ctx, confidential.Id), calendar.ErrForbidden)
+
+	listed, err := erin.ListMeetings(ctx, "bob", start, start.Add(3*time.Hour))
+	require.Empty(t, err)
+	require.Equal(t, 3, len(listed))
+	require.Equal(t, "planning", listed[0].Description)
+	require.Equal(t, "1:1", listed[1].Description)
+	require.True(t, listed[2].Busy)
+	_, err = erin.UpdateMeeting(ctx, organized.Id, *organized)
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+	_, err = erin.ListMeetings(ctx, "alice", start, start.Add(3*time.Hour))
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+}
+
+func TestCalendars(t *testing.T) {
+	cleanup(t)
+	users := map[string]*calendar.Client{}
+	for _, login := range []string{"bob", "alice"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		users[login] = calendar.New(url)
+		users[login].Token = user.Token
+	}
+	bob, alice := users["bob"], users["alice"]
+	personal, err := bob.CreateCalendar(ctx, "bob", calendar.Calendar{Name: "Personal", Color: "#ff8800", Visibility: calendar.VisibilityPublic})
+	require.Empty(t, err)
+	_, err = bob.CreateCalendar(ctx, "bob", calendar.Calendar{Name: "Work", Color: "orange"})
+	require.ErrorIs(t, err, calendar.ErrValidation)
+	calendars, err := bob.ListCalendars(ctx, "bob")
+	require.Empty(t, err)
+	require.Equal(t, 2, len(calendars))
+	require.Equal(t, "default", calendars[0].Id)
+	require.Equal(t, personal.Id, calendars[1].Id)
+
+	start := parseTimeNoError(t, "2023-03-07T10:00:00.000Z")
+	gym, err := bob.AddMeeting(ctx, calendar.Meeting{
+		StartTime:   start,
+		EndTime:     start.Add(time.Hour),
+		Description: "gym",
+		CalendarId:  personal.Id,
+	})
+	require.Empty(t, err)
+	_, err = alice.AddMeeting(ctx, calendar.Meeting{StartTime: start, EndTime: start.Add(time.Hour), CalendarId: personal.Id, Description: "planning"})
+	require.ErrorIs(t, err, calendar.ErrValidation)
+	// the meeting is public with its calendar
+	seen, err := alice.GetMeeting(ctx, gym.Id)
+	require.Empty(t, err)
+	require.Equal(t, "gym", seen.Description)
+
+	work := calendar.CalendarFilter{Exclude: []string{personal.Id}}
+	slot, err := bob.FindSlotIn(ctx, []string{"bob"}, start, 30*time.Minute, work)
+	require.Empty(t, err)
+	require.Equal(t, start, slot.UTC())
+	slot, err = bob.FindSlot(ctx, []string{"bob"}, start, 30*time.Minute)
+	require.Empty(t, err)
+	require.Equal(t, start.Add(time.Hour), slot.UTC())
+
+	invitation, err := alice.AddMeeting(ctx, calendar.Meeting{
+		Invited:     []calendar.Invitation{{Invitee: "bob"}},
+		StartTime:   start.Add(2 * time.Hour),
+		EndTime:     start.Add(3 * time.Hour),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	answered, err := bob.AcceptMeetingIn(ctx, invitation.Id, "", personal.Id /* decline = */, false)
+	require.Empty(t, err)
+	require.Equal(t, personal.Id, answered.Invited[0].CalendarId)
+	listed, err := bob.ListMeetingsIn(ctx, "bob", start, start.Add(3*time.Hour), calendar.CalendarFilter{Calendars: []string{personal.Id}})
+	require.Empty(t, err)
+	require.Equal(t, 2, len(listed))
+
+	before, err := bob.GetMeeting(ctx, gym.Id)
+	require.Empty(t, err)
+	updated, err := bob.UpdateCalendar(ctx, "bob", personal.Id, calendar.Calendar{Name: "Personal", Visibility: calendar.VisibilityPrivate})
+	require.Empty(t, err)
+	require.Equal(t, calendar.VisibilityPrivate, updated.Visibility)
+	seen, err = alice.GetMeeting(ctx, gym.Id)
+	require.Empty(t, err)
+	require.True(t, seen.Busy)
+	// the meetings of the calendar sync again
+	after, err := bob.GetMeeting(ctx, gym.Id)
+	require.Empty(t, err)
+	require.Greater(t, after.Version, before.Version)
+	_, err = alice.UpdateCalendar(ctx, "bob", personal.Id, calendar.Calendar{Name: "Mine"})
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+
+	require.Empty(t, bob.DeleteCalendar(ctx, "bob", personal.Id))
+	moved, err := bob.GetMeeting(ctx, gym.Id)
+	require.Empty(t, err)
+	require.Empty(t, moved.CalendarId)
+	moved, err = bob.GetMeeting(ctx, invitation.Id)
+	require.Empty(t, err)
+	require.Empty(t, moved.Invited[0].CalendarId)
+	require.ErrorIs(t, bob.DeleteCalendar(ctx, "bob", "default"), calendar.ErrValidation)
+}
+
+func TestGroups(t *testing.T) {
+	cleanup(t)
+	users := map[string]*calendar.Client{}
+	for _, login := range []string{"bob", "alice", "carol", "dave"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		users[login] = calendar.New(url)
+		users[login].Token = user.Token
+	}
+	bob, alice := users["bob"], users["alice"]
+	for _, login := range []string{"alice", "dave"} {
+		_, err := users[login].SetShares(ctx, login, []calendar.Share{{Grantee: "bob", Level: calendar.ShareFreeBusy}})
+		require.Empty(t, err)
+	}
+	_, err := bob.CreateGroup(ctx, calendar.Group{Name: "backend", Members: []string{"alice", "bob"}})
+	require.Empty(t, err)
+	_, err = bob.CreateGroup(ctx, calendar.Group{Name: "engineering", Members: []string{"carol"}, Groups: []string{"backend"}})
+	require.Empty(t, err)
+	_, err = bob.UpdateGroup(ctx, "backend", calendar.Group{Members: []string{"alice"}, Groups: []string{"engineering"}})
+	require.ErrorIs(t, err, calendar.ErrValidation)
+	_, err = alice.UpdateGroup(ctx, "backend", calendar.Group{Members: []string{"alice"}})
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+
+	start := parseTimeNoError(t, "2023-03-07T10:00:00.000Z")
+	// a snapshot of the team, and a standup following it
+	review, err := bob.AddMeeting(ctx, calendar.Meeting{
+		Invited:     []calendar.Invitation{{Invitee: "team:engineering"}},
+		StartTime:   start,
+		EndTime:     start.Add(time.Hour),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	require.Equal(t, []string{"carol", "alice"}, invitees(review))
+	standup, err := bob.AddMeeting(ctx, calendar.Meeting{
+		Groups:      []string{"engineering"},
+		StartTime:   start.Add(2 * time.Hour),
+		EndTime:     start.Add(150 * time.Minute),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	require.Equal(t, []string{"carol", "alice"}, invitees(standup))
+	_, err = alice.AcceptMeeting(ctx, standup.Id, "" /* decline = */, false)
+	require.Empty(t, err)
+
+	_, err = bob.UpdateGroup(ctx, "backend", calendar.Group{Members: []string{"alice", "bob", "dave"}})
+	require.Empty(t, err)
+	standup, err = bob.GetMeeting(ctx, standup.Id)
+	require.Empty(t, err)
+	require.Equal(t, []string{"carol", "alice", "dave"}, invitees(standup))
+	require.Equal(t, calendar.Accepted, standup.Invited[1].Accepted)
+	require.Equal(t, "engineering", standup.Invited[2].Group)
+	review, err = bob.GetMeeting(ctx, review.Id)
+	require.Empty(t, err)
+	require.Equal(t, []string{"carol", "alice"}, invitees(review))
+
+	slot, err := bob.FindSlot(ctx, []string{"team:backend"}, start, 30*time.Minute)
+	require.Empty(t, err)
+	require.Equal(t, start.Add(time.Hour), slot.UTC())
+	_, err = bob.FindSlot(ctx, []string{"team:frontend"}, start, 30*time.Minute)
+	require.ErrorIs(t, err, calendar.ErrValidation)
+
+	require.ErrorIs(t, bob.DeleteGroup(ctx, "backend"), calendar.ErrConflict)
+	require.Empty(t, bob.DeleteGroup(ctx, "engineering"))
+	standup, err = bob.GetMeeting(ctx, standup.Id)
+	require.Empty(t, err)
+	require.Empty(t, standup.Groups)
+	require.Equal(t, []string{"carol", "alice", "dave"}, invitees(standup))
+}
+
+func invitees(meeting *calendar.Meeting) []string {
+	logins := []string{}
+	for _, invitation := range meeting.Invited {
+		logins = append(logins, invitation.Invitee)
+	}
+	return logins
+}
+
+func TestUserManagement(t *testing.T) {
+	cleanup(t)
+	users := map[string]*calendar.Client{}
+	for _, login := range []string{"bob", "alice", "carol"} {
+		user, err := client.AddUser(ctx, login)
+		require.Empty(t, err)
+		users[login] = calendar.New(url)
+		users[login].Token = user.Token
+	}
+	bob, alice, carol := users["bob"], users["alice"], users["carol"]
+	name, email, zone := "Bob Smith", "bob@example.com", "Europe/Berlin"
+	updated, err := bob.UpdateUser(ctx, "bob", calendar.UserUpdate{DisplayName: &name, Email: &email, TimeZone: &zone})
+	require.Empty(t, err)
+	require.Equal(t, "Bob Smith", updated.DisplayName)
+	invalid := "Mars/Olympus"
+	_, err = bob.UpdateUser(ctx, "bob", calendar.UserUpdate{TimeZone: &invalid})
+	require.ErrorIs(t, err, calendar.ErrValidation)
+	_, err = alice.UpdateUser(ctx, "bob", calendar.UserUpdate{DisplayName: &name})
+	require.ErrorIs(t, err, calendar.ErrForbidden)
+	profile, err := alice.GetUser(ctx, "bob")
+	require.Empty(t, err)
+	require.Equal(t, "Bob Smith", profile.DisplayName)
+	require.Empty(t, profile.Email)
+	listed, err := alice.ListUsers(ctx)
+	require.Empty(t, err)
+	require.Equal(t, 3, len(listed))
+
+	start := parseTimeNoError(t, "2023-03-07T10:00:00.000Z")
+	planning, err := bob.AddMeeting(ctx, calendar.Meeting{
+		Invited:     []calendar.Invitation{{Invitee: "alice"}, {Invitee: "carol"}},
+		StartTime:   start,
+		EndTime:     start.Add(time.Hour),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	review, err := alice.AddMeeting(ctx, calendar.Meeting{
+		Invited:     []calendar.Invitation{{Invitee: "bob"}},
+		StartTime:   start.Add(2 * time.Hour),
+		EndTime:     start.Add(3 * time.Hour),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	_, err = alice.SetShares(ctx, "alice", []calendar.Share{{Grantee: "bob", Level: calendar.ShareDetails}})
+	require.Empty(t, err)
+
+	require.ErrorIs(t, alice.DeleteUser(ctx, "bob", ""), calendar.ErrForbidden)
+	require.ErrorIs(t, bob.DeleteUser(ctx, "bob", "dave"), calendar.ErrValidation)
+	require.Empty(t, bob.DeleteUser(ctx, "bob", "carol"))
+	_, err = alice.GetUser(ctx, "bob")
+	require.ErrorIs(t, err, calendar.ErrNotFound)
+	_, err = bob.GetUser(ctx, "bob")
+	require.ErrorIs(t, err, calendar.ErrUnauthorized)
+	transferred, err := carol.GetMeeting(ctx, planning.Id)
+	require.Empty(t, err)
+	require.Equal(t, "carol", transferred.Owner)
+	require.Equal(t, []string{"alice"}, invitees(transferred))
+	review, err = alice.GetMeeting(ctx, review.Id)
+	require.Empty(t, err)
+	require.Empty(t, review.Invited)
+	user, err := alice.GetUser(ctx, "alice")
+	require.Empty(t, err)
+	require.Empty(t, user.Shares)
+
+	require.Empty(t, carol.DeleteUser(ctx, "carol", ""))
+	_, err = alice.GetMeeting(ctx, planning.Id)
+	require.ErrorIs(t, err, calendar.ErrNotFound)
+}
+
+func TestErrorResponses(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	err := addUser("bob")
+	require.ErrorIs(t, err, calendar.ErrConflict)
+
+	response, err := get("/api/meetings/640a4862377457548608f50a")
+	require.Empty(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+	require.Equal(t, "application/json", response.Header.Get("Content-Type"))
+	apiErr := service.ApiError{}
+	require.Empty(t, json.NewDecoder(response.Body).Decode(&apiErr))
+	require.Equal(t, service.CodeNotFound, apiErr.Code)
+	require.NotEmpty(t, apiErr.RequestId)
+	require.Equal(t, response.Header.Get("X-Request-Id"), apiErr.RequestId)
+
+	_, err = addMeeting(calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "nobody"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "planning",
+	})
+	require.ErrorIs(t, err, calendar.ErrValidation)
+	_, err = client.GetMeeting(ctx, "640a4862377457548608f50a")
+	require.ErrorIs(t, err, calendar.ErrNotFound)
+
+	// every problem of a request is reported at once
+	validationErrors := func(path, body string) []service.ErrorDetail {
+		response, err := post(path, "application/json", strings.NewReader(body))
+		require.Empty(t, err)
+		defer response.Body.Close()
+		require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+		apiErr := service.ApiError{}
+		require.Empty(t, json.NewDecoder(response.Body).Decode(&apiErr))
+		require.Equal(t, service.CodeValidationFailed, apiErr.Code)
+		return apiErr.Details
+	}
+	require.Equal(t, []service.ErrorDetail{
+		{Field: "endTime", Message: "must be at least a minute after startTime and at most 24h later"},
+		{Field: "description", Message: "is required without a title"},
+		{Field: "invited[0].invitee", Message: "the owner can't be invited"},
+		{Field: "invited[2].invitee", Message: `"nobody" is invited more than once`},
+		{Field: "login", Message: `unknown user "nobody"`},
+	}, validationErrors("/api/meetings", `{"owner": "bob", "invited": [{"invitee": "bob"}, {"invitee": "nobody"}, {"invitee": "nobody"}],
+		"startTime": "2023-03-07T16:20:00Z", "endTime": "2023-03-07T16:20:30Z", "description": " "}`))
+	require.Equal(t, []service.ErrorDetail{
+		{Field: "login", Message: "at most 64 letters, digits, '.', '_' and '-', starting with a letter or digit"},
+		{Field: "timeZone", Message: "must be an IANA time zone, e.g. Europe/Berlin"},
+	}, validationErrors("/api/users", `{"login": " bob", "timeZone": "Mars/Olympus"}`))
+	require.Equal(t, []service.ErrorDetail{
+		{Field: "admin", Message: "is a forbidden property"},
+	}, validationErrors("/api/users", `{"login": "carol", "admin": true}`))
+}
+
+func TestOpenApiSpec(t *testing.T) {
+	response, err := get("/api/openapi.json")
+	require.Empty(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	document := struct {
+		Swagger string                    `json:"swagger"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}{}
+	require.Empty(t, json.NewDecoder(response.Body).Decode(&document))
+	require.Equal(t, "2.0", document.Swagger)
+	require.Contains(t, document.Paths, "/api/users/{login}/meetings")
+	require.Contains(t, document.Paths["/api/meetings"], "post")
+
+	response, err = get("/api/findSlot?startTime=2023-03-07T15:50:00.000Z&durationMinutes=0&logins=bob")
+	require.Empty(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+}
+
+// TestGeneratedClient talks to the api with the client generated from
+// openapi.json, which keeps the spec honest.
+func TestGeneratedClient(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	api := apiclient.New(httptransport.New(strings.TrimPrefix(url, "http://"), "/", []string{"http"}), strfmt.Default)
+	auth := httptransport.BearerToken(adminToken)
+
+	alice := "alice"
+	start := strfmt.DateTime(parseTimeNoError(t, "2023-03-07T16:20:00Z"))
+	end := strfmt.DateTime(parseTimeNoError(t, "2023-03-07T16:40:00Z"))
+	meeting := &models.Meeting{Owner: "bob", Invited: []*models.Invitation{{Invitee: &alice}}, StartTime: &start, EndTime: &end, Title: "1:1"}
+	created, err := api.Operations.AddMeeting(operations.NewAddMeetingParams().WithMeeting(meeting), auth)
+	require.Empty(t, err)
+	require.NotEmpty(t, created.Payload.ID)
+	found, err := api.Operations.GetMeeting(operations.NewGetMeetingParams().WithID(created.Payload.ID), auth)
+	require.Empty(t, err)
+	require.Equal(t, "1:1", found.Payload.Title)
+	require.Equal(t, alice, *found.Payload.Invited[0].Invitee)
+	require.Equal(t, start.String(), found.Payload.StartTime.String())
+
+	_, err = api.Operations.GetMeeting(operations.NewGetMeetingParams().WithID("640a4862377457548608f50a"), auth)
+	var notFound *operations.GetMeetingNotFound
+	require.ErrorAs(t, err, &notFound)
+	require.Equal(t, string(service.CodeNotFound), *notFound.Payload.Code)
+}
+
+func TestExportCalendar(t *testing.T) {
+	cleanup(t)
+	createMeetings(t)
+	response, err := get("/api/users/alice/calendar.ics")
+	require.Empty(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.True(t, strings.HasPrefix(response.Header.Get("Content-Type"), "text/calendar"))
+	body, err := io.ReadAll(response.Body)
+	require.Empty(t, err)
+	ics := string(body)
+	require.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+	require.Equal(t, 3, strings.Count(ics, "BEGIN:VEVENT"))
+	require.Equal(t, 1, strings.Count(ics, "RRULE:FREQ=DAILY"))
+	require.Contains(t, ics, "DTSTART:20230307T162000Z")
+	require.Contains(t, ics, "ORGANIZER;CN=bob:mailto:bob@")
+	require.Contains(t, ics, "ATTENDEE;CN=alice;ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION:mailto:alice@")
+
+	response, err = get("/api/users/nobody/calendar.ics")
+	require.Empty(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestImportCalendar(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	importAs := func(token string, ics io.Reader) calendar.ImportReport {
+		c := calendar.New(url)
+		c.Token = token
+		report, err := c.ImportCalendar(ctx, "alice", ics)
+		require.Empty(t, err)
+		return *report
+	}
+	importFile := func(token string) calendar.ImportReport {
+		file, err := os.Open("testdata/import.ics")
+		require.Empty(t, err)
+		defer file.Close()
+		return importAs(token, file)
+	}
+
+	report := importFile(adminToken)
+	require.Equal(t, 2, report.Created)
+	require.Equal(t, 1, report.Skipped)
+	require.Equal(t, calendar.ImportCreated, report.Events[0].Status)
+	require.Equal(t, 1, len(report.Events[0].Warnings)) // mallory is not a user
+	require.Equal(t, calendar.ImportSkipped, report.Events[2].Status)
+
+	standup, err := client.GetMeeting(ctx, report.Events[0].MeetingId)
+	require.Empty(t, err)
+	require.Equal(t, "bob", standup.Owner)
+	require.Equal(t, calendar.WorkingDays, standup.Reoccurance)
+	require.Equal(t, []calendar.Invitation{{Invitee: "alice", Accepted: calendar.Accepted}}, standup.Invited)
+	review, err := client.GetMeeting(ctx, report.Events[1].MeetingId)
+	require.Empty(t, err)
+	require.Equal(t, "Design review", review.Title)
+	require.Equal(t, "Agenda:\n- storage, sync", review.Description)
+	require.Equal(t, "Room 4", review.Location)
+	require.Equal(t, "https://meet.example.com/review", review.ConferenceUrl)
+	require.Equal(t, []calendar.Attachment{{Name: "agenda.pdf", Url: "https://files.example.com/agenda.pdf", MimeType: "application/pdf"}}, review.Attachments)
+	// bob@gmail.com is someone else than bob
+	require.Empty(t, review.Invited)
+	require.Equal(t, []string{"attendee mailto:bob@gmail.com is not a user"}, report.Events[1].Warnings)
+
+	// wednesday is excluded, the weekend is skipped
+	meetings, err := client.ListMeetings(ctx, "alice", parseTimeNoError(t, "2023-03-08T00:00:00Z"), parseTimeNoError(t, "2023-03-14T00:00:00Z"))
+	require.Empty(t, err)
+	starts := []string{}
+	for _, meeting := range meetings {
+		starts = append(starts, meeting.StartTime.Format(time.RFC3339))
+	}
+	require.Equal(t, []string{"2023-03-09T09:00:00Z", "2023-03-10T09:00:00Z", "2023-03-13T09:00:00Z"}, starts)
+
+	report = importFile(adminToken)
+	require.Equal(t, 0, report.Created)
+	require.Equal(t, 2, report.Updated)
+	require.Equal(t, report.Events[0].MeetingId, standup.Id)
+
+	// alice can't take over the meetings bob organizes
+	token, err := client.CreateToken(ctx, "alice", "import")
+	require.Empty(t, err)
+	report = importFile(token.Token)
+	require.Equal(t, 1, report.Updated)
+	require.Equal(t, calendar.ImportSkipped, report.Events[0].Status)
+	require.Contains(t, report.Events[0].Reason, "bob")
+	standup, err = client.GetMeeting(ctx, standup.Id)
+	require.Empty(t, err)
+	require.Equal(t, "bob", standup.Owner)
+
+	// events are checked like meetings of the api
+	report = importAs(adminToken, strings.NewReader(strings.Join([]string{
+		"BEGIN:VCALENDAR", "VERSION:2.0",
+		"BEGIN:VEVENT", "UID:long-4@example.com", "DTSTART:20230307T150000Z", "DURATION:PT1H", "SUMMARY:" + strings.Repeat("a", 201), "END:VEVENT",
+		"BEGIN:VEVENT", "UID:untitled-5@example.com", "DTSTART:20230307T170000Z", "DURATION:PT1H", "END:VEVENT",
+		"END:VCALENDAR", "",
+	}, "\r\n")))
+	require.Equal(t, 2, report.Skipped)
+	require.Equal(t, "invalid meeting: title at most 200 characters", report.Events[0].Reason)
+	require.Equal(t, "invalid meeting: description is required without a title", report.Events[1].Reason)
+}
+
+// TestCalDav replays the conversation a native client has when a calendar
+// account is added, an event is created and synced, and then deleted.
+func TestCalDav(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	event := "/dav/calendars/alice/default/6B0D7E46-3F1A-4C4B-9C57-2E5C1B1D77A0.ics"
+	etag := ""
+	steps := []struct {
+		method   string
+		path     string
+		user     string
+		depth    string
+		body     string
+		status   int
+		contains []string
+	}{
+		{"PROPFIND", "/.well-known/caldav", "alice", "0", "propfind-principal.xml", http.StatusMultiStatus, []string{"<D:href>/dav/principals/alice/</D:href>"}},
+		{"PROPFIND", "/dav/principals/alice/", "", "0", "propfind-principal.xml", http.StatusUnauthorized, nil},
+		{"PROPFIND", "/dav/principals/alice/", "alice", "0", "propfind-principal.xml", http.StatusMultiStatus, []string{
+			"<C:calendar-home-set><D:href>/dav/calendars/alice/</D:href></C:calendar-home-set>",
+			"<D:href>mailto:alice@calendar.local</D:href>",
+		}},
+		{"PROPFIND", "/dav/calendars/alice/", "alice", "1", "propfind-home.xml", http.StatusMultiStatus, []string{
+			"<D:href>/dav/calendars/alice/default/</D:href>",
+			"<D:resourcetype><D:collection/><C:calendar/></D:resourcetype>",
+			`<C:supported-calendar-component-set><C:comp name="VEVENT"/></C:supported-calendar-component-set>`,
+			"HTTP/1.1 404 Not Found",
+		}},
+		{"PUT", event, "alice", "", "event.ics", http.StatusCreated, nil},
+		{"PUT", event, "bob", "", "event.ics", http.StatusForbidden, nil},
+		// calendar objects are checked like meetings of the api
+		{"PUT", "/dav/calendars/alice/default/untitled.ics", "alice", "", "event-untitled.ics", http.StatusUnprocessableEntity, []string{"is required without a title"}},
+		// calendars are only read by users they are shared with
+		{"GET", event, "bob", "", "", http.StatusForbidden, nil},
+		{"PROPFIND", "/dav/calendars/alice/default/", "bob", "1", "propfind-calendar.xml", http.StatusForbidden, nil},
+		{"REPORT", "/dav/calendars/alice/default/", "bob", "1", "report-query.xml", http.StatusForbidden, nil},
+		{"PROPFIND", "/dav/calendars/alice/default/", "alice", "1", "propfind-calendar.xml", http.StatusMultiStatus, []string{"<D:href>" + event + "</D:href>", "<D:getetag>"}},
+		{"REPORT", "/dav/calendars/bob/default/", "bob", "1", "report-query.xml", http.StatusMultiStatus, []string{"<D:href>/dav/calendars/bob/default/6B0D7E46-3F1A-4C4B-9C57-2E5C1B1D77A0.ics</D:href>"}},
+		{"REPORT", "/dav/calendars/alice/default/", "alice", "1", "report-multiget.xml", http.StatusMultiStatus, []string{
+			"SUMMARY:Design review",
+			"ATTENDEE;PARTSTAT=NEEDS-ACTION:mailto:bob@calendar.local",
+			"HTTP/1.1 404 Not Found",
+		}},
+		{"GET", event, "alice", "", "", http.StatusOK, []string{"UID:6B0D7E46-3F1A-4C4B-9C57-2E5C1B1D77A0"}},
+		{"DELETE", event, "alice", "", "", http.StatusNoContent, nil},
+		{"GET", event, "alice", "", "", http.StatusNotFound, nil},
+	}
+	for _, step := range steps {
+		var body io.Reader
+		if step.body != "" {
+			content, err := os.ReadFile("testdata/caldav/" + step.body)
+			require.Empty(t, err)
+			body = strings.NewReader(string(content))
+		}
+		request, err := http.NewRequest(step.method, url+step.path, body)
+		require.Empty(t, err)
+		if step.user != "" {
+			request.SetBasicAuth(step.user, adminToken)
+		}
+		if step.depth != "" {
+			request.Header.Set("Depth", step.depth)
+		}
+		response, err := http.DefaultClient.Do(request)
+		require.Empty(t, err)
+		content, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		require.Empty(t, err)
+		require.Equal(t, step.status, response.StatusCode, "%s %s: %s", step.method, step.path, content)
+		for _, expected := range step.contains {
+			require.Contains(t, string(content), expected, "%s %s", step.method, step.path)
+		}
+		if step.method == "PUT" && response.StatusCode == http.StatusCreated {
+			etag = response.Header.Get("ETag")
+			require.NotEmpty(t, etag)
+		}
+		if step.method == "GET" && response.StatusCode == http.StatusOK {
+			require.Equal(t, etag, response.Header.Get("ETag"))
+		}
+	}
+}
+
+// TestWebhooks subscribes a receiver running in the test container, which the
+// api reaches under the compose service name.
+func TestWebhooks(t *testing.T) {
+	cleanup(t)
+	_, err := dbClient.Database("db").Collection("webhooks").DeleteMany(context.TODO(), bson.M{})
+	require.Empty(t, err)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+
+	received := make(chan calendar.Event, 10)
+	listener, err := net.Listen("tcp", ":0")
+	require.Empty(t, err)
+	receiver := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event calendar.Event
+		require.Empty(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	})}
+	go receiver.Serve(listener)
+	defer receiver.Close()
+	receiverUrl := fmt.Sprintf("http://test:%d/hook", listener.Addr().(*net.TCPAddr).Port)
+
+	webhook, err := client.AddWebhook(ctx, calendar.Webhook{
+		Login:  "alice",
+		Url:    receiverUrl,
+		Events: []calendar.EventType{calendar.MeetingCreated, calendar.MeetingResponded},
+	})
+	require.Empty(t, err)
+	require.NotEmpty(t, webhook.Secret)
+	// the secret is only stored encrypted, and only returned on creation
+	var stored bson.M
+	err = dbClient.Database("db").Collection("webhooks").FindOne(context.TODO(), bson.M{}).Decode(&stored)
+	require.Empty(t, err)
+	require.NotContains(t, fmt.Sprint(stored), webhook.Secret)
+	fetched, err := client.GetWebhook(ctx, webhook.Id)
+	require.Empty(t, err)
+	require.Empty(t, fetched.Secret)
+
+	meetingId, err := addMeeting(calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	_, err = client.AcceptMeeting(ctx, meetingId, "alice" /* decline = */, false)
+	require.Empty(t, err)
+	for _, expected := range []calendar.EventType{calendar.MeetingCreated, calendar.MeetingResponded} {
+		select {
+		case event := <-received:
+			require.Equal(t, expected, event.Type)
+			require.Equal(t, meetingId, event.Meeting.Id)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("no %s delivered", expected)
+		}
+	}
+
+	// the outcome is recorded after the receiver has answered
+	require.Eventually(t, func() bool {
+		deliveries, err := client.ListDeliveries(ctx, webhook.Id, calendar.DeliverySucceeded, 0)
+		require.Empty(t, err)
+		return len(deliveries) == 2
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestReminders(t *testing.T) {
+	cleanup(t)
+	database := dbClient.Database("db")
+	_, err := database.Collection("webhooks").DeleteMany(context.TODO(), bson.M{})
+	require.Empty(t, err)
+	_, err = database.Collection("reminders").DeleteMany(context.TODO(), bson.M{})
+	require.Empty(t, err)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	require.Empty(t, addUser("carl"))
+	_, err = client.SetReminders(ctx, "carl", []calendar.Reminder{{OffsetMinutes: 1, Channel: calendar.ChannelLog}})
+	require.Empty(t, err)
+
+	received := make(chan calendar.Event, 10)
+	listener, err := net.Listen("tcp", ":0")
+	require.Empty(t, err)
+	receiver := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event calendar.Event
+		require.Empty(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	})}
+	go receiver.Serve(listener)
+	defer receiver.Close()
+	receiverUrl := fmt.Sprintf("http://test:%d/hook", listener.Addr().(*net.TCPAddr).Port)
+	body := fmt.Sprintf(`{"url": %q, "events": ["meeting.reminder"]}`, receiverUrl)
+	response, err := post("/api/webhooks", "application/json", strings.NewReader(body))
+	require.Empty(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	// due since a minute
+	start := time.Now().UTC().Truncate(time.Minute).Add(2 * time.Minute)
+	meetingId, err := addMeeting(calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}, {Invitee: "carl"}},
+		StartTime:   start,
+		EndTime:     start.Add(30 * time.Minute),
+		Reminders:   []calendar.Reminder{{OffsetMinutes: 3, Channel: calendar.ChannelWebhook}},
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	_, err = client.AcceptMeeting(ctx, meetingId, "carl" /* decline = */, true)
+	require.Empty(t, err)
+
+	reminded := map[string]bool{}
+	for len(reminded) < 2 {
+		select {
+		case event := <-received:
+			require.Equal(t, calendar.MeetingReminder, event.Type)
+			require.Equal(t, meetingId, event.Meeting.Id)
+			require.False(t, reminded[event.Login])
+			reminded[event.Login] = true
+		case <-time.After(45 * time.Second):
+			t.Fatalf("reminded only %v", reminded)
+		}
+	}
+	require.Equal(t, map[string]bool{"bob": true, "alice": true}, reminded)
+	count, err := database.Collection("reminders").CountDocuments(context.TODO(), bson.M{"meetingId": meetingId})
+	require.Empty(t, err)
+	require.Equal(t, int64(2), count)
+
+	_, err = addMeeting(calendar.Meeting{
+		Owner:       "bob",
+		StartTime:   start,
+		EndTime:     start.Add(30 * time.Minute),
+		Reminders:   []calendar.Reminder{{OffsetMinutes: 10, Channel: "pigeon"}},
+		Description: "planning",
+	})
+	require.ErrorIs(t, err, calendar.ErrValidation)
+}
+
+func TestStreamMeetings(t *testing.T) {
+	cleanup(t)
+	require.Empty(t, addUser("bob"))
+	require.Empty(t, addUser("alice"))
+	streamCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	stream, err := client.StreamMeetings(streamCtx, "alice")
+	require.Empty(t, err)
+	defer stream.Close()
+
+	meetingId, err := addMeeting(calendar.Meeting{
+		Owner:       "bob",
+		Invited:     []calendar.Invitation{{Invitee: "alice"}},
+		StartTime:   parseTimeNoError(t, "2023-03-07T16:20:00.000Z"),
+		EndTime:     parseTimeNoError(t, "2023-03-07T16:40:00.000Z"),
+		Description: "planning",
+	})
+	require.Empty(t, err)
+	event, err := stream.Next()
+	require.Empty(t, err)
+	require.Equal(t, calendar.MeetingCreated, event.Type)
+	require.Equal(t, meetingId, event.Meeting.Id)
+
+	// dropped participants only learn that they were removed
+	meeting, err := client.GetMeeting(ctx, meetingId)
 	require.Empty(t, err)
-	meetingId2, err := client.PostMeeting(*meeting)
+	meeting.Invited = []calendar.Invitation{}
+	_, err = client.UpdateMeeting(ctx, meetingId, *meeting)
 	require.Empty(t, err)
-	meeting, err = client.AcceptMeeting(meetingId1, "alice" /* decline = */, false)
+	event, err = stream.Next()
 	require.Empty(t, err)
-	require.Equal(t, meeting.Invited[0].Accepted, service.Accepted)
-	require.Equal(t, meeting.Invited[1].Accepted, service.NotReviewed)
-	meeting, err = client.GetMeeting(meetingId2)
-	require.Equal(t, meeting.Invited[0].Accepted, service.NotReviewed)
-	require.Equal(t, meeting.Invited[1].Accepted, service.NotReviewed)
+	require.Equal(t, calendar.MeetingRemoved, event.Type)
+	require.Equal(t, "alice", event.Login)
+	require.Equal(t, meetingId, event.Meeting.Id)
+	require.True(t, event.Meeting.Busy)
 }